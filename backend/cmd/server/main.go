@@ -2,22 +2,27 @@ package main
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"net/http"
+	_ "time/tzdata" // heatmaps load client timezones, the host may not ship zoneinfo
 	"valorant-tracker/gen/proto/valorant/v1/valorantv1connect"
 	"valorant-tracker/internal/config"
 	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/discord"
 	fxmodules "valorant-tracker/internal/fx"
 	"valorant-tracker/internal/middleware"
 	"valorant-tracker/internal/server"
+	"valorant-tracker/internal/service"
 
 	"github.com/rs/cors"
 	"github.com/rs/zerolog"
 	"go.uber.org/fx"
 )
 
-const ValorantTrackerPath = "/valorant.v1.ValorantTracker/"
+const (
+	ValorantTrackerPath     = "/valorant.v1.ValorantTracker/"
+	DiscordInteractionsPath = "/discord/interactions"
+)
 
 func main() {
 	fx.New(
@@ -29,8 +34,9 @@ func main() {
 func runServer(
 	lc fx.Lifecycle,
 	trackerServer *server.TrackerServer,
+	discordHandler *discord.Handler,
+	watchHub *service.WatchHub,
 	cfg *config.Config,
-	db *sql.DB,
 	logger zerolog.Logger,
 ) {
 	mux := http.NewServeMux()
@@ -57,10 +63,16 @@ func runServer(
 		requestIDMiddleware(c.Handler(handler)).ServeHTTP(w, r)
 	})
 
+	if discordHandler.Enabled() {
+		mux.Handle(DiscordInteractionsPath, requestIDMiddleware(discordHandler))
+	}
+
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%s", cfg.ServerPort),
 		Handler: mux,
 	}
+	// streams never finish on their own, end them so Shutdown doesn't wait out its timeout
+	srv.RegisterOnShutdown(watchHub.Close)
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -77,10 +89,6 @@ func runServer(
 			shutdownCtx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
 			defer cancel()
 
-			if err := db.Close(); err != nil {
-				logger.Warn().Err(err).Msg("error closing database connection")
-			}
-
 			if err := srv.Shutdown(shutdownCtx); err != nil {
 				logger.Error().Err(err).Msg("server shutdown failed")
 				return err
@@ -0,0 +1,12 @@
+-- name: DeleteMatchAwards :exec
+DELETE FROM match_awards WHERE match_id = ?;
+
+-- name: InsertMatchAward :exec
+INSERT INTO match_awards (match_id, award, puuid, value)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(match_id, award, puuid) DO UPDATE SET value = excluded.value;
+
+-- name: GetMatchAwards :many
+SELECT * FROM match_awards
+WHERE match_id = ?
+ORDER BY award, puuid;
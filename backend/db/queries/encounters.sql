@@ -0,0 +1,39 @@
+-- name: GetEncounters :many
+SELECT
+    o.puuid,
+    o.name,
+    o.tag,
+    CAST(SUM(CASE WHEN o.team = me.team THEN 1 ELSE 0 END) AS INTEGER) AS games_with,
+    CAST(SUM(CASE WHEN o.team = me.team AND me.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins_with,
+    CAST(SUM(CASE WHEN o.team <> me.team THEN 1 ELSE 0 END) AS INTEGER) AS games_against,
+    CAST(SUM(CASE WHEN o.team <> me.team AND me.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins_against,
+    m.match_id AS last_match_id,
+    m.started_at AS last_played_at,
+    o.team = me.team AS last_as_teammate
+FROM match_players me
+INNER JOIN match_players o ON o.match_id = me.match_id AND o.puuid <> me.puuid
+INNER JOIN matches m ON m.match_id = me.match_id
+WHERE me.puuid = sqlc.arg('puuid')
+GROUP BY o.puuid
+ORDER BY COUNT(*) DESC, MAX(m.started_at) DESC
+LIMIT sqlc.arg('limit');
+
+-- name: GetEncounter :one
+SELECT
+    o.puuid,
+    o.name,
+    o.tag,
+    CAST(SUM(CASE WHEN o.team = me.team THEN 1 ELSE 0 END) AS INTEGER) AS games_with,
+    CAST(SUM(CASE WHEN o.team = me.team AND me.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins_with,
+    CAST(SUM(CASE WHEN o.team <> me.team THEN 1 ELSE 0 END) AS INTEGER) AS games_against,
+    CAST(SUM(CASE WHEN o.team <> me.team AND me.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins_against,
+    m.match_id AS last_match_id,
+    m.started_at AS last_played_at,
+    o.team = me.team AS last_as_teammate
+FROM match_players me
+INNER JOIN match_players o ON o.match_id = me.match_id AND o.puuid = sqlc.arg('other_puuid')
+INNER JOIN matches m ON m.match_id = me.match_id
+WHERE me.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('exclude_match_id') IS NULL OR me.match_id <> sqlc.narg('exclude_match_id'))
+GROUP BY o.puuid
+ORDER BY MAX(m.started_at) DESC;
@@ -0,0 +1,150 @@
+-- name: FollowPlayer :exec
+INSERT INTO follows (follower_id, puuid, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT(follower_id, puuid) DO NOTHING;
+
+-- name: UnfollowPlayer :exec
+DELETE FROM follows
+WHERE follower_id = ? AND puuid = ?;
+
+-- name: GetFollowedPuuids :many
+SELECT puuid FROM follows
+WHERE follower_id = ?
+ORDER BY created_at ASC;
+
+-- name: CountFollowers :one
+SELECT COUNT(*) as count FROM follows
+WHERE puuid = ?;
+
+-- name: GetFeedMatches :many
+WITH page AS MATERIALIZED (
+    SELECT
+        m.match_id,
+        m.map_name,
+        m.map_id,
+        m.mode,
+        m.started_at,
+        m.season_id,
+        m.team_red_score,
+        m.team_blue_score,
+        m.region,
+        m.cluster,
+        m.version,
+        m.source,
+        m.patch,
+        m.created_at AS match_created_at,
+        m.updated_at AS match_updated_at,
+        mp.puuid,
+        mp.name,
+        mp.tag,
+        mp.tier,
+        mp.tier_name,
+        mp.kills,
+        mp.deaths,
+        mp.assists,
+        mp.score,
+        mp.team,
+        mp.has_won,
+        mp.character_id,
+        mp.damage_taken,
+        mp.damage_dealt,
+        mp.created_at AS mp_created_at,
+        mp.updated_at AS mp_updated_at,
+        mp.acs,
+        mp.adr,
+        mp.kast,
+        mp.first_bloods,
+        mp.first_deaths,
+        mp.plus_minus,
+        mp.rating
+    FROM follows f
+    INNER JOIN match_players mp ON mp.puuid = f.puuid
+    INNER JOIN matches m ON m.match_id = mp.match_id
+    WHERE f.follower_id = sqlc.arg('follower_id')
+        AND (sqlc.narg('before_match_id') IS NULL OR (m.started_at, m.match_id, mp.puuid) < (
+            (SELECT c.started_at FROM matches c WHERE c.match_id = sqlc.narg('before_match_id')),
+            sqlc.narg('before_match_id'),
+            sqlc.arg('before_puuid')
+        ))
+    ORDER BY m.started_at DESC, m.match_id DESC, mp.puuid DESC
+    LIMIT sqlc.arg('limit')
+)
+SELECT
+    page.match_id,
+    page.map_name,
+    page.map_id,
+    page.mode,
+    page.started_at,
+    page.season_id,
+    page.team_red_score,
+    page.team_blue_score,
+    page.region,
+    page.cluster,
+    page.version,
+    page.source,
+    page.patch,
+    page.match_created_at,
+    page.match_updated_at,
+    page.puuid,
+    page.name,
+    page.tag,
+    page.tier,
+    page.tier_name,
+    page.kills,
+    page.deaths,
+    page.assists,
+    page.score,
+    page.team,
+    page.has_won,
+    page.character_id,
+    page.damage_taken,
+    page.damage_dealt,
+    page.mp_created_at,
+    page.mp_updated_at,
+    page.acs,
+    page.adr,
+    page.kast,
+    page.first_bloods,
+    page.first_deaths,
+    page.plus_minus,
+    page.rating,
+    mmr.id AS mmr_id,
+    mmr.tier AS mmr_tier,
+    mmr.tier_name AS mmr_tier_name,
+    mmr.ranking_in_tier,
+    mmr.mmr_change,
+    mmr.elo,
+    mmr.date AS mmr_date,
+    mmr.source AS mmr_source,
+    mmr.created_at AS mmr_created_at,
+    mmr.updated_at AS mmr_updated_at,
+    CAST((
+        SELECT COUNT(*) FROM match_players hp
+        INNER JOIN matches hm ON hm.match_id = hp.match_id
+        WHERE hp.puuid = page.puuid AND (hm.started_at, hm.match_id) <= (page.started_at, page.match_id)
+    ) AS INTEGER) AS played,
+    (
+        SELECT hh.tier FROM mmr_histories hh
+        INNER JOIN match_players hp ON hp.match_id = hh.match_id AND hp.puuid = hh.puuid
+        INNER JOIN matches hm ON hm.match_id = hh.match_id
+        WHERE hh.puuid = page.puuid AND (hm.started_at, hm.match_id) < (page.started_at, page.match_id)
+        ORDER BY hm.started_at DESC, hm.match_id DESC
+        LIMIT 1
+    ) AS previous_tier,
+    (
+        SELECT hh.tier_name FROM mmr_histories hh
+        INNER JOIN match_players hp ON hp.match_id = hh.match_id AND hp.puuid = hh.puuid
+        INNER JOIN matches hm ON hm.match_id = hh.match_id
+        WHERE hh.puuid = page.puuid AND (hm.started_at, hm.match_id) < (page.started_at, page.match_id)
+        ORDER BY hm.started_at DESC, hm.match_id DESC
+        LIMIT 1
+    ) AS previous_tier_name,
+    CAST(COALESCE((
+        SELECT MAX(hh.tier) FROM mmr_histories hh
+        INNER JOIN match_players hp ON hp.match_id = hh.match_id AND hp.puuid = hh.puuid
+        INNER JOIN matches hm ON hm.match_id = hh.match_id
+        WHERE hh.puuid = page.puuid AND (hm.started_at, hm.match_id) < (page.started_at, page.match_id)
+    ), 0) AS INTEGER) AS peak_tier
+FROM page
+LEFT JOIN mmr_histories mmr ON mmr.match_id = page.match_id AND mmr.puuid = page.puuid
+ORDER BY page.started_at DESC, page.match_id DESC, page.puuid DESC;
@@ -0,0 +1,58 @@
+-- name: GetMatchLobbySizes :many
+SELECT
+    m.match_id,
+    m.mode,
+    m.source,
+    CAST(COUNT(mp.puuid) AS INTEGER) AS player_count
+FROM matches m
+LEFT JOIN match_players mp ON m.match_id = mp.match_id
+GROUP BY m.match_id
+ORDER BY m.started_at DESC;
+
+-- name: GetOrphanedMMRHistories :many
+SELECT mmr.id, mmr.match_id, mmr.puuid FROM mmr_histories mmr
+LEFT JOIN match_players mp ON mp.match_id = mmr.match_id AND mp.puuid = mmr.puuid
+WHERE mp.puuid IS NULL
+ORDER BY mmr.date DESC;
+
+-- name: GetStalePartialPlayers :many
+SELECT * FROM players
+WHERE is_partial_fetch = TRUE AND updated_at < ?
+ORDER BY updated_at ASC
+LIMIT ?;
+
+-- name: GetOutcomeMismatches :many
+SELECT
+    mp.match_id,
+    mp.puuid,
+    mp.team,
+    mp.has_won,
+    m.team_red_score,
+    m.team_blue_score
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE mp.team IN ('Red', 'Blue')
+  AND mp.has_won != (
+      (mp.team = 'Red' AND m.team_red_score > m.team_blue_score)
+      OR (mp.team = 'Blue' AND m.team_blue_score > m.team_red_score)
+  );
+
+-- name: UpdateMatchPlayerOutcome :exec
+UPDATE match_players
+SET has_won = ?, updated_at = ?
+WHERE match_id = ? AND puuid = ?;
+
+-- name: GetMatchLobbySize :one
+SELECT
+    m.match_id,
+    m.mode,
+    m.source,
+    CAST(COUNT(mp.puuid) AS INTEGER) AS player_count
+FROM matches m
+LEFT JOIN match_players mp ON m.match_id = mp.match_id
+WHERE m.match_id = ?
+GROUP BY m.match_id;
+
+-- name: CountMatchPlayer :one
+SELECT COUNT(*) AS count FROM match_players
+WHERE match_id = ? AND puuid = ?;
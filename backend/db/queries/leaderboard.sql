@@ -0,0 +1,40 @@
+-- name: AddGroupMember :exec
+INSERT INTO player_groups (group_name, puuid, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT(group_name, puuid) DO NOTHING;
+
+-- name: RemoveGroupMember :exec
+DELETE FROM player_groups
+WHERE group_name = ? AND puuid = ?;
+
+-- name: GetGroupMembers :many
+SELECT puuid FROM player_groups
+WHERE group_name = ?
+ORDER BY created_at ASC;
+
+-- name: GetLeaderboardRows :many
+SELECT
+    p.puuid,
+    p.name,
+    p.tag,
+    p.region,
+    p.current_tier,
+    p.current_tier_name,
+    p.current_rr,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths,
+    CAST(COALESCE(SUM(mp.rating), 0) AS REAL) AS rating_sum,
+    CAST(COUNT(mp.rating) AS INTEGER) AS rated_games
+FROM players p
+INNER JOIN match_players mp ON mp.puuid = p.puuid
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE (sqlc.narg('region') IS NULL OR p.region = sqlc.narg('region'))
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('group_name') IS NULL OR EXISTS (
+        SELECT 1 FROM player_groups g
+        WHERE g.group_name = sqlc.narg('group_name') AND g.puuid = p.puuid
+    ))
+GROUP BY p.puuid
+HAVING COUNT(*) >= sqlc.arg('min_games');
@@ -16,8 +16,8 @@ WHERE puuid = ? AND match_id IN (sqlc.slice('match_ids'));
 INSERT INTO matches (
     match_id, map_name, map_id, mode, started_at, season_id,
     team_red_score, team_blue_score, region, cluster, version,
-    source, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    source, created_at, updated_at, patch
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(match_id) DO UPDATE SET
     map_name = excluded.map_name,
     map_id = excluded.map_id,
@@ -30,15 +30,17 @@ ON CONFLICT(match_id) DO UPDATE SET
     cluster = excluded.cluster,
     version = excluded.version,
     source = excluded.source,
-    updated_at = excluded.updated_at;
+    updated_at = excluded.updated_at,
+    patch = excluded.patch;
 
 -- name: UpsertMatchPlayer :exec
 INSERT INTO match_players (
     match_id, puuid, name, tag, tier, tier_name,
     kills, deaths, assists, score, team, has_won,
     character_id, damage_taken, damage_dealt,
-    created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    created_at, updated_at,
+    acs, adr, kast, first_bloods, first_deaths, plus_minus, rating, party_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(match_id, puuid) DO UPDATE SET
     name = excluded.name,
     tag = excluded.tag,
@@ -53,7 +55,15 @@ ON CONFLICT(match_id, puuid) DO UPDATE SET
     character_id = excluded.character_id,
     damage_taken = excluded.damage_taken,
     damage_dealt = excluded.damage_dealt,
-    updated_at = excluded.updated_at;
+    updated_at = excluded.updated_at,
+    acs = excluded.acs,
+    adr = excluded.adr,
+    kast = COALESCE(excluded.kast, match_players.kast),
+    first_bloods = COALESCE(excluded.first_bloods, match_players.first_bloods),
+    first_deaths = COALESCE(excluded.first_deaths, match_players.first_deaths),
+    plus_minus = excluded.plus_minus,
+    rating = COALESCE(excluded.rating, match_players.rating),
+    party_id = CASE WHEN excluded.party_id <> '' THEN excluded.party_id ELSE match_players.party_id END;
 
 -- name: GetLatestMatchDate :one
 SELECT m.started_at FROM matches m
@@ -75,3 +85,32 @@ WHERE match_id = ?;
 SELECT * FROM matches
 WHERE match_id = ?
 LIMIT 1;
+
+-- name: GetRecentMatchStartTimes :many
+SELECT m.started_at FROM matches m
+INNER JOIN match_players mp ON m.match_id = mp.match_id
+WHERE mp.puuid = ?
+ORDER BY m.started_at DESC
+LIMIT ?;
+
+-- name: GetStoredMatchIDs :many
+SELECT match_id FROM match_players
+WHERE puuid = ? AND match_id IN (sqlc.slice('match_ids'));
+
+-- name: GetRecentMatchOutcomes :many
+SELECT mp.has_won FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE mp.puuid = ?
+ORDER BY m.started_at DESC
+LIMIT ?;
+
+-- name: GetLobbyTiersByPuuid :many
+SELECT
+    mp.match_id,
+    mp.team,
+    CAST(SUM(CASE WHEN mp.tier >= sqlc.arg('min_tier') THEN mp.tier ELSE 0 END) AS INTEGER) AS tier_sum,
+    CAST(SUM(CASE WHEN mp.tier >= sqlc.arg('min_tier') THEN 1 ELSE 0 END) AS INTEGER) AS ranked_players,
+    CAST(COUNT(*) AS INTEGER) AS stored_players
+FROM match_players mp
+WHERE mp.match_id IN (SELECT own.match_id FROM match_players own WHERE own.puuid = sqlc.arg('puuid'))
+GROUP BY mp.match_id, mp.team;
@@ -12,6 +12,7 @@ SELECT
     m.cluster,
     m.version,
     m.source,
+    m.patch,
     m.created_at as match_created_at,
     m.updated_at as match_updated_at,
     mp.puuid,
@@ -30,6 +31,13 @@ SELECT
     mp.damage_dealt,
     mp.created_at as mp_created_at,
     mp.updated_at as mp_updated_at,
+    mp.acs,
+    mp.adr,
+    mp.kast,
+    mp.first_bloods,
+    mp.first_deaths,
+    mp.plus_minus,
+    mp.rating,
     mmr.id as mmr_id,
     mmr.tier as mmr_tier,
     mmr.tier_name as mmr_tier_name,
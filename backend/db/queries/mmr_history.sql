@@ -18,3 +18,16 @@ SELECT * FROM mmr_histories
 WHERE puuid = ?
 ORDER BY date DESC
 LIMIT ?;
+
+-- name: GetRatedGamesByPuuid :many
+SELECT
+    h.id, h.match_id, h.puuid, h.tier, h.tier_name, h.ranking_in_tier,
+    h.mmr_change, h.elo, h.date, h.source, h.created_at, h.updated_at,
+    mp.has_won,
+    CAST(m.team_red_score = m.team_blue_score AS BOOLEAN) AS drawn
+FROM mmr_histories h
+LEFT JOIN match_players mp ON mp.match_id = h.match_id AND mp.puuid = h.puuid
+LEFT JOIN matches m ON m.match_id = h.match_id
+WHERE h.puuid = ?
+ORDER BY h.date DESC
+LIMIT ?;
@@ -0,0 +1,51 @@
+-- name: InsertLeaderboardSnapshot :exec
+INSERT INTO leaderboard_snapshots (id, region, fetched_at)
+VALUES (?, ?, ?);
+
+-- name: InsertLeaderboardEntry :exec
+INSERT INTO leaderboard_entries (snapshot_id, leaderboard_rank, puuid, name, tag, tier, rr, wins)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetRecentLeaderboardSnapshots :many
+SELECT * FROM leaderboard_snapshots
+WHERE region = ?
+ORDER BY fetched_at DESC
+LIMIT ?;
+
+-- name: DeleteLeaderboardEntriesBefore :exec
+DELETE FROM leaderboard_entries
+WHERE snapshot_id IN (SELECT id FROM leaderboard_snapshots WHERE fetched_at < ?);
+
+-- name: DeleteLeaderboardSnapshotsBefore :exec
+DELETE FROM leaderboard_snapshots
+WHERE fetched_at < ?;
+
+-- name: ListLeaderboardEntries :many
+SELECT
+    e.leaderboard_rank,
+    e.puuid,
+    e.name,
+    e.tag,
+    e.tier,
+    e.rr,
+    e.wins,
+    prev.leaderboard_rank AS previous_rank,
+    CAST(p.puuid IS NOT NULL AS BOOLEAN) AS tracked
+FROM leaderboard_entries e
+LEFT JOIN leaderboard_entries prev
+    ON prev.snapshot_id = sqlc.narg('previous_snapshot_id') AND prev.puuid = e.puuid AND e.puuid <> ''
+LEFT JOIN players p ON p.puuid = e.puuid
+WHERE e.snapshot_id = sqlc.arg('snapshot_id')
+    AND e.leaderboard_rank > sqlc.arg('after_rank')
+    AND (sqlc.narg('search') IS NULL OR e.name || '#' || e.tag LIKE sqlc.narg('search') ESCAPE '\')
+ORDER BY e.leaderboard_rank ASC
+LIMIT sqlc.arg('limit');
+
+-- name: GetPlayerLeaderboardRank :one
+SELECT s.region, e.leaderboard_rank
+FROM leaderboard_entries e
+INNER JOIN leaderboard_snapshots s ON s.id = e.snapshot_id
+WHERE e.puuid = ?
+    AND s.fetched_at = (SELECT MAX(fetched_at) FROM leaderboard_snapshots latest WHERE latest.region = s.region)
+ORDER BY e.leaderboard_rank ASC
+LIMIT 1;
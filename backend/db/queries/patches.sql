@@ -0,0 +1,39 @@
+-- name: UpsertPatch :exec
+INSERT INTO patches (name, major, minor, first_seen_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(name) DO UPDATE SET
+    first_seen_at = MIN(patches.first_seen_at, excluded.first_seen_at);
+
+-- name: ListPatches :many
+SELECT
+    p.name,
+    p.major,
+    p.minor,
+    p.first_seen_at,
+    CAST(COUNT(m.match_id) AS INTEGER) AS matches
+FROM patches p
+LEFT JOIN matches m ON m.patch = p.name
+GROUP BY p.name, p.major, p.minor, p.first_seen_at
+ORDER BY p.major DESC, p.minor DESC;
+
+-- name: GetPatchMeta :many
+SELECT
+    mp.character_id,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(COUNT(DISTINCT mp.match_id) AS INTEGER) AS matches,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE m.patch = sqlc.arg('patch')
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND mp.character_id <> ''
+GROUP BY mp.character_id
+ORDER BY matches DESC, mp.character_id ASC;
+
+-- name: CountPatchMatches :one
+SELECT CAST(COUNT(*) AS INTEGER) AS count
+FROM matches m
+WHERE m.patch = sqlc.arg('patch')
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'));
@@ -0,0 +1,39 @@
+-- name: DeleteMatchRounds :exec
+DELETE FROM match_rounds WHERE match_id = ?;
+
+-- name: DeleteMatchKills :exec
+DELETE FROM match_kills WHERE match_id = ?;
+
+-- name: InsertMatchRound :exec
+INSERT INTO match_rounds (
+    match_id, round_number, winning_team, attacking_team,
+    end_type, bomb_planted, bomb_defused, plant_site
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(match_id, round_number) DO NOTHING;
+
+-- name: InsertMatchKill :exec
+INSERT INTO match_kills (
+    match_id, round_number, time_in_round_ms, killer_puuid, killer_team,
+    victim_puuid, victim_team, assistants, weapon_id, weapon_name
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(match_id, round_number, time_in_round_ms, victim_puuid) DO NOTHING;
+
+-- name: DeleteMatchPlayerRounds :exec
+DELETE FROM match_player_rounds WHERE match_id = ?;
+
+-- name: InsertMatchPlayerRound :exec
+INSERT INTO match_player_rounds (
+    match_id, round_number, puuid, weapon_id, weapon_name,
+    headshots, bodyshots, legshots, damage
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(match_id, round_number, puuid) DO NOTHING;
+
+-- name: GetMatchRounds :many
+SELECT * FROM match_rounds
+WHERE match_id = ?
+ORDER BY round_number;
+
+-- name: GetMatchKills :many
+SELECT * FROM match_kills
+WHERE match_id = ?
+ORDER BY round_number, time_in_round_ms;
@@ -0,0 +1,191 @@
+-- name: GetAgentStats :many
+SELECT
+    CAST(CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END AS TEXT) AS patch,
+    mp.character_id,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths,
+    CAST(SUM(mp.assists) AS INTEGER) AS assists,
+    CAST(SUM(mp.damage_dealt) AS INTEGER) AS damage_dealt,
+    CAST(SUM(m.team_red_score + m.team_blue_score) AS INTEGER) AS rounds,
+    CAST(AVG(mp.score) AS REAL) AS avg_score,
+    CAST(COALESCE(AVG(mh.mmr_change), 0) AS REAL) AS avg_rr_change,
+    CAST(COUNT(mh.id) AS INTEGER) AS rated_games
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+LEFT JOIN mmr_histories mh ON mh.match_id = mp.match_id AND mh.puuid = mp.puuid
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END, mp.character_id
+ORDER BY games DESC, mp.character_id ASC;
+
+-- name: GetMapStats :many
+SELECT
+    CAST(CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END AS TEXT) AS patch,
+    m.map_id,
+    m.map_name,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(CASE WHEN mp.team = 'Red' THEN m.team_red_score ELSE m.team_blue_score END) AS INTEGER) AS rounds_won,
+    CAST(SUM(m.team_red_score + m.team_blue_score) AS INTEGER) AS rounds,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths,
+    CAST(SUM(mp.score) AS INTEGER) AS score
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END, m.map_id, m.map_name
+ORDER BY games DESC, m.map_name ASC;
+
+-- name: GetMapSideStats :many
+SELECT
+    CAST(CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END AS TEXT) AS patch,
+    m.map_id,
+    CAST(SUM(CASE WHEN r.attacking_team = mp.team THEN 1 ELSE 0 END) AS INTEGER) AS attack_rounds,
+    CAST(SUM(CASE WHEN r.attacking_team = mp.team AND r.winning_team = mp.team THEN 1 ELSE 0 END) AS INTEGER) AS attack_rounds_won,
+    CAST(SUM(CASE WHEN r.attacking_team <> mp.team THEN 1 ELSE 0 END) AS INTEGER) AS defense_rounds,
+    CAST(SUM(CASE WHEN r.attacking_team <> mp.team AND r.winning_team = mp.team THEN 1 ELSE 0 END) AS INTEGER) AS defense_rounds_won
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+INNER JOIN match_rounds r ON r.match_id = m.match_id AND r.attacking_team <> ''
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END, m.map_id;
+
+-- name: GetOpponentTiers :many
+SELECT
+    m.match_id,
+    m.started_at,
+    m.mode,
+    CAST(SUM(CASE WHEN o.team = mp.team AND o.tier >= sqlc.arg('min_tier') THEN o.tier ELSE 0 END) AS INTEGER) AS team_tier_sum,
+    CAST(SUM(CASE WHEN o.team = mp.team AND o.tier >= sqlc.arg('min_tier') THEN 1 ELSE 0 END) AS INTEGER) AS team_ranked,
+    CAST(SUM(CASE WHEN o.team = mp.team THEN 1 ELSE 0 END) AS INTEGER) AS team_stored,
+    CAST(SUM(CASE WHEN o.team <> mp.team AND o.tier >= sqlc.arg('min_tier') THEN o.tier ELSE 0 END) AS INTEGER) AS opponent_tier_sum,
+    CAST(SUM(CASE WHEN o.team <> mp.team AND o.tier >= sqlc.arg('min_tier') THEN 1 ELSE 0 END) AS INTEGER) AS opponent_ranked,
+    CAST(SUM(CASE WHEN o.team <> mp.team THEN 1 ELSE 0 END) AS INTEGER) AS opponent_stored
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+INNER JOIN match_players o ON o.match_id = mp.match_id
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY m.match_id, m.started_at, m.mode
+ORDER BY m.started_at ASC;
+
+-- name: GetMatchTimes :many
+SELECT
+    m.started_at,
+    m.team_red_score,
+    m.team_blue_score,
+    mp.has_won,
+    mp.kills,
+    mp.deaths,
+    mp.score,
+    mp.rating,
+    mh.mmr_change
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+LEFT JOIN mmr_histories mh ON mh.match_id = mp.match_id AND mh.puuid = mp.puuid
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+ORDER BY m.started_at ASC;
+
+-- name: GetOverallStats :many
+SELECT
+    CAST(CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END AS TEXT) AS patch,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(CASE WHEN NOT mp.has_won AND m.team_red_score = m.team_blue_score THEN 1 ELSE 0 END) AS INTEGER) AS draws,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths,
+    CAST(SUM(mp.assists) AS INTEGER) AS assists,
+    CAST(SUM(mp.damage_dealt) AS INTEGER) AS damage_dealt,
+    CAST(SUM(mp.score) AS INTEGER) AS score,
+    CAST(SUM(m.team_red_score + m.team_blue_score) AS INTEGER) AS rounds,
+    CAST(COALESCE(SUM(mh.mmr_change), 0) AS INTEGER) AS rr_change,
+    CAST(COUNT(mh.id) AS INTEGER) AS rated_games
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+LEFT JOIN mmr_histories mh ON mh.match_id = mp.match_id AND mh.puuid = mp.puuid
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY CASE WHEN sqlc.arg('group_by_patch') THEN m.patch ELSE '' END;
+
+-- name: GetClusterStats :many
+SELECT
+    m.cluster,
+    CAST(COUNT(*) AS INTEGER) AS games,
+    CAST(SUM(CASE WHEN mp.has_won THEN 1 ELSE 0 END) AS INTEGER) AS wins,
+    CAST(SUM(CASE WHEN NOT mp.has_won AND m.team_red_score = m.team_blue_score THEN 1 ELSE 0 END) AS INTEGER) AS draws,
+    CAST(SUM(mp.kills) AS INTEGER) AS kills,
+    CAST(SUM(mp.deaths) AS INTEGER) AS deaths,
+    CAST(SUM(mp.damage_dealt) AS INTEGER) AS damage_dealt,
+    CAST(SUM(mp.score) AS INTEGER) AS score,
+    CAST(SUM(m.team_red_score + m.team_blue_score) AS INTEGER) AS rounds,
+    CAST(COALESCE(SUM(mh.mmr_change), 0) AS INTEGER) AS rr_change,
+    CAST(COUNT(mh.id) AS INTEGER) AS rated_games,
+    CAST(COALESCE(SUM(mp.rating), 0) AS REAL) AS rating_sum,
+    CAST(COUNT(mp.rating) AS INTEGER) AS rated
+FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+LEFT JOIN mmr_histories mh ON mh.match_id = mp.match_id AND mh.puuid = mp.puuid
+WHERE mp.puuid = sqlc.arg('puuid')
+    AND m.cluster <> ''
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY m.cluster
+ORDER BY games DESC, m.cluster ASC;
+
+-- name: GetClusterDistribution :many
+SELECT
+    m.cluster,
+    m.region,
+    CAST(COUNT(DISTINCT m.match_id) AS INTEGER) AS matches,
+    CAST(COUNT(DISTINCT mp.puuid) AS INTEGER) AS players,
+    CAST(SUM(m.team_red_score + m.team_blue_score) AS INTEGER) AS player_rounds,
+    CAST(COUNT(*) AS INTEGER) AS player_games,
+    CAST(COALESCE(SUM(mp.rating), 0) AS REAL) AS rating_sum,
+    CAST(COUNT(mp.rating) AS INTEGER) AS rated
+FROM matches m
+INNER JOIN match_players mp ON mp.match_id = m.match_id
+WHERE m.cluster <> ''
+    AND (sqlc.narg('region') IS NULL OR m.region = sqlc.narg('region'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY m.cluster, m.region
+ORDER BY matches DESC, m.cluster ASC;
@@ -0,0 +1,18 @@
+-- name: GetTeammateRows :many
+SELECT
+    me.match_id,
+    me.party_id,
+    me.has_won,
+    me.kills,
+    me.deaths,
+    mmr.mmr_change,
+    o.puuid AS teammate_puuid,
+    o.name AS teammate_name,
+    o.tag AS teammate_tag,
+    o.party_id AS teammate_party_id
+FROM match_players me
+INNER JOIN matches m ON m.match_id = me.match_id
+LEFT JOIN match_players o ON o.match_id = me.match_id AND o.team = me.team AND o.puuid <> me.puuid
+LEFT JOIN mmr_histories mmr ON mmr.match_id = me.match_id AND mmr.puuid = me.puuid
+WHERE me.puuid = ?
+ORDER BY m.started_at DESC, me.match_id;
@@ -0,0 +1,26 @@
+-- name: TrackPlayer :exec
+INSERT INTO tracked_players (
+    puuid, source, refresh_interval_seconds, next_refresh_at, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(puuid, source) DO NOTHING;
+
+-- name: UntrackPlayer :exec
+DELETE FROM tracked_players
+WHERE puuid = ? AND source = ?;
+
+-- name: GetDueTrackedPlayers :many
+SELECT t.* FROM tracked_players t
+WHERE t.next_refresh_at <= ?
+  AND NOT EXISTS (
+      SELECT 1 FROM tracked_players o
+      WHERE o.puuid = t.puuid
+        AND (o.next_refresh_at < t.next_refresh_at
+             OR (o.next_refresh_at = t.next_refresh_at AND o.source < t.source))
+  )
+ORDER BY t.next_refresh_at ASC
+LIMIT ?;
+
+-- name: UpdateTrackedPlayerSchedule :exec
+UPDATE tracked_players
+SET refresh_interval_seconds = ?, next_refresh_at = ?, last_refresh_at = ?, updated_at = ?
+WHERE puuid = ?;
@@ -0,0 +1,83 @@
+-- name: GetWeaponKills :many
+SELECT
+    LOWER(k.weapon_id) AS weapon_id,
+    MAX(k.weapon_name) AS weapon_name,
+    m.map_id,
+    m.map_name,
+    m.season_id,
+    CAST(MIN(CAST(strftime('%s', m.started_at) AS INTEGER)) AS INTEGER) AS first_played_at,
+    CAST(COUNT(*) AS INTEGER) AS kills
+FROM match_kills k
+INNER JOIN matches m ON m.match_id = k.match_id
+INNER JOIN match_players mp ON mp.match_id = k.match_id AND mp.puuid = k.killer_puuid
+WHERE k.killer_puuid = sqlc.arg('puuid')
+    AND k.weapon_id <> ''
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY LOWER(k.weapon_id), m.map_id, m.map_name, m.season_id;
+
+-- name: GetWeaponRounds :many
+SELECT
+    LOWER(pr.weapon_id) AS weapon_id,
+    MAX(pr.weapon_name) AS weapon_name,
+    m.map_id,
+    m.map_name,
+    m.season_id,
+    CAST(MIN(CAST(strftime('%s', m.started_at) AS INTEGER)) AS INTEGER) AS first_played_at,
+    CAST(COUNT(*) AS INTEGER) AS rounds,
+    CAST(SUM((
+        SELECT COUNT(*) FROM match_kills k
+        WHERE k.match_id = pr.match_id
+            AND k.round_number = pr.round_number
+            AND k.killer_puuid = pr.puuid
+            AND LOWER(k.weapon_id) = LOWER(pr.weapon_id)
+    )) AS INTEGER) AS round_kills,
+    CAST(SUM(pr.headshots) AS INTEGER) AS headshots,
+    CAST(SUM(pr.bodyshots) AS INTEGER) AS bodyshots,
+    CAST(SUM(pr.legshots) AS INTEGER) AS legshots,
+    CAST(SUM(pr.damage) AS INTEGER) AS damage
+FROM match_player_rounds pr
+INNER JOIN matches m ON m.match_id = pr.match_id
+INNER JOIN match_players mp ON mp.match_id = pr.match_id AND mp.puuid = pr.puuid
+WHERE pr.puuid = sqlc.arg('puuid')
+    AND pr.weapon_id <> ''
+    AND (sqlc.narg('season_id') IS NULL OR m.season_id = sqlc.narg('season_id'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('started_after') IS NULL OR m.started_at >= sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR m.started_at < sqlc.narg('started_before'))
+    AND (sqlc.narg('character_id') IS NULL OR mp.character_id = sqlc.narg('character_id'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY LOWER(pr.weapon_id), m.map_id, m.map_name, m.season_id;
+
+-- name: GetWeaponMetaKills :many
+SELECT
+    LOWER(k.weapon_id) AS weapon_id,
+    MAX(k.weapon_name) AS weapon_name,
+    CAST(COUNT(*) AS INTEGER) AS kills
+FROM match_kills k
+INNER JOIN matches m ON m.match_id = k.match_id
+WHERE k.weapon_id <> ''
+    AND (sqlc.narg('region') IS NULL OR m.region = sqlc.narg('region'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY LOWER(k.weapon_id);
+
+-- name: GetWeaponMetaRounds :many
+SELECT
+    LOWER(pr.weapon_id) AS weapon_id,
+    MAX(pr.weapon_name) AS weapon_name,
+    CAST(COUNT(*) AS INTEGER) AS rounds,
+    CAST(SUM(pr.headshots) AS INTEGER) AS headshots,
+    CAST(SUM(pr.bodyshots) AS INTEGER) AS bodyshots,
+    CAST(SUM(pr.legshots) AS INTEGER) AS legshots,
+    CAST(SUM(pr.damage) AS INTEGER) AS damage
+FROM match_player_rounds pr
+INNER JOIN matches m ON m.match_id = pr.match_id
+WHERE (sqlc.narg('region') IS NULL OR m.region = sqlc.narg('region'))
+    AND (sqlc.narg('mode') IS NULL OR m.mode = sqlc.narg('mode'))
+    AND (sqlc.narg('patch') IS NULL OR m.patch = sqlc.narg('patch'))
+GROUP BY LOWER(pr.weapon_id);
@@ -0,0 +1,38 @@
+-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (
+    id, url, secret, event_types, puuid, rr_threshold, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteWebhookDeliveriesBySubscription :exec
+DELETE FROM webhook_deliveries
+WHERE subscription_id = ?;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = ?;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY created_at ASC;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+    id, subscription_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetDueWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY next_attempt_at ASC
+LIMIT ?;
+
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?, delivered_at = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE (sqlc.narg('subscription_id') IS NULL OR subscription_id = sqlc.narg('subscription_id'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit');
@@ -82,21 +82,42 @@ func (x *PlayerRequest) GetRefresh() bool {
 }
 
 type PlayerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	AccountLevel  int32                  `protobuf:"varint,5,opt,name=account_level,json=accountLevel,proto3" json:"account_level,omitempty"`
-	Card          string                 `protobuf:"bytes,6,opt,name=card,proto3" json:"card,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	CurrentTier   *Tier                  `protobuf:"bytes,8,opt,name=current_tier,json=currentTier,proto3" json:"current_tier,omitempty"`
-	CurrentRr     int32                  `protobuf:"varint,9,opt,name=current_rr,json=currentRr,proto3" json:"current_rr,omitempty"`
-	TotalMatches  int32                  `protobuf:"varint,10,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
-	KdRatio       float32                `protobuf:"fixed32,11,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
-	WinRate       float32                `protobuf:"fixed32,12,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Puuid        string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag          string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Region       string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	AccountLevel int32                  `protobuf:"varint,5,opt,name=account_level,json=accountLevel,proto3" json:"account_level,omitempty"`
+	Card         string                 `protobuf:"bytes,6,opt,name=card,proto3" json:"card,omitempty"`
+	Title        string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	CurrentTier  *Tier                  `protobuf:"bytes,8,opt,name=current_tier,json=currentTier,proto3" json:"current_tier,omitempty"`
+	CurrentRr    int32                  `protobuf:"varint,9,opt,name=current_rr,json=currentRr,proto3" json:"current_rr,omitempty"`
+	TotalMatches int32                  `protobuf:"varint,10,opt,name=total_matches,json=totalMatches,proto3" json:"total_matches,omitempty"`
+	KdRatio      float32                `protobuf:"fixed32,11,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	WinRate      float32                `protobuf:"fixed32,12,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	// per round, weighted by rounds played
+	Acs float32 `protobuf:"fixed32,13,opt,name=acs,proto3" json:"acs,omitempty"`
+	Adr float32 `protobuf:"fixed32,14,opt,name=adr,proto3" json:"adr,omitempty"`
+	// 0-1, over matches with round data only; unset when there are none
+	Kast *float32 `protobuf:"fixed32,15,opt,name=kast,proto3,oneof" json:"kast,omitempty"`
+	// over the first_kill_matches matches that have a kill feed
+	FirstBloods int32 `protobuf:"varint,16,opt,name=first_bloods,json=firstBloods,proto3" json:"first_bloods,omitempty"`
+	FirstDeaths int32 `protobuf:"varint,17,opt,name=first_deaths,json=firstDeaths,proto3" json:"first_deaths,omitempty"`
+	PlusMinus   int32 `protobuf:"varint,18,opt,name=plus_minus,json=plusMinus,proto3" json:"plus_minus,omitempty"`
+	// rolling average over the latest rated matches; unset when none are rated
+	AvgRating *float32 `protobuf:"fixed32,19,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
+	// rank on the latest official leaderboard, unset when not on it
+	LeaderboardRank   *int32 `protobuf:"varint,20,opt,name=leaderboard_rank,json=leaderboardRank,proto3,oneof" json:"leaderboard_rank,omitempty"`
+	LeaderboardRegion string `protobuf:"bytes,21,opt,name=leaderboard_region,json=leaderboardRegion,proto3" json:"leaderboard_region,omitempty"`
+	// over every stored match
+	Streaks *Streaks `protobuf:"bytes,22,opt,name=streaks,proto3" json:"streaks,omitempty"`
+	// newest season first
+	SeasonStreaks []*SeasonStreaks `protobuf:"bytes,23,rep,name=season_streaks,json=seasonStreaks,proto3" json:"season_streaks,omitempty"`
+	Tilt          *Tilt            `protobuf:"bytes,24,opt,name=tilt,proto3" json:"tilt,omitempty"`
+	// matches first_bloods and first_deaths cover, stored matches carry no kill feed
+	FirstKillMatches int32 `protobuf:"varint,25,opt,name=first_kill_matches,json=firstKillMatches,proto3" json:"first_kill_matches,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PlayerResponse) Reset() {
@@ -213,28 +234,122 @@ func (x *PlayerResponse) GetWinRate() float32 {
 	return 0
 }
 
-type Tier struct {
+func (x *PlayerResponse) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetKast() float32 {
+	if x != nil && x.Kast != nil {
+		return *x.Kast
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetFirstBloods() int32 {
+	if x != nil {
+		return x.FirstBloods
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetFirstDeaths() int32 {
+	if x != nil {
+		return x.FirstDeaths
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetPlusMinus() int32 {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetLeaderboardRank() int32 {
+	if x != nil && x.LeaderboardRank != nil {
+		return *x.LeaderboardRank
+	}
+	return 0
+}
+
+func (x *PlayerResponse) GetLeaderboardRegion() string {
+	if x != nil {
+		return x.LeaderboardRegion
+	}
+	return ""
+}
+
+func (x *PlayerResponse) GetStreaks() *Streaks {
+	if x != nil {
+		return x.Streaks
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetSeasonStreaks() []*SeasonStreaks {
+	if x != nil {
+		return x.SeasonStreaks
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetTilt() *Tilt {
+	if x != nil {
+		return x.Tilt
+	}
+	return nil
+}
+
+func (x *PlayerResponse) GetFirstKillMatches() int32 {
+	if x != nil {
+		return x.FirstKillMatches
+	}
+	return 0
+}
+
+// a draw ends both streaks
+type Streaks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentWins   int32                  `protobuf:"varint,1,opt,name=current_wins,json=currentWins,proto3" json:"current_wins,omitempty"`
+	CurrentLosses int32                  `protobuf:"varint,2,opt,name=current_losses,json=currentLosses,proto3" json:"current_losses,omitempty"`
+	LongestWins   int32                  `protobuf:"varint,3,opt,name=longest_wins,json=longestWins,proto3" json:"longest_wins,omitempty"`
+	LongestLosses int32                  `protobuf:"varint,4,opt,name=longest_losses,json=longestLosses,proto3" json:"longest_losses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tier) Reset() {
-	*x = Tier{}
+func (x *Streaks) Reset() {
+	*x = Streaks{}
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tier) String() string {
+func (x *Streaks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tier) ProtoMessage() {}
+func (*Streaks) ProtoMessage() {}
 
-func (x *Tier) ProtoReflect() protoreflect.Message {
+func (x *Streaks) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,47 +361,61 @@ func (x *Tier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tier.ProtoReflect.Descriptor instead.
-func (*Tier) Descriptor() ([]byte, []int) {
+// Deprecated: Use Streaks.ProtoReflect.Descriptor instead.
+func (*Streaks) Descriptor() ([]byte, []int) {
 	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Tier) GetId() int32 {
+func (x *Streaks) GetCurrentWins() int32 {
 	if x != nil {
-		return x.Id
+		return x.CurrentWins
 	}
 	return 0
 }
 
-func (x *Tier) GetName() string {
+func (x *Streaks) GetCurrentLosses() int32 {
 	if x != nil {
-		return x.Name
+		return x.CurrentLosses
 	}
-	return ""
+	return 0
 }
 
-type MatchesRequest struct {
+func (x *Streaks) GetLongestWins() int32 {
+	if x != nil {
+		return x.LongestWins
+	}
+	return 0
+}
+
+func (x *Streaks) GetLongestLosses() int32 {
+	if x != nil {
+		return x.LongestLosses
+	}
+	return 0
+}
+
+type SeasonStreaks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
-	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Streaks       *Streaks               `protobuf:"bytes,2,opt,name=streaks,proto3" json:"streaks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchesRequest) Reset() {
-	*x = MatchesRequest{}
+func (x *SeasonStreaks) Reset() {
+	*x = SeasonStreaks{}
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchesRequest) String() string {
+func (x *SeasonStreaks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchesRequest) ProtoMessage() {}
+func (*SeasonStreaks) ProtoMessage() {}
 
-func (x *MatchesRequest) ProtoReflect() protoreflect.Message {
+func (x *SeasonStreaks) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,67 +427,50 @@ func (x *MatchesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchesRequest.ProtoReflect.Descriptor instead.
-func (*MatchesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeasonStreaks.ProtoReflect.Descriptor instead.
+func (*SeasonStreaks) Descriptor() ([]byte, []int) {
 	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MatchesRequest) GetPuuid() string {
+func (x *SeasonStreaks) GetSeasonId() string {
 	if x != nil {
-		return x.Puuid
+		return x.SeasonId
 	}
 	return ""
 }
 
-func (x *MatchesRequest) GetRefresh() bool {
+func (x *SeasonStreaks) GetStreaks() *Streaks {
 	if x != nil {
-		return x.Refresh
+		return x.Streaks
 	}
-	return false
+	return nil
 }
 
-type Match struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	MapName       string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
-	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	StartedAt     string                 `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	Tier          *Tier                  `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
-	RankingInTier int32                  `protobuf:"varint,6,opt,name=ranking_in_tier,json=rankingInTier,proto3" json:"ranking_in_tier,omitempty"`
-	MmrChange     int32                  `protobuf:"varint,7,opt,name=mmr_change,json=mmrChange,proto3" json:"mmr_change,omitempty"`
-	Kills         int32                  `protobuf:"varint,8,opt,name=kills,proto3" json:"kills,omitempty"`
-	Deaths        int32                  `protobuf:"varint,9,opt,name=deaths,proto3" json:"deaths,omitempty"`
-	Assists       int32                  `protobuf:"varint,10,opt,name=assists,proto3" json:"assists,omitempty"`
-	Score         int32                  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
-	Team          string                 `protobuf:"bytes,12,opt,name=team,proto3" json:"team,omitempty"`
-	HasWon        bool                   `protobuf:"varint,13,opt,name=has_won,json=hasWon,proto3" json:"has_won,omitempty"`
-	Source        string                 `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`
-	TeamRedScore  int32                  `protobuf:"varint,15,opt,name=team_red_score,json=teamRedScore,proto3" json:"team_red_score,omitempty"`
-	TeamBlueScore int32                  `protobuf:"varint,16,opt,name=team_blue_score,json=teamBlueScore,proto3" json:"team_blue_score,omitempty"`
-	Cluster       string                 `protobuf:"bytes,17,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Version       string                 `protobuf:"bytes,18,opt,name=version,proto3" json:"version,omitempty"`
-	MapId         string                 `protobuf:"bytes,19,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	CharacterId   string                 `protobuf:"bytes,20,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	DamageTaken   int32                  `protobuf:"varint,21,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
-	DamageDealt   int32                  `protobuf:"varint,22,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+type TiltSplit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Games   int32                  `protobuf:"varint,1,opt,name=games,proto3" json:"games,omitempty"`
+	WinRate float32                `protobuf:"fixed32,2,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	KdRatio float32                `protobuf:"fixed32,3,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// unset when none of the games are rated
+	AvgRating     *float32 `protobuf:"fixed32,4,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Match) Reset() {
-	*x = Match{}
+func (x *TiltSplit) Reset() {
+	*x = TiltSplit{}
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Match) String() string {
+func (x *TiltSplit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Match) ProtoMessage() {}
+func (*TiltSplit) ProtoMessage() {}
 
-func (x *Match) ProtoReflect() protoreflect.Message {
+func (x *TiltSplit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,187 +482,7483 @@ func (x *Match) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Match.ProtoReflect.Descriptor instead.
-func (*Match) Descriptor() ([]byte, []int) {
+// Deprecated: Use TiltSplit.ProtoReflect.Descriptor instead.
+func (*TiltSplit) Descriptor() ([]byte, []int) {
 	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Match) GetMatchId() string {
+func (x *TiltSplit) GetGames() int32 {
 	if x != nil {
-		return x.MatchId
+		return x.Games
 	}
-	return ""
+	return 0
 }
 
-func (x *Match) GetMapName() string {
+func (x *TiltSplit) GetWinRate() float32 {
 	if x != nil {
-		return x.MapName
+		return x.WinRate
 	}
-	return ""
+	return 0
 }
 
-func (x *Match) GetMode() string {
+func (x *TiltSplit) GetKdRatio() float32 {
 	if x != nil {
-		return x.Mode
+		return x.KdRatio
 	}
-	return ""
+	return 0
 }
 
-func (x *Match) GetStartedAt() string {
-	if x != nil {
-		return x.StartedAt
+func (x *TiltSplit) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
 	}
-	return ""
+	return 0
 }
 
-func (x *Match) GetTier() *Tier {
-	if x != nil {
-		return x.Tier
-	}
-	return nil
+// games queued after a loss streak in the same session against the other games
+type Tilt struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AfterLosses int32                  `protobuf:"varint,1,opt,name=after_losses,json=afterLosses,proto3" json:"after_losses,omitempty"`
+	Baseline    *TiltSplit             `protobuf:"bytes,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	AfterStreak *TiltSplit             `protobuf:"bytes,3,opt,name=after_streak,json=afterStreak,proto3" json:"after_streak,omitempty"`
+	// both win rate and rating dropped, KD when the games aren't rated
+	Tilted bool `protobuf:"varint,4,opt,name=tilted,proto3" json:"tilted,omitempty"`
+	// empty when there aren't enough games on either side
+	Insight       string `protobuf:"bytes,5,opt,name=insight,proto3" json:"insight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Match) GetRankingInTier() int32 {
-	if x != nil {
-		return x.RankingInTier
-	}
-	return 0
+func (x *Tilt) Reset() {
+	*x = Tilt{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Match) GetMmrChange() int32 {
-	if x != nil {
-		return x.MmrChange
-	}
-	return 0
+func (x *Tilt) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Match) GetKills() int32 {
+func (*Tilt) ProtoMessage() {}
+
+func (x *Tilt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[5]
 	if x != nil {
-		return x.Kills
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Match) GetDeaths() int32 {
-	if x != nil {
-		return x.Deaths
-	}
-	return 0
+// Deprecated: Use Tilt.ProtoReflect.Descriptor instead.
+func (*Tilt) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Match) GetAssists() int32 {
+func (x *Tilt) GetAfterLosses() int32 {
 	if x != nil {
-		return x.Assists
+		return x.AfterLosses
 	}
 	return 0
 }
 
-func (x *Match) GetScore() int32 {
+func (x *Tilt) GetBaseline() *TiltSplit {
 	if x != nil {
-		return x.Score
+		return x.Baseline
 	}
-	return 0
+	return nil
 }
 
-func (x *Match) GetTeam() string {
+func (x *Tilt) GetAfterStreak() *TiltSplit {
 	if x != nil {
-		return x.Team
+		return x.AfterStreak
 	}
-	return ""
+	return nil
 }
 
-func (x *Match) GetHasWon() bool {
+func (x *Tilt) GetTilted() bool {
 	if x != nil {
-		return x.HasWon
+		return x.Tilted
 	}
 	return false
 }
 
-func (x *Match) GetSource() string {
+func (x *Tilt) GetInsight() string {
 	if x != nil {
-		return x.Source
+		return x.Insight
 	}
 	return ""
 }
 
-func (x *Match) GetTeamRedScore() int32 {
-	if x != nil {
-		return x.TeamRedScore
-	}
-	return 0
+type Tier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Match) GetTeamBlueScore() int32 {
-	if x != nil {
-		return x.TeamBlueScore
-	}
-	return 0
+func (x *Tier) Reset() {
+	*x = Tier{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Match) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
+func (x *Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Match) GetVersion() string {
+func (*Tier) ProtoMessage() {}
+
+func (x *Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[6]
 	if x != nil {
-		return x.Version
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Match) GetMapId() string {
-	if x != nil {
+// Deprecated: Use Tier.ProtoReflect.Descriptor instead.
+func (*Tier) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Tier) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchesRequest) Reset() {
+	*x = MatchesRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchesRequest) ProtoMessage() {}
+
+func (x *MatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchesRequest.ProtoReflect.Descriptor instead.
+func (*MatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MatchesRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *MatchesRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type Match struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	MapName       string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Tier          *Tier                  `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
+	RankingInTier int32                  `protobuf:"varint,6,opt,name=ranking_in_tier,json=rankingInTier,proto3" json:"ranking_in_tier,omitempty"`
+	MmrChange     int32                  `protobuf:"varint,7,opt,name=mmr_change,json=mmrChange,proto3" json:"mmr_change,omitempty"`
+	Kills         int32                  `protobuf:"varint,8,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths        int32                  `protobuf:"varint,9,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists       int32                  `protobuf:"varint,10,opt,name=assists,proto3" json:"assists,omitempty"`
+	Score         int32                  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
+	Team          string                 `protobuf:"bytes,12,opt,name=team,proto3" json:"team,omitempty"`
+	HasWon        bool                   `protobuf:"varint,13,opt,name=has_won,json=hasWon,proto3" json:"has_won,omitempty"`
+	Source        string                 `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`
+	TeamRedScore  int32                  `protobuf:"varint,15,opt,name=team_red_score,json=teamRedScore,proto3" json:"team_red_score,omitempty"`
+	TeamBlueScore int32                  `protobuf:"varint,16,opt,name=team_blue_score,json=teamBlueScore,proto3" json:"team_blue_score,omitempty"`
+	Cluster       string                 `protobuf:"bytes,17,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Version       string                 `protobuf:"bytes,18,opt,name=version,proto3" json:"version,omitempty"`
+	MapId         string                 `protobuf:"bytes,19,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,20,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	DamageTaken   int32                  `protobuf:"varint,21,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	DamageDealt   int32                  `protobuf:"varint,22,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	Acs           float32                `protobuf:"fixed32,23,opt,name=acs,proto3" json:"acs,omitempty"`
+	Adr           float32                `protobuf:"fixed32,24,opt,name=adr,proto3" json:"adr,omitempty"`
+	// 0-1; unset until the match's round data is stored
+	Kast        *float32 `protobuf:"fixed32,25,opt,name=kast,proto3,oneof" json:"kast,omitempty"`
+	FirstBloods *int32   `protobuf:"varint,26,opt,name=first_bloods,json=firstBloods,proto3,oneof" json:"first_bloods,omitempty"`
+	FirstDeaths *int32   `protobuf:"varint,27,opt,name=first_deaths,json=firstDeaths,proto3,oneof" json:"first_deaths,omitempty"`
+	PlusMinus   int32    `protobuf:"varint,28,opt,name=plus_minus,json=plusMinus,proto3" json:"plus_minus,omitempty"`
+	// 0-1000 relative to the lobby, 500 is average; unset when the lobby wasn't known
+	Rating        *float32   `protobuf:"fixed32,29,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	LobbyRank     *LobbyRank `protobuf:"bytes,30,opt,name=lobby_rank,json=lobbyRank,proto3" json:"lobby_rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Match) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *Match) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *Match) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Match) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Match) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *Match) GetRankingInTier() int32 {
+	if x != nil {
+		return x.RankingInTier
+	}
+	return 0
+}
+
+func (x *Match) GetMmrChange() int32 {
+	if x != nil {
+		return x.MmrChange
+	}
+	return 0
+}
+
+func (x *Match) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *Match) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *Match) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *Match) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Match) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Match) GetHasWon() bool {
+	if x != nil {
+		return x.HasWon
+	}
+	return false
+}
+
+func (x *Match) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Match) GetTeamRedScore() int32 {
+	if x != nil {
+		return x.TeamRedScore
+	}
+	return 0
+}
+
+func (x *Match) GetTeamBlueScore() int32 {
+	if x != nil {
+		return x.TeamBlueScore
+	}
+	return 0
+}
+
+func (x *Match) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *Match) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Match) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *Match) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *Match) GetDamageTaken() int32 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *Match) GetDamageDealt() int32 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *Match) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *Match) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *Match) GetKast() float32 {
+	if x != nil && x.Kast != nil {
+		return *x.Kast
+	}
+	return 0
+}
+
+func (x *Match) GetFirstBloods() int32 {
+	if x != nil && x.FirstBloods != nil {
+		return *x.FirstBloods
+	}
+	return 0
+}
+
+func (x *Match) GetFirstDeaths() int32 {
+	if x != nil && x.FirstDeaths != nil {
+		return *x.FirstDeaths
+	}
+	return 0
+}
+
+func (x *Match) GetPlusMinus() int32 {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return 0
+}
+
+func (x *Match) GetRating() float32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *Match) GetLobbyRank() *LobbyRank {
+	if x != nil {
+		return x.LobbyRank
+	}
+	return nil
+}
+
+type MatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchesResponse) Reset() {
+	*x = MatchesResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchesResponse) ProtoMessage() {}
+
+func (x *MatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchesResponse.ProtoReflect.Descriptor instead.
+func (*MatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type SearchSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestionsRequest) Reset() {
+	*x = SearchSuggestionsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestionsRequest) ProtoMessage() {}
+
+func (x *SearchSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchSuggestionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*PlayerResponse      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestionsResponse) Reset() {
+	*x = SearchSuggestionsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestionsResponse) ProtoMessage() {}
+
+func (x *SearchSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchSuggestionsResponse) GetSuggestions() []*PlayerResponse {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type PlayerMatch struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Puuid       string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag         string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Agent       string                 `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
+	Kills       int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths      int32                  `protobuf:"varint,6,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists     int32                  `protobuf:"varint,7,opt,name=assists,proto3" json:"assists,omitempty"`
+	Score       int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	HasWon      bool                   `protobuf:"varint,9,opt,name=has_won,json=hasWon,proto3" json:"has_won,omitempty"`
+	Team        string                 `protobuf:"bytes,10,opt,name=team,proto3" json:"team,omitempty"`
+	Tier        *Tier                  `protobuf:"bytes,11,opt,name=tier,proto3" json:"tier,omitempty"`
+	CharacterId string                 `protobuf:"bytes,12,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	DamageTaken int32                  `protobuf:"varint,13,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	DamageDealt int32                  `protobuf:"varint,14,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	Acs         float32                `protobuf:"fixed32,15,opt,name=acs,proto3" json:"acs,omitempty"`
+	Adr         float32                `protobuf:"fixed32,16,opt,name=adr,proto3" json:"adr,omitempty"`
+	// 0-1; unset until the match's round data is stored
+	Kast        *float32 `protobuf:"fixed32,17,opt,name=kast,proto3,oneof" json:"kast,omitempty"`
+	FirstBloods *int32   `protobuf:"varint,18,opt,name=first_bloods,json=firstBloods,proto3,oneof" json:"first_bloods,omitempty"`
+	FirstDeaths *int32   `protobuf:"varint,19,opt,name=first_deaths,json=firstDeaths,proto3,oneof" json:"first_deaths,omitempty"`
+	PlusMinus   int32    `protobuf:"varint,20,opt,name=plus_minus,json=plusMinus,proto3" json:"plus_minus,omitempty"`
+	// 0-1000 relative to the lobby, 500 is average
+	Rating *float32 `protobuf:"fixed32,21,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	// 0-100 smurf or boost suspicion, only sent with admin credentials and unset when too little
+	// of it could be checked
+	SuspicionScore *float32 `protobuf:"fixed32,22,opt,name=suspicion_score,json=suspicionScore,proto3,oneof" json:"suspicion_score,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerMatch) Reset() {
+	*x = PlayerMatch{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMatch) ProtoMessage() {}
+
+func (x *PlayerMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMatch.ProtoReflect.Descriptor instead.
+func (*PlayerMatch) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerMatch) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetHasWon() bool {
+	if x != nil {
+		return x.HasWon
+	}
+	return false
+}
+
+func (x *PlayerMatch) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *PlayerMatch) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *PlayerMatch) GetDamageTaken() int32 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetDamageDealt() int32 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetKast() float32 {
+	if x != nil && x.Kast != nil {
+		return *x.Kast
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetFirstBloods() int32 {
+	if x != nil && x.FirstBloods != nil {
+		return *x.FirstBloods
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetFirstDeaths() int32 {
+	if x != nil && x.FirstDeaths != nil {
+		return *x.FirstDeaths
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetPlusMinus() int32 {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetRating() float32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerMatch) GetSuspicionScore() float32 {
+	if x != nil && x.SuspicionScore != nil {
+		return *x.SuspicionScore
+	}
+	return 0
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetMatchResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *MatchMetadata         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// grouped by team, highest score first
+	Players       []*PlayerMatch `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Awards        []*MatchAward  `protobuf:"bytes,3,rep,name=awards,proto3" json:"awards,omitempty"`
+	LobbyRank     *LobbyRank     `protobuf:"bytes,4,opt,name=lobby_rank,json=lobbyRank,proto3" json:"lobby_rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchResponse) Reset() {
+	*x = GetMatchResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchResponse) ProtoMessage() {}
+
+func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMatchResponse) GetMetadata() *MatchMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetMatchResponse) GetPlayers() []*PlayerMatch {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetMatchResponse) GetAwards() []*MatchAward {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+func (x *GetMatchResponse) GetLobbyRank() *LobbyRank {
+	if x != nil {
+		return x.LobbyRank
+	}
+	return nil
+}
+
+type TeamRank struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for the whole lobby or the opponents
+	Team string `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// over ranked players only, unset when none are
+	AvgTier       *float32 `protobuf:"fixed32,2,opt,name=avg_tier,json=avgTier,proto3,oneof" json:"avg_tier,omitempty"`
+	RankedPlayers int32    `protobuf:"varint,3,opt,name=ranked_players,json=rankedPlayers,proto3" json:"ranked_players,omitempty"`
+	StoredPlayers int32    `protobuf:"varint,4,opt,name=stored_players,json=storedPlayers,proto3" json:"stored_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamRank) Reset() {
+	*x = TeamRank{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRank) ProtoMessage() {}
+
+func (x *TeamRank) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRank.ProtoReflect.Descriptor instead.
+func (*TeamRank) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamRank) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamRank) GetAvgTier() float32 {
+	if x != nil && x.AvgTier != nil {
+		return *x.AvgTier
+	}
+	return 0
+}
+
+func (x *TeamRank) GetRankedPlayers() int32 {
+	if x != nil {
+		return x.RankedPlayers
+	}
+	return 0
+}
+
+func (x *TeamRank) GetStoredPlayers() int32 {
+	if x != nil {
+		return x.StoredPlayers
+	}
+	return 0
+}
+
+type LobbyRank struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// by team name
+	Teams []*TeamRank `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Lobby *TeamRank   `protobuf:"bytes,2,opt,name=lobby,proto3" json:"lobby,omitempty"`
+	// seats in the mode, 0 when unknown. Fewer stored players means only part of the lobby is known.
+	ExpectedPlayers int32 `protobuf:"varint,3,opt,name=expected_players,json=expectedPlayers,proto3" json:"expected_players,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LobbyRank) Reset() {
+	*x = LobbyRank{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbyRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyRank) ProtoMessage() {}
+
+func (x *LobbyRank) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyRank.ProtoReflect.Descriptor instead.
+func (*LobbyRank) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LobbyRank) GetTeams() []*TeamRank {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *LobbyRank) GetLobby() *TeamRank {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+func (x *LobbyRank) GetExpectedPlayers() int32 {
+	if x != nil {
+		return x.ExpectedPlayers
+	}
+	return 0
+}
+
+type MatchAward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// match_mvp, team_mvp, most_first_bloods, highest_damage or best_clutch
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Puuid string `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// rating or combat score for the MVPs, damage, first bloods, or enemies left for a clutch
+	Value         float32 `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchAward) Reset() {
+	*x = MatchAward{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchAward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchAward) ProtoMessage() {}
+
+func (x *MatchAward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchAward.ProtoReflect.Descriptor instead.
+func (*MatchAward) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MatchAward) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MatchAward) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *MatchAward) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type MatchMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	MapName       string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	MapId         string                 `protobuf:"bytes,3,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	GameVersion   string                 `protobuf:"bytes,4,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
+	TeamRedScore  int32                  `protobuf:"varint,5,opt,name=team_red_score,json=teamRedScore,proto3" json:"team_red_score,omitempty"`
+	TeamBlueScore int32                  `protobuf:"varint,6,opt,name=team_blue_score,json=teamBlueScore,proto3" json:"team_blue_score,omitempty"`
+	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+	Cluster       string                 `protobuf:"bytes,8,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Mode          string                 `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`
+	SeasonId      string                 `protobuf:"bytes,10,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	GameStart     int64                  `protobuf:"varint,11,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
+	RoundsPlayed  int32                  `protobuf:"varint,12,opt,name=rounds_played,json=roundsPlayed,proto3" json:"rounds_played,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchMetadata) Reset() {
+	*x = MatchMetadata{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchMetadata) ProtoMessage() {}
+
+func (x *MatchMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchMetadata.ProtoReflect.Descriptor instead.
+func (*MatchMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MatchMetadata) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetGameVersion() string {
+	if x != nil {
+		return x.GameVersion
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetTeamRedScore() int32 {
+	if x != nil {
+		return x.TeamRedScore
+	}
+	return 0
+}
+
+func (x *MatchMetadata) GetTeamBlueScore() int32 {
+	if x != nil {
+		return x.TeamBlueScore
+	}
+	return 0
+}
+
+func (x *MatchMetadata) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *MatchMetadata) GetGameStart() int64 {
+	if x != nil {
+		return x.GameStart
+	}
+	return 0
+}
+
+func (x *MatchMetadata) GetRoundsPlayed() int32 {
+	if x != nil {
+		return x.RoundsPlayed
+	}
+	return 0
+}
+
+type GetPlayerByPuuidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerByPuuidRequest) Reset() {
+	*x = GetPlayerByPuuidRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerByPuuidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByPuuidRequest) ProtoMessage() {}
+
+func (x *GetPlayerByPuuidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByPuuidRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerByPuuidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPlayerByPuuidRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetPlayerByPuuidRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type GetIntegrityReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// run a reconciliation pass now instead of returning the last report
+	Run           bool `protobuf:"varint,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIntegrityReportRequest) Reset() {
+	*x = GetIntegrityReportRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntegrityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrityReportRequest) ProtoMessage() {}
+
+func (x *GetIntegrityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetIntegrityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetIntegrityReportRequest) GetRun() bool {
+	if x != nil {
+		return x.Run
+	}
+	return false
+}
+
+type IntegrityIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	MatchId       string                 `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Puuid         string                 `protobuf:"bytes,3,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Repaired      bool                   `protobuf:"varint,5,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Deferred      bool                   `protobuf:"varint,6,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrityIssue) Reset() {
+	*x = IntegrityIssue{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrityIssue) ProtoMessage() {}
+
+func (x *IntegrityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrityIssue.ProtoReflect.Descriptor instead.
+func (*IntegrityIssue) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *IntegrityIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *IntegrityIssue) GetDeferred() bool {
+	if x != nil {
+		return x.Deferred
+	}
+	return false
+}
+
+type GetIntegrityReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt      int64                  `protobuf:"varint,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     int64                  `protobuf:"varint,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	MatchesScanned int32                  `protobuf:"varint,3,opt,name=matches_scanned,json=matchesScanned,proto3" json:"matches_scanned,omitempty"`
+	Repaired       int32                  `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Deferred       int32                  `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	Issues         []*IntegrityIssue      `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetIntegrityReportResponse) Reset() {
+	*x = GetIntegrityReportResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntegrityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrityReportResponse) ProtoMessage() {}
+
+func (x *GetIntegrityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetIntegrityReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetIntegrityReportResponse) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *GetIntegrityReportResponse) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *GetIntegrityReportResponse) GetMatchesScanned() int32 {
+	if x != nil {
+		return x.MatchesScanned
+	}
+	return 0
+}
+
+func (x *GetIntegrityReportResponse) GetRepaired() int32 {
+	if x != nil {
+		return x.Repaired
+	}
+	return 0
+}
+
+func (x *GetIntegrityReportResponse) GetDeferred() int32 {
+	if x != nil {
+		return x.Deferred
+	}
+	return 0
+}
+
+func (x *GetIntegrityReportResponse) GetIssues() []*IntegrityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// follower_id is an opaque id chosen by the client, e.g. a user or a team
+type FollowPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	Puuid         string                 `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPlayerRequest) Reset() {
+	*x = FollowPlayerRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPlayerRequest) ProtoMessage() {}
+
+func (x *FollowPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPlayerRequest.ProtoReflect.Descriptor instead.
+func (*FollowPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FollowPlayerRequest) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *FollowPlayerRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type FollowPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPlayerResponse) Reset() {
+	*x = FollowPlayerResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPlayerResponse) ProtoMessage() {}
+
+func (x *FollowPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPlayerResponse.ProtoReflect.Descriptor instead.
+func (*FollowPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{24}
+}
+
+type UnfollowPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	Puuid         string                 `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowPlayerRequest) Reset() {
+	*x = UnfollowPlayerRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowPlayerRequest) ProtoMessage() {}
+
+func (x *UnfollowPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnfollowPlayerRequest) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *UnfollowPlayerRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type UnfollowPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowPlayerResponse) Reset() {
+	*x = UnfollowPlayerResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowPlayerResponse) ProtoMessage() {}
+
+func (x *UnfollowPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowPlayerResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{26}
+}
+
+type GetFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRequest) ProtoMessage() {}
+
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFeedRequest) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *GetFeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FeedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "match", "rank_change" or "milestone"
+	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	OccurredAt string          `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Player     *PlayerResponse `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`
+	Match      *Match          `protobuf:"bytes,5,opt,name=match,proto3" json:"match,omitempty"`
+	// set for rank changes, match.tier holds the new tier
+	PreviousTier  *Tier  `protobuf:"bytes,6,opt,name=previous_tier,json=previousTier,proto3" json:"previous_tier,omitempty"`
+	Milestone     string `protobuf:"bytes,7,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedItem) Reset() {
+	*x = FeedItem{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedItem) ProtoMessage() {}
+
+func (x *FeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
+func (*FeedItem) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FeedItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FeedItem) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *FeedItem) GetPlayer() *PlayerResponse {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *FeedItem) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *FeedItem) GetPreviousTier() *Tier {
+	if x != nil {
+		return x.PreviousTier
+	}
+	return nil
+}
+
+func (x *FeedItem) GetMilestone() string {
+	if x != nil {
+		return x.Milestone
+	}
+	return ""
+}
+
+type GetFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*FeedItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// empty when there are no more items
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedResponse) ProtoMessage() {}
+
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFeedResponse) GetItems() []*FeedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetFeedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type WatchPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPlayerRequest) Reset() {
+	*x = WatchPlayerRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlayerRequest) ProtoMessage() {}
+
+func (x *WatchPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlayerRequest.ProtoReflect.Descriptor instead.
+func (*WatchPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchPlayerRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type RRChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousTier  *Tier                  `protobuf:"bytes,1,opt,name=previous_tier,json=previousTier,proto3" json:"previous_tier,omitempty"`
+	PreviousRr    int32                  `protobuf:"varint,2,opt,name=previous_rr,json=previousRr,proto3" json:"previous_rr,omitempty"`
+	Tier          *Tier                  `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Rr            int32                  `protobuf:"varint,4,opt,name=rr,proto3" json:"rr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RRChange) Reset() {
+	*x = RRChange{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RRChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RRChange) ProtoMessage() {}
+
+func (x *RRChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RRChange.ProtoReflect.Descriptor instead.
+func (*RRChange) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RRChange) GetPreviousTier() *Tier {
+	if x != nil {
+		return x.PreviousTier
+	}
+	return nil
+}
+
+func (x *RRChange) GetPreviousRr() int32 {
+	if x != nil {
+		return x.PreviousRr
+	}
+	return 0
+}
+
+func (x *RRChange) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *RRChange) GetRr() int32 {
+	if x != nil {
+		return x.Rr
+	}
+	return 0
+}
+
+type RefreshStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "refreshing", "refreshed", "failed" or "throttled"
+	State         string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	NextRefreshAt int64  `protobuf:"varint,2,opt,name=next_refresh_at,json=nextRefreshAt,proto3" json:"next_refresh_at,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshStatus) Reset() {
+	*x = RefreshStatus{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatus) ProtoMessage() {}
+
+func (x *RefreshStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatus.ProtoReflect.Descriptor instead.
+func (*RefreshStatus) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RefreshStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RefreshStatus) GetNextRefreshAt() int64 {
+	if x != nil {
+		return x.NextRefreshAt
+	}
+	return 0
+}
+
+func (x *RefreshStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WatchPlayerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*WatchPlayerResponse_Snapshot
+	//	*WatchPlayerResponse_NewMatch
+	//	*WatchPlayerResponse_RrChange
+	//	*WatchPlayerResponse_RefreshStatus
+	Update        isWatchPlayerResponse_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPlayerResponse) Reset() {
+	*x = WatchPlayerResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlayerResponse) ProtoMessage() {}
+
+func (x *WatchPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlayerResponse.ProtoReflect.Descriptor instead.
+func (*WatchPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchPlayerResponse) GetUpdate() isWatchPlayerResponse_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *WatchPlayerResponse) GetSnapshot() *PlayerResponse {
+	if x != nil {
+		if x, ok := x.Update.(*WatchPlayerResponse_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *WatchPlayerResponse) GetNewMatch() *Match {
+	if x != nil {
+		if x, ok := x.Update.(*WatchPlayerResponse_NewMatch); ok {
+			return x.NewMatch
+		}
+	}
+	return nil
+}
+
+func (x *WatchPlayerResponse) GetRrChange() *RRChange {
+	if x != nil {
+		if x, ok := x.Update.(*WatchPlayerResponse_RrChange); ok {
+			return x.RrChange
+		}
+	}
+	return nil
+}
+
+func (x *WatchPlayerResponse) GetRefreshStatus() *RefreshStatus {
+	if x != nil {
+		if x, ok := x.Update.(*WatchPlayerResponse_RefreshStatus); ok {
+			return x.RefreshStatus
+		}
+	}
+	return nil
+}
+
+type isWatchPlayerResponse_Update interface {
+	isWatchPlayerResponse_Update()
+}
+
+type WatchPlayerResponse_Snapshot struct {
+	// always the first message
+	Snapshot *PlayerResponse `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchPlayerResponse_NewMatch struct {
+	NewMatch *Match `protobuf:"bytes,2,opt,name=new_match,json=newMatch,proto3,oneof"`
+}
+
+type WatchPlayerResponse_RrChange struct {
+	RrChange *RRChange `protobuf:"bytes,3,opt,name=rr_change,json=rrChange,proto3,oneof"`
+}
+
+type WatchPlayerResponse_RefreshStatus struct {
+	RefreshStatus *RefreshStatus `protobuf:"bytes,4,opt,name=refresh_status,json=refreshStatus,proto3,oneof"`
+}
+
+func (*WatchPlayerResponse_Snapshot) isWatchPlayerResponse_Update() {}
+
+func (*WatchPlayerResponse_NewMatch) isWatchPlayerResponse_Update() {}
+
+func (*WatchPlayerResponse_RrChange) isWatchPlayerResponse_Update() {}
+
+func (*WatchPlayerResponse_RefreshStatus) isWatchPlayerResponse_Update() {}
+
+type GetSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// max minutes between two match starts in one session, defaults to 60
+	GapMinutes int32 `protobuf:"varint,2,opt,name=gap_minutes,json=gapMinutes,proto3" json:"gap_minutes,omitempty"`
+	// only sessions that ended in the last 24 hours
+	Today         bool  `protobuf:"varint,3,opt,name=today,proto3" json:"today,omitempty"`
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionsRequest) Reset() {
+	*x = GetSessionsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsRequest) ProtoMessage() {}
+
+func (x *GetSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSessionsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetSessionsRequest) GetGapMinutes() int32 {
+	if x != nil {
+		return x.GapMinutes
+	}
+	return 0
+}
+
+func (x *GetSessionsRequest) GetToday() bool {
+	if x != nil {
+		return x.Today
+	}
+	return false
+}
+
+func (x *GetSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SessionAgent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Matches       int32                  `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAgent) Reset() {
+	*x = SessionAgent{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAgent) ProtoMessage() {}
+
+func (x *SessionAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAgent.ProtoReflect.Descriptor instead.
+func (*SessionAgent) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SessionAgent) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionAgent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionAgent) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type Session struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt string                 `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// estimated from the last match start, game length isn't stored
+	EndedAt         string          `protobuf:"bytes,2,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	DurationSeconds int64           `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Matches         int32           `protobuf:"varint,4,opt,name=matches,proto3" json:"matches,omitempty"`
+	Wins            int32           `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses          int32           `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws           int32           `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	Kills           int32           `protobuf:"varint,8,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths          int32           `protobuf:"varint,9,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists         int32           `protobuf:"varint,10,opt,name=assists,proto3" json:"assists,omitempty"`
+	KdRatio         float32         `protobuf:"fixed32,11,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	NetRr           int32           `protobuf:"varint,12,opt,name=net_rr,json=netRr,proto3" json:"net_rr,omitempty"`
+	Agents          []*SessionAgent `protobuf:"bytes,13,rep,name=agents,proto3" json:"agents,omitempty"`
+	StartTier       *Tier           `protobuf:"bytes,14,opt,name=start_tier,json=startTier,proto3" json:"start_tier,omitempty"`
+	EndTier         *Tier           `protobuf:"bytes,15,opt,name=end_tier,json=endTier,proto3" json:"end_tier,omitempty"`
+	// newest first
+	MatchIds []string `protobuf:"bytes,16,rep,name=match_ids,json=matchIds,proto3" json:"match_ids,omitempty"`
+	Streaks  *Streaks `protobuf:"bytes,17,opt,name=streaks,proto3" json:"streaks,omitempty"`
+	// games queued after 3 straight losses
+	TiltGames     int32 `protobuf:"varint,18,opt,name=tilt_games,json=tiltGames,proto3" json:"tilt_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Session) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Session) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *Session) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Session) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *Session) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Session) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Session) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *Session) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *Session) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *Session) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *Session) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *Session) GetNetRr() int32 {
+	if x != nil {
+		return x.NetRr
+	}
+	return 0
+}
+
+func (x *Session) GetAgents() []*SessionAgent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *Session) GetStartTier() *Tier {
+	if x != nil {
+		return x.StartTier
+	}
+	return nil
+}
+
+func (x *Session) GetEndTier() *Tier {
+	if x != nil {
+		return x.EndTier
+	}
+	return nil
+}
+
+func (x *Session) GetMatchIds() []string {
+	if x != nil {
+		return x.MatchIds
+	}
+	return nil
+}
+
+func (x *Session) GetStreaks() *Streaks {
+	if x != nil {
+		return x.Streaks
+	}
+	return nil
+}
+
+func (x *Session) GetTiltGames() int32 {
+	if x != nil {
+		return x.TiltGames
+	}
+	return 0
+}
+
+type GetSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// newest first
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// over every stored session
+	Tilt          *Tilt `protobuf:"bytes,2,opt,name=tilt,proto3" json:"tilt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionsResponse) Reset() {
+	*x = GetSessionsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionsResponse) ProtoMessage() {}
+
+func (x *GetSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *GetSessionsResponse) GetTilt() *Tilt {
+	if x != nil {
+		return x.Tilt
+	}
+	return nil
+}
+
+// empty fields don't filter
+type StatsFilter struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Mode     string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// RFC3339, inclusive
+	StartedAfter string `protobuf:"bytes,3,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	// RFC3339, exclusive
+	StartedBefore string `protobuf:"bytes,4,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
+	CharacterId   string `protobuf:"bytes,5,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// normalized patch such as 8.11, see ListPatches
+	Patch         string `protobuf:"bytes,6,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsFilter) Reset() {
+	*x = StatsFilter{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsFilter) ProtoMessage() {}
+
+func (x *StatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsFilter.ProtoReflect.Descriptor instead.
+func (*StatsFilter) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StatsFilter) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetStartedAfter() string {
+	if x != nil {
+		return x.StartedAfter
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetStartedBefore() string {
+	if x != nil {
+		return x.StartedBefore
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *StatsFilter) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+type GetAgentStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Puuid  string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// one row per patch and agent, newest patch first
+	GroupByPatch  bool `protobuf:"varint,3,opt,name=group_by_patch,json=groupByPatch,proto3" json:"group_by_patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsRequest) Reset() {
+	*x = GetAgentStatsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsRequest) ProtoMessage() {}
+
+func (x *GetAgentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAgentStatsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetAgentStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetAgentStatsRequest) GetGroupByPatch() bool {
+	if x != nil {
+		return x.GroupByPatch
+	}
+	return false
+}
+
+type AgentStats struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Games       int32                  `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Wins        int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate     float32                `protobuf:"fixed32,5,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	Kills       int32                  `protobuf:"varint,6,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths      int32                  `protobuf:"varint,7,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists     int32                  `protobuf:"varint,8,opt,name=assists,proto3" json:"assists,omitempty"`
+	KdRatio     float32                `protobuf:"fixed32,9,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	Adr         float32                `protobuf:"fixed32,10,opt,name=adr,proto3" json:"adr,omitempty"`
+	AvgScore    float32                `protobuf:"fixed32,11,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	// over games with an RR record only
+	AvgRrChange float32 `protobuf:"fixed32,12,opt,name=avg_rr_change,json=avgRrChange,proto3" json:"avg_rr_change,omitempty"`
+	// set when grouped by patch
+	Patch         string `protobuf:"bytes,13,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentStats) Reset() {
+	*x = AgentStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentStats) ProtoMessage() {}
+
+func (x *AgentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentStats.ProtoReflect.Descriptor instead.
+func (*AgentStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AgentStats) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *AgentStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *AgentStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *AgentStats) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *AgentStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *AgentStats) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *AgentStats) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *AgentStats) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *AgentStats) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *AgentStats) GetAvgScore() float32 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *AgentStats) GetAvgRrChange() float32 {
+	if x != nil {
+		return x.AvgRrChange
+	}
+	return 0
+}
+
+func (x *AgentStats) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+type GetAgentStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most played first
+	Agents        []*AgentStats `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsResponse) Reset() {
+	*x = GetAgentStatsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsResponse) ProtoMessage() {}
+
+func (x *GetAgentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAgentStatsResponse) GetAgents() []*AgentStats {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type GetMapStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Puuid  string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// one row per patch and map, newest patch first
+	GroupByPatch  bool `protobuf:"varint,3,opt,name=group_by_patch,json=groupByPatch,proto3" json:"group_by_patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMapStatsRequest) Reset() {
+	*x = GetMapStatsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapStatsRequest) ProtoMessage() {}
+
+func (x *GetMapStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMapStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMapStatsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetMapStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetMapStatsRequest) GetGroupByPatch() bool {
+	if x != nil {
+		return x.GroupByPatch
+	}
+	return false
+}
+
+type MapStats struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MapId        string                 `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	MapName      string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Games        int32                  `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Wins         int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate      float32                `protobuf:"fixed32,5,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	RoundsWon    int32                  `protobuf:"varint,6,opt,name=rounds_won,json=roundsWon,proto3" json:"rounds_won,omitempty"`
+	Rounds       int32                  `protobuf:"varint,7,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	RoundWinRate float32                `protobuf:"fixed32,8,opt,name=round_win_rate,json=roundWinRate,proto3" json:"round_win_rate,omitempty"`
+	KdRatio      float32                `protobuf:"fixed32,9,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// average combat score
+	Acs float32 `protobuf:"fixed32,10,opt,name=acs,proto3" json:"acs,omitempty"`
+	// unset until round data is stored for the matches
+	AttackRoundWinRate  *float32 `protobuf:"fixed32,11,opt,name=attack_round_win_rate,json=attackRoundWinRate,proto3,oneof" json:"attack_round_win_rate,omitempty"`
+	DefenseRoundWinRate *float32 `protobuf:"fixed32,12,opt,name=defense_round_win_rate,json=defenseRoundWinRate,proto3,oneof" json:"defense_round_win_rate,omitempty"`
+	// set when grouped by patch
+	Patch         string `protobuf:"bytes,13,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapStats) Reset() {
+	*x = MapStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStats) ProtoMessage() {}
+
+func (x *MapStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStats.ProtoReflect.Descriptor instead.
+func (*MapStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MapStats) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *MapStats) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *MapStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *MapStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *MapStats) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *MapStats) GetRoundsWon() int32 {
+	if x != nil {
+		return x.RoundsWon
+	}
+	return 0
+}
+
+func (x *MapStats) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *MapStats) GetRoundWinRate() float32 {
+	if x != nil {
+		return x.RoundWinRate
+	}
+	return 0
+}
+
+func (x *MapStats) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *MapStats) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *MapStats) GetAttackRoundWinRate() float32 {
+	if x != nil && x.AttackRoundWinRate != nil {
+		return *x.AttackRoundWinRate
+	}
+	return 0
+}
+
+func (x *MapStats) GetDefenseRoundWinRate() float32 {
+	if x != nil && x.DefenseRoundWinRate != nil {
+		return *x.DefenseRoundWinRate
+	}
+	return 0
+}
+
+func (x *MapStats) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+type GetMapStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most played first
+	Maps          []*MapStats `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMapStatsResponse) Reset() {
+	*x = GetMapStatsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapStatsResponse) ProtoMessage() {}
+
+func (x *GetMapStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMapStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMapStatsResponse) GetMaps() []*MapStats {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+type GetOverallStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Puuid  string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// one row per patch, newest first
+	GroupByPatch  bool `protobuf:"varint,3,opt,name=group_by_patch,json=groupByPatch,proto3" json:"group_by_patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverallStatsRequest) Reset() {
+	*x = GetOverallStatsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverallStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverallStatsRequest) ProtoMessage() {}
+
+func (x *GetOverallStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverallStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetOverallStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetOverallStatsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetOverallStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetOverallStatsRequest) GetGroupByPatch() bool {
+	if x != nil {
+		return x.GroupByPatch
+	}
+	return false
+}
+
+type OverallStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// set when grouped by patch
+	Patch   string  `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	Games   int32   `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins    int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses  int32   `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws   int32   `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	WinRate float32 `protobuf:"fixed32,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	Kills   int32   `protobuf:"varint,7,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths  int32   `protobuf:"varint,8,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	Assists int32   `protobuf:"varint,9,opt,name=assists,proto3" json:"assists,omitempty"`
+	KdRatio float32 `protobuf:"fixed32,10,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// per round
+	Acs float32 `protobuf:"fixed32,11,opt,name=acs,proto3" json:"acs,omitempty"`
+	Adr float32 `protobuf:"fixed32,12,opt,name=adr,proto3" json:"adr,omitempty"`
+	// over games with an RR record only
+	RrChange      int32   `protobuf:"varint,13,opt,name=rr_change,json=rrChange,proto3" json:"rr_change,omitempty"`
+	AvgRrChange   float32 `protobuf:"fixed32,14,opt,name=avg_rr_change,json=avgRrChange,proto3" json:"avg_rr_change,omitempty"`
+	RatedGames    int32   `protobuf:"varint,15,opt,name=rated_games,json=ratedGames,proto3" json:"rated_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverallStats) Reset() {
+	*x = OverallStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverallStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverallStats) ProtoMessage() {}
+
+func (x *OverallStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverallStats.ProtoReflect.Descriptor instead.
+func (*OverallStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OverallStats) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *OverallStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *OverallStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *OverallStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *OverallStats) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *OverallStats) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *OverallStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *OverallStats) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *OverallStats) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *OverallStats) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *OverallStats) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *OverallStats) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *OverallStats) GetRrChange() int32 {
+	if x != nil {
+		return x.RrChange
+	}
+	return 0
+}
+
+func (x *OverallStats) GetAvgRrChange() float32 {
+	if x != nil {
+		return x.AvgRrChange
+	}
+	return 0
+}
+
+func (x *OverallStats) GetRatedGames() int32 {
+	if x != nil {
+		return x.RatedGames
+	}
+	return 0
+}
+
+type GetOverallStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty when no match is left after filtering
+	Stats         []*OverallStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverallStatsResponse) Reset() {
+	*x = GetOverallStatsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverallStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverallStatsResponse) ProtoMessage() {}
+
+func (x *GetOverallStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverallStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetOverallStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetOverallStatsResponse) GetStats() []*OverallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetClusterStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter        *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterStatsRequest) Reset() {
+	*x = GetClusterStatsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterStatsRequest) ProtoMessage() {}
+
+func (x *GetClusterStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetClusterStatsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetClusterStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ClusterStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// server location such as Frankfurt
+	Cluster string  `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Games   int32   `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins    int32   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses  int32   `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws   int32   `protobuf:"varint,5,opt,name=draws,proto3" json:"draws,omitempty"`
+	WinRate float32 `protobuf:"fixed32,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	KdRatio float32 `protobuf:"fixed32,7,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// per round
+	Acs float32 `protobuf:"fixed32,8,opt,name=acs,proto3" json:"acs,omitempty"`
+	Adr float32 `protobuf:"fixed32,9,opt,name=adr,proto3" json:"adr,omitempty"`
+	// over games with an RR record only, unset when there are none
+	AvgRrChange *float32 `protobuf:"fixed32,10,opt,name=avg_rr_change,json=avgRrChange,proto3,oneof" json:"avg_rr_change,omitempty"`
+	RatedGames  int32    `protobuf:"varint,11,opt,name=rated_games,json=ratedGames,proto3" json:"rated_games,omitempty"`
+	// unset when none of the games are rated
+	AvgRating     *float32 `protobuf:"fixed32,12,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterStats) Reset() {
+	*x = ClusterStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterStats) ProtoMessage() {}
+
+func (x *ClusterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterStats.ProtoReflect.Descriptor instead.
+func (*ClusterStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ClusterStats) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterStats) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetAdr() float32 {
+	if x != nil {
+		return x.Adr
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetAvgRrChange() float32 {
+	if x != nil && x.AvgRrChange != nil {
+		return *x.AvgRrChange
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetRatedGames() int32 {
+	if x != nil {
+		return x.RatedGames
+	}
+	return 0
+}
+
+func (x *ClusterStats) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
+	}
+	return 0
+}
+
+type GetClusterStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most played first, matches without a cluster are left out
+	Clusters []*ClusterStats `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// the same filter over all of the player's matches, to compare each cluster against
+	Overall       *OverallStats `protobuf:"bytes,2,opt,name=overall,proto3" json:"overall,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterStatsResponse) Reset() {
+	*x = GetClusterStatsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterStatsResponse) ProtoMessage() {}
+
+func (x *GetClusterStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetClusterStatsResponse) GetClusters() []*ClusterStats {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *GetClusterStatsResponse) GetOverall() *OverallStats {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+// empty fields don't filter
+type GetClusterDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Patch         string                 `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterDistributionRequest) Reset() {
+	*x = GetClusterDistributionRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterDistributionRequest) ProtoMessage() {}
+
+func (x *GetClusterDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetClusterDistributionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetClusterDistributionRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetClusterDistributionRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+type ClusterShare struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Cluster string                 `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Region  string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Matches int32                  `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
+	// share of the stored matches in the response
+	Share float32 `protobuf:"fixed32,4,opt,name=share,proto3" json:"share,omitempty"`
+	// distinct stored players
+	Players   int32   `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`
+	AvgRounds float32 `protobuf:"fixed32,6,opt,name=avg_rounds,json=avgRounds,proto3" json:"avg_rounds,omitempty"`
+	// over every rated player in those matches, unset when none are rated
+	AvgRating     *float32 `protobuf:"fixed32,7,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterShare) Reset() {
+	*x = ClusterShare{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterShare) ProtoMessage() {}
+
+func (x *ClusterShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterShare.ProtoReflect.Descriptor instead.
+func (*ClusterShare) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ClusterShare) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterShare) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ClusterShare) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *ClusterShare) GetShare() float32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *ClusterShare) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *ClusterShare) GetAvgRounds() float32 {
+	if x != nil {
+		return x.AvgRounds
+	}
+	return 0
+}
+
+func (x *ClusterShare) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
+	}
+	return 0
+}
+
+type GetClusterDistributionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most matches first
+	Clusters      []*ClusterShare `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Matches       int32           `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterDistributionResponse) Reset() {
+	*x = GetClusterDistributionResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterDistributionResponse) ProtoMessage() {}
+
+func (x *GetClusterDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetClusterDistributionResponse) GetClusters() []*ClusterShare {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *GetClusterDistributionResponse) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type GetWeaponStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter        *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeaponStatsRequest) Reset() {
+	*x = GetWeaponStatsRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeaponStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeaponStatsRequest) ProtoMessage() {}
+
+func (x *GetWeaponStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeaponStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetWeaponStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetWeaponStatsRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetWeaponStatsRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Kills come from the kill feed. Rounds are the ones the weapon was bought or kept for; shots
+// and damage cover those whole rounds, abilities included.
+type WeaponStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// every kill with the weapon, picked up ones included
+	Kills  int32 `protobuf:"varint,1,opt,name=kills,proto3" json:"kills,omitempty"`
+	Rounds int32 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// kills with the weapon in those rounds
+	RoundKills int32 `protobuf:"varint,3,opt,name=round_kills,json=roundKills,proto3" json:"round_kills,omitempty"`
+	// unset without round data
+	KillsPerRound  *float32 `protobuf:"fixed32,4,opt,name=kills_per_round,json=killsPerRound,proto3,oneof" json:"kills_per_round,omitempty"`
+	HeadshotRate   *float32 `protobuf:"fixed32,5,opt,name=headshot_rate,json=headshotRate,proto3,oneof" json:"headshot_rate,omitempty"`
+	DamagePerRound *float32 `protobuf:"fixed32,6,opt,name=damage_per_round,json=damagePerRound,proto3,oneof" json:"damage_per_round,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeaponStats) Reset() {
+	*x = WeaponStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponStats) ProtoMessage() {}
+
+func (x *WeaponStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponStats.ProtoReflect.Descriptor instead.
+func (*WeaponStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WeaponStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetRoundKills() int32 {
+	if x != nil {
+		return x.RoundKills
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetKillsPerRound() float32 {
+	if x != nil && x.KillsPerRound != nil {
+		return *x.KillsPerRound
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetHeadshotRate() float32 {
+	if x != nil && x.HeadshotRate != nil {
+		return *x.HeadshotRate
+	}
+	return 0
+}
+
+func (x *WeaponStats) GetDamagePerRound() float32 {
+	if x != nil && x.DamagePerRound != nil {
+		return *x.DamagePerRound
+	}
+	return 0
+}
+
+type WeaponMapStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapId         string                 `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	MapName       string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	Stats         *WeaponStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeaponMapStats) Reset() {
+	*x = WeaponMapStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponMapStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponMapStats) ProtoMessage() {}
+
+func (x *WeaponMapStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponMapStats.ProtoReflect.Descriptor instead.
+func (*WeaponMapStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WeaponMapStats) GetMapId() string {
+	if x != nil {
 		return x.MapId
 	}
 	return ""
 }
 
-func (x *Match) GetCharacterId() string {
+func (x *WeaponMapStats) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *WeaponMapStats) GetStats() *WeaponStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type WeaponSeasonStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// start of the first match with the weapon in the season
+	FirstPlayedAt string       `protobuf:"bytes,2,opt,name=first_played_at,json=firstPlayedAt,proto3" json:"first_played_at,omitempty"`
+	Stats         *WeaponStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeaponSeasonStats) Reset() {
+	*x = WeaponSeasonStats{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponSeasonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponSeasonStats) ProtoMessage() {}
+
+func (x *WeaponSeasonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponSeasonStats.ProtoReflect.Descriptor instead.
+func (*WeaponSeasonStats) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WeaponSeasonStats) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *WeaponSeasonStats) GetFirstPlayedAt() string {
+	if x != nil {
+		return x.FirstPlayedAt
+	}
+	return ""
+}
+
+func (x *WeaponSeasonStats) GetStats() *WeaponStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type WeaponBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lower case
+	WeaponId   string       `protobuf:"bytes,1,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	WeaponName string       `protobuf:"bytes,2,opt,name=weapon_name,json=weaponName,proto3" json:"weapon_name,omitempty"`
+	Stats      *WeaponStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	// most kills first
+	Maps []*WeaponMapStats `protobuf:"bytes,4,rep,name=maps,proto3" json:"maps,omitempty"`
+	// oldest first
+	Seasons       []*WeaponSeasonStats `protobuf:"bytes,5,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeaponBreakdown) Reset() {
+	*x = WeaponBreakdown{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponBreakdown) ProtoMessage() {}
+
+func (x *WeaponBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponBreakdown.ProtoReflect.Descriptor instead.
+func (*WeaponBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WeaponBreakdown) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+func (x *WeaponBreakdown) GetWeaponName() string {
+	if x != nil {
+		return x.WeaponName
+	}
+	return ""
+}
+
+func (x *WeaponBreakdown) GetStats() *WeaponStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *WeaponBreakdown) GetMaps() []*WeaponMapStats {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *WeaponBreakdown) GetSeasons() []*WeaponSeasonStats {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+type GetWeaponStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most kills first, only matches with a stored kill feed count
+	Weapons       []*WeaponBreakdown `protobuf:"bytes,1,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeaponStatsResponse) Reset() {
+	*x = GetWeaponStatsResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeaponStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeaponStatsResponse) ProtoMessage() {}
+
+func (x *GetWeaponStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeaponStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetWeaponStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetWeaponStatsResponse) GetWeapons() []*WeaponBreakdown {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+// empty fields don't filter
+type GetWeaponMetaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Patch         string                 `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeaponMetaRequest) Reset() {
+	*x = GetWeaponMetaRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeaponMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeaponMetaRequest) ProtoMessage() {}
+
+func (x *GetWeaponMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeaponMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetWeaponMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetWeaponMetaRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetWeaponMetaRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetWeaponMetaRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+type WeaponMeta struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WeaponId   string                 `protobuf:"bytes,1,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	WeaponName string                 `protobuf:"bytes,2,opt,name=weapon_name,json=weaponName,proto3" json:"weapon_name,omitempty"`
+	Kills      int32                  `protobuf:"varint,3,opt,name=kills,proto3" json:"kills,omitempty"`
+	// share of all kills in the response
+	KillShare float32 `protobuf:"fixed32,4,opt,name=kill_share,json=killShare,proto3" json:"kill_share,omitempty"`
+	Rounds    int32   `protobuf:"varint,5,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// share of all player rounds the weapon was bought or kept for
+	PickRate       float32  `protobuf:"fixed32,6,opt,name=pick_rate,json=pickRate,proto3" json:"pick_rate,omitempty"`
+	HeadshotRate   *float32 `protobuf:"fixed32,7,opt,name=headshot_rate,json=headshotRate,proto3,oneof" json:"headshot_rate,omitempty"`
+	DamagePerRound *float32 `protobuf:"fixed32,8,opt,name=damage_per_round,json=damagePerRound,proto3,oneof" json:"damage_per_round,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeaponMeta) Reset() {
+	*x = WeaponMeta{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeaponMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeaponMeta) ProtoMessage() {}
+
+func (x *WeaponMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeaponMeta.ProtoReflect.Descriptor instead.
+func (*WeaponMeta) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WeaponMeta) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+func (x *WeaponMeta) GetWeaponName() string {
+	if x != nil {
+		return x.WeaponName
+	}
+	return ""
+}
+
+func (x *WeaponMeta) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *WeaponMeta) GetKillShare() float32 {
+	if x != nil {
+		return x.KillShare
+	}
+	return 0
+}
+
+func (x *WeaponMeta) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *WeaponMeta) GetPickRate() float32 {
+	if x != nil {
+		return x.PickRate
+	}
+	return 0
+}
+
+func (x *WeaponMeta) GetHeadshotRate() float32 {
+	if x != nil && x.HeadshotRate != nil {
+		return *x.HeadshotRate
+	}
+	return 0
+}
+
+func (x *WeaponMeta) GetDamagePerRound() float32 {
+	if x != nil && x.DamagePerRound != nil {
+		return *x.DamagePerRound
+	}
+	return 0
+}
+
+type GetWeaponMetaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most kills first
+	Weapons       []*WeaponMeta `protobuf:"bytes,1,rep,name=weapons,proto3" json:"weapons,omitempty"`
+	Kills         int32         `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	PlayerRounds  int32         `protobuf:"varint,3,opt,name=player_rounds,json=playerRounds,proto3" json:"player_rounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeaponMetaResponse) Reset() {
+	*x = GetWeaponMetaResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeaponMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeaponMetaResponse) ProtoMessage() {}
+
+func (x *GetWeaponMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeaponMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetWeaponMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetWeaponMetaResponse) GetWeapons() []*WeaponMeta {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+func (x *GetWeaponMetaResponse) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *GetWeaponMetaResponse) GetPlayerRounds() int32 {
+	if x != nil {
+		return x.PlayerRounds
+	}
+	return 0
+}
+
+type ListPatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatchesRequest) Reset() {
+	*x = ListPatchesRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatchesRequest) ProtoMessage() {}
+
+func (x *ListPatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListPatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{63}
+}
+
+type Patch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. 8.11
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Major int32  `protobuf:"varint,2,opt,name=major,proto3" json:"major,omitempty"`
+	Minor int32  `protobuf:"varint,3,opt,name=minor,proto3" json:"minor,omitempty"`
+	// start of the earliest stored match on the patch
+	FirstSeenAt   string `protobuf:"bytes,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	Matches       int32  `protobuf:"varint,5,opt,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patch) Reset() {
+	*x = Patch{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patch) ProtoMessage() {}
+
+func (x *Patch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patch.ProtoReflect.Descriptor instead.
+func (*Patch) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Patch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Patch) GetMajor() int32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *Patch) GetMinor() int32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *Patch) GetFirstSeenAt() string {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return ""
+}
+
+func (x *Patch) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+type ListPatchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// newest first
+	Patches       []*Patch `protobuf:"bytes,1,rep,name=patches,proto3" json:"patches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPatchesResponse) Reset() {
+	*x = ListPatchesResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatchesResponse) ProtoMessage() {}
+
+func (x *ListPatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListPatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListPatchesResponse) GetPatches() []*Patch {
+	if x != nil {
+		return x.Patches
+	}
+	return nil
+}
+
+type GetPatchMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Patch string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	// empty for every mode
+	Mode          string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatchMetaRequest) Reset() {
+	*x = GetPatchMetaRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatchMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatchMetaRequest) ProtoMessage() {}
+
+func (x *GetPatchMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatchMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetPatchMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPatchMetaRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *GetPatchMetaRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type PatchAgent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// player slots, an agent can be on both teams of a match
+	Games   int32 `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Matches int32 `protobuf:"varint,4,opt,name=matches,proto3" json:"matches,omitempty"`
+	// share of the patch's stored matches the agent was in
+	PickRate      float32 `protobuf:"fixed32,5,opt,name=pick_rate,json=pickRate,proto3" json:"pick_rate,omitempty"`
+	Wins          int32   `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate       float32 `protobuf:"fixed32,7,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	KdRatio       float32 `protobuf:"fixed32,8,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchAgent) Reset() {
+	*x = PatchAgent{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchAgent) ProtoMessage() {}
+
+func (x *PatchAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchAgent.ProtoReflect.Descriptor instead.
+func (*PatchAgent) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PatchAgent) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *PatchAgent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatchAgent) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *PatchAgent) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *PatchAgent) GetPickRate() float32 {
+	if x != nil {
+		return x.PickRate
+	}
+	return 0
+}
+
+func (x *PatchAgent) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PatchAgent) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *PatchAgent) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+type GetPatchMetaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Patch string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	// stored matches on the patch, over every player in the database
+	Matches int32 `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	// most picked first
+	Agents        []*PatchAgent `protobuf:"bytes,3,rep,name=agents,proto3" json:"agents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatchMetaResponse) Reset() {
+	*x = GetPatchMetaResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatchMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatchMetaResponse) ProtoMessage() {}
+
+func (x *GetPatchMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatchMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetPatchMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPatchMetaResponse) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *GetPatchMetaResponse) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *GetPatchMetaResponse) GetAgents() []*PatchAgent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type GetOpponentStrengthRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Puuid  string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// period length, defaults to 7, between 1 and 90. Periods are aligned to UTC, weeks start on Monday.
+	BucketDays    int32 `protobuf:"varint,3,opt,name=bucket_days,json=bucketDays,proto3" json:"bucket_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpponentStrengthRequest) Reset() {
+	*x = GetOpponentStrengthRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpponentStrengthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpponentStrengthRequest) ProtoMessage() {}
+
+func (x *GetOpponentStrengthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpponentStrengthRequest.ProtoReflect.Descriptor instead.
+func (*GetOpponentStrengthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetOpponentStrengthRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetOpponentStrengthRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetOpponentStrengthRequest) GetBucketDays() int32 {
+	if x != nil {
+		return x.BucketDays
+	}
+	return 0
+}
+
+type OpponentStrengthPeriod struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Start   string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Matches int32                  `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	// the player's own team, including the player
+	Team      *TeamRank `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	Opponents *TeamRank `protobuf:"bytes,4,opt,name=opponents,proto3" json:"opponents,omitempty"`
+	// share of enemy seats with a known rank, over matches whose lobby size is known
+	OpponentCoverage float32 `protobuf:"fixed32,5,opt,name=opponent_coverage,json=opponentCoverage,proto3" json:"opponent_coverage,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OpponentStrengthPeriod) Reset() {
+	*x = OpponentStrengthPeriod{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpponentStrengthPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentStrengthPeriod) ProtoMessage() {}
+
+func (x *OpponentStrengthPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentStrengthPeriod.ProtoReflect.Descriptor instead.
+func (*OpponentStrengthPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *OpponentStrengthPeriod) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *OpponentStrengthPeriod) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *OpponentStrengthPeriod) GetTeam() *TeamRank {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *OpponentStrengthPeriod) GetOpponents() *TeamRank {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *OpponentStrengthPeriod) GetOpponentCoverage() float32 {
+	if x != nil {
+		return x.OpponentCoverage
+	}
+	return 0
+}
+
+type GetOpponentStrengthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// oldest first, periods without matches are left out
+	Periods []*OpponentStrengthPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	Overall *OpponentStrengthPeriod   `protobuf:"bytes,2,opt,name=overall,proto3" json:"overall,omitempty"`
+	// matches with at least one ranked opponent, the only ones in the trend
+	RatedMatches int32 `protobuf:"varint,3,opt,name=rated_matches,json=ratedMatches,proto3" json:"rated_matches,omitempty"`
+	// fitted change of the average opponent tier per week, unset with fewer than two rated matches
+	TierChangePerWeek *float32 `protobuf:"fixed32,4,opt,name=tier_change_per_week,json=tierChangePerWeek,proto3,oneof" json:"tier_change_per_week,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetOpponentStrengthResponse) Reset() {
+	*x = GetOpponentStrengthResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpponentStrengthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpponentStrengthResponse) ProtoMessage() {}
+
+func (x *GetOpponentStrengthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpponentStrengthResponse.ProtoReflect.Descriptor instead.
+func (*GetOpponentStrengthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetOpponentStrengthResponse) GetPeriods() []*OpponentStrengthPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *GetOpponentStrengthResponse) GetOverall() *OpponentStrengthPeriod {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GetOpponentStrengthResponse) GetRatedMatches() int32 {
+	if x != nil {
+		return x.RatedMatches
+	}
+	return 0
+}
+
+func (x *GetOpponentStrengthResponse) GetTierChangePerWeek() float32 {
+	if x != nil && x.TierChangePerWeek != nil {
+		return *x.TierChangePerWeek
+	}
+	return 0
+}
+
+type GetHeatmapRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Puuid  string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Filter *StatsFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// IANA name such as Europe/Berlin, defaults to UTC
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeatmapRequest) Reset() {
+	*x = GetHeatmapRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeatmapRequest) ProtoMessage() {}
+
+func (x *GetHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*GetHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetHeatmapRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetHeatmapRequest) GetFilter() *StatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetHeatmapRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type HeatmapBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is Sunday; unset on hour buckets
+	Weekday *int32 `protobuf:"varint,1,opt,name=weekday,proto3,oneof" json:"weekday,omitempty"`
+	// 0-23 local time; unset on weekday buckets
+	Hour    *int32  `protobuf:"varint,2,opt,name=hour,proto3,oneof" json:"hour,omitempty"`
+	Games   int32   `protobuf:"varint,3,opt,name=games,proto3" json:"games,omitempty"`
+	Wins    int32   `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate float32 `protobuf:"fixed32,5,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	KdRatio float32 `protobuf:"fixed32,6,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// per round
+	Acs float32 `protobuf:"fixed32,7,opt,name=acs,proto3" json:"acs,omitempty"`
+	// over games with an RR record only, unset when there are none
+	AvgRrChange *float32 `protobuf:"fixed32,8,opt,name=avg_rr_change,json=avgRrChange,proto3,oneof" json:"avg_rr_change,omitempty"`
+	RrGames     int32    `protobuf:"varint,9,opt,name=rr_games,json=rrGames,proto3" json:"rr_games,omitempty"`
+	// unset when none of the games are rated
+	AvgRating     *float32 `protobuf:"fixed32,10,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeatmapBucket) Reset() {
+	*x = HeatmapBucket{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatmapBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapBucket) ProtoMessage() {}
+
+func (x *HeatmapBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapBucket.ProtoReflect.Descriptor instead.
+func (*HeatmapBucket) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HeatmapBucket) GetWeekday() int32 {
+	if x != nil && x.Weekday != nil {
+		return *x.Weekday
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetHour() int32 {
+	if x != nil && x.Hour != nil {
+		return *x.Hour
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetAcs() float32 {
+	if x != nil {
+		return x.Acs
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetAvgRrChange() float32 {
+	if x != nil && x.AvgRrChange != nil {
+		return *x.AvgRrChange
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetRrGames() int32 {
+	if x != nil {
+		return x.RrGames
+	}
+	return 0
+}
+
+func (x *HeatmapBucket) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
+	}
+	return 0
+}
+
+type GetHeatmapResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Timezone string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Overall  *HeatmapBucket         `protobuf:"bytes,2,opt,name=overall,proto3" json:"overall,omitempty"`
+	// all 24, midnight first
+	Hours []*HeatmapBucket `protobuf:"bytes,3,rep,name=hours,proto3" json:"hours,omitempty"`
+	// all 7, Sunday first
+	Weekdays []*HeatmapBucket `protobuf:"bytes,4,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// weekday and hour pairs with at least one game
+	Cells         []*HeatmapBucket `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeatmapResponse) Reset() {
+	*x = GetHeatmapResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeatmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeatmapResponse) ProtoMessage() {}
+
+func (x *GetHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*GetHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetHeatmapResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetHeatmapResponse) GetOverall() *HeatmapBucket {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GetHeatmapResponse) GetHours() []*HeatmapBucket {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *GetHeatmapResponse) GetWeekdays() []*HeatmapBucket {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *GetHeatmapResponse) GetCells() []*HeatmapBucket {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type Encounter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Puuid          string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag            string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	GamesWith      int32                  `protobuf:"varint,4,opt,name=games_with,json=gamesWith,proto3" json:"games_with,omitempty"`
+	WinsWith       int32                  `protobuf:"varint,5,opt,name=wins_with,json=winsWith,proto3" json:"wins_with,omitempty"`
+	WinRateWith    float32                `protobuf:"fixed32,6,opt,name=win_rate_with,json=winRateWith,proto3" json:"win_rate_with,omitempty"`
+	GamesAgainst   int32                  `protobuf:"varint,7,opt,name=games_against,json=gamesAgainst,proto3" json:"games_against,omitempty"`
+	WinsAgainst    int32                  `protobuf:"varint,8,opt,name=wins_against,json=winsAgainst,proto3" json:"wins_against,omitempty"`
+	WinRateAgainst float32                `protobuf:"fixed32,9,opt,name=win_rate_against,json=winRateAgainst,proto3" json:"win_rate_against,omitempty"`
+	LastMatchId    string                 `protobuf:"bytes,10,opt,name=last_match_id,json=lastMatchId,proto3" json:"last_match_id,omitempty"`
+	LastPlayedAt   string                 `protobuf:"bytes,11,opt,name=last_played_at,json=lastPlayedAt,proto3" json:"last_played_at,omitempty"`
+	LastAsTeammate bool                   `protobuf:"varint,12,opt,name=last_as_teammate,json=lastAsTeammate,proto3" json:"last_as_teammate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Encounter) Reset() {
+	*x = Encounter{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Encounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Encounter) ProtoMessage() {}
+
+func (x *Encounter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Encounter.ProtoReflect.Descriptor instead.
+func (*Encounter) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Encounter) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *Encounter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Encounter) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Encounter) GetGamesWith() int32 {
+	if x != nil {
+		return x.GamesWith
+	}
+	return 0
+}
+
+func (x *Encounter) GetWinsWith() int32 {
+	if x != nil {
+		return x.WinsWith
+	}
+	return 0
+}
+
+func (x *Encounter) GetWinRateWith() float32 {
+	if x != nil {
+		return x.WinRateWith
+	}
+	return 0
+}
+
+func (x *Encounter) GetGamesAgainst() int32 {
+	if x != nil {
+		return x.GamesAgainst
+	}
+	return 0
+}
+
+func (x *Encounter) GetWinsAgainst() int32 {
+	if x != nil {
+		return x.WinsAgainst
+	}
+	return 0
+}
+
+func (x *Encounter) GetWinRateAgainst() float32 {
+	if x != nil {
+		return x.WinRateAgainst
+	}
+	return 0
+}
+
+func (x *Encounter) GetLastMatchId() string {
+	if x != nil {
+		return x.LastMatchId
+	}
+	return ""
+}
+
+func (x *Encounter) GetLastPlayedAt() string {
+	if x != nil {
+		return x.LastPlayedAt
+	}
+	return ""
+}
+
+func (x *Encounter) GetLastAsTeammate() bool {
+	if x != nil {
+		return x.LastAsTeammate
+	}
+	return false
+}
+
+type GetEncountersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// defaults to 50, at most 200
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEncountersRequest) Reset() {
+	*x = GetEncountersRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncountersRequest) ProtoMessage() {}
+
+func (x *GetEncountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncountersRequest.ProtoReflect.Descriptor instead.
+func (*GetEncountersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetEncountersRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetEncountersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetEncountersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most shared matches first
+	Encounters    []*Encounter `protobuf:"bytes,1,rep,name=encounters,proto3" json:"encounters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEncountersResponse) Reset() {
+	*x = GetEncountersResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncountersResponse) ProtoMessage() {}
+
+func (x *GetEncountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncountersResponse.ProtoReflect.Descriptor instead.
+func (*GetEncountersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetEncountersResponse) GetEncounters() []*Encounter {
+	if x != nil {
+		return x.Encounters
+	}
+	return nil
+}
+
+type GetEncounterRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Puuid      string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	OtherPuuid string                 `protobuf:"bytes,2,opt,name=other_puuid,json=otherPuuid,proto3" json:"other_puuid,omitempty"`
+	// leave out the match being viewed
+	ExcludeMatchId string `protobuf:"bytes,3,opt,name=exclude_match_id,json=excludeMatchId,proto3" json:"exclude_match_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEncounterRequest) Reset() {
+	*x = GetEncounterRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncounterRequest) ProtoMessage() {}
+
+func (x *GetEncounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncounterRequest.ProtoReflect.Descriptor instead.
+func (*GetEncounterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetEncounterRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetEncounterRequest) GetOtherPuuid() string {
+	if x != nil {
+		return x.OtherPuuid
+	}
+	return ""
+}
+
+func (x *GetEncounterRequest) GetExcludeMatchId() string {
+	if x != nil {
+		return x.ExcludeMatchId
+	}
+	return ""
+}
+
+type GetEncounterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unset when the two never shared a match
+	Encounter     *Encounter `protobuf:"bytes,1,opt,name=encounter,proto3" json:"encounter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEncounterResponse) Reset() {
+	*x = GetEncounterResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncounterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncounterResponse) ProtoMessage() {}
+
+func (x *GetEncounterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncounterResponse.ProtoReflect.Descriptor instead.
+func (*GetEncounterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetEncounterResponse) GetEncounter() *Encounter {
+	if x != nil {
+		return x.Encounter
+	}
+	return nil
+}
+
+type QueueSplit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Games   int32                  `protobuf:"varint,1,opt,name=games,proto3" json:"games,omitempty"`
+	Wins    int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate float32                `protobuf:"fixed32,3,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	// summed over rated matches only
+	RrChange      int32   `protobuf:"varint,4,opt,name=rr_change,json=rrChange,proto3" json:"rr_change,omitempty"`
+	KdRatio       float32 `protobuf:"fixed32,5,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueSplit) Reset() {
+	*x = QueueSplit{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSplit) ProtoMessage() {}
+
+func (x *QueueSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSplit.ProtoReflect.Descriptor instead.
+func (*QueueSplit) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *QueueSplit) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *QueueSplit) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *QueueSplit) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *QueueSplit) GetRrChange() int32 {
+	if x != nil {
+		return x.RrChange
+	}
+	return 0
+}
+
+func (x *QueueSplit) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+type TeammateSynergy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag   string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// the requested player's own results in the shared matches
+	Stats *QueueSplit `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	// shared matches queued in the same party
+	PremadeGames  int32 `protobuf:"varint,5,opt,name=premade_games,json=premadeGames,proto3" json:"premade_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeammateSynergy) Reset() {
+	*x = TeammateSynergy{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeammateSynergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeammateSynergy) ProtoMessage() {}
+
+func (x *TeammateSynergy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeammateSynergy.ProtoReflect.Descriptor instead.
+func (*TeammateSynergy) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TeammateSynergy) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *TeammateSynergy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeammateSynergy) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TeammateSynergy) GetStats() *QueueSplit {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *TeammateSynergy) GetPremadeGames() int32 {
+	if x != nil {
+		return x.PremadeGames
+	}
+	return 0
+}
+
+type GetSynergyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// shared matches needed to be listed, defaults to 2
+	MinGames int32 `protobuf:"varint,2,opt,name=min_games,json=minGames,proto3" json:"min_games,omitempty"`
+	// defaults to 20, at most 100
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSynergyRequest) Reset() {
+	*x = GetSynergyRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSynergyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSynergyRequest) ProtoMessage() {}
+
+func (x *GetSynergyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSynergyRequest.ProtoReflect.Descriptor instead.
+func (*GetSynergyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetSynergyRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *GetSynergyRequest) GetMinGames() int32 {
+	if x != nil {
+		return x.MinGames
+	}
+	return 0
+}
+
+func (x *GetSynergyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSynergyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most shared matches first
+	Teammates []*TeammateSynergy `protobuf:"bytes,1,rep,name=teammates,proto3" json:"teammates,omitempty"`
+	Solo      *QueueSplit        `protobuf:"bytes,2,opt,name=solo,proto3" json:"solo,omitempty"`
+	Party     *QueueSplit        `protobuf:"bytes,3,opt,name=party,proto3" json:"party,omitempty"`
+	// matches without stored teammates, counted in neither split
+	UnclassifiedGames int32 `protobuf:"varint,4,opt,name=unclassified_games,json=unclassifiedGames,proto3" json:"unclassified_games,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSynergyResponse) Reset() {
+	*x = GetSynergyResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSynergyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSynergyResponse) ProtoMessage() {}
+
+func (x *GetSynergyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSynergyResponse.ProtoReflect.Descriptor instead.
+func (*GetSynergyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetSynergyResponse) GetTeammates() []*TeammateSynergy {
+	if x != nil {
+		return x.Teammates
+	}
+	return nil
+}
+
+func (x *GetSynergyResponse) GetSolo() *QueueSplit {
+	if x != nil {
+		return x.Solo
+	}
+	return nil
+}
+
+func (x *GetSynergyResponse) GetParty() *QueueSplit {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
+func (x *GetSynergyResponse) GetUnclassifiedGames() int32 {
+	if x != nil {
+		return x.UnclassifiedGames
+	}
+	return 0
+}
+
+// a puuid, or a name and tag
+type PlayerRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRef) Reset() {
+	*x = PlayerRef{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRef) ProtoMessage() {}
+
+func (x *PlayerRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRef.ProtoReflect.Descriptor instead.
+func (*PlayerRef) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PlayerRef) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *PlayerRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerRef) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ComparePlayersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// two to five players
+	Players []*PlayerRef `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// only matches from the last this many days, 0 keeps every stored match
+	Days          int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Refresh       bool  `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePlayersRequest) Reset() {
+	*x = ComparePlayersRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePlayersRequest) ProtoMessage() {}
+
+func (x *ComparePlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePlayersRequest.ProtoReflect.Descriptor instead.
+func (*ComparePlayersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ComparePlayersRequest) GetPlayers() []*PlayerRef {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ComparePlayersRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *ComparePlayersRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type RankPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Tier          *Tier                  `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	RankingInTier int32                  `protobuf:"varint,4,opt,name=ranking_in_tier,json=rankingInTier,proto3" json:"ranking_in_tier,omitempty"`
+	MmrChange     int32                  `protobuf:"varint,5,opt,name=mmr_change,json=mmrChange,proto3" json:"mmr_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankPoint) Reset() {
+	*x = RankPoint{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankPoint) ProtoMessage() {}
+
+func (x *RankPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankPoint.ProtoReflect.Descriptor instead.
+func (*RankPoint) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RankPoint) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RankPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *RankPoint) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *RankPoint) GetRankingInTier() int32 {
+	if x != nil {
+		return x.RankingInTier
+	}
+	return 0
+}
+
+func (x *RankPoint) GetMmrChange() int32 {
+	if x != nil {
+		return x.MmrChange
+	}
+	return 0
+}
+
+type ComparedPlayer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stats cover the window only
+	Player *PlayerResponse `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// oldest first, rated matches only
+	RankTimeline  []*RankPoint `protobuf:"bytes,2,rep,name=rank_timeline,json=rankTimeline,proto3" json:"rank_timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparedPlayer) Reset() {
+	*x = ComparedPlayer{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparedPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparedPlayer) ProtoMessage() {}
+
+func (x *ComparedPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparedPlayer.ProtoReflect.Descriptor instead.
+func (*ComparedPlayer) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ComparedPlayer) GetPlayer() *PlayerResponse {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *ComparedPlayer) GetRankTimeline() []*RankPoint {
+	if x != nil {
+		return x.RankTimeline
+	}
+	return nil
+}
+
+// games and wins are indexed like ComparePlayersResponse.players
+type UsageOverlap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Games         []int32                `protobuf:"varint,3,rep,packed,name=games,proto3" json:"games,omitempty"`
+	Wins          []int32                `protobuf:"varint,4,rep,packed,name=wins,proto3" json:"wins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageOverlap) Reset() {
+	*x = UsageOverlap{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageOverlap) ProtoMessage() {}
+
+func (x *UsageOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageOverlap.ProtoReflect.Descriptor instead.
+func (*UsageOverlap) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UsageOverlap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UsageOverlap) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UsageOverlap) GetGames() []int32 {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *UsageOverlap) GetWins() []int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+type SharedRecord struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PuuidA       string                 `protobuf:"bytes,1,opt,name=puuid_a,json=puuidA,proto3" json:"puuid_a,omitempty"`
+	PuuidB       string                 `protobuf:"bytes,2,opt,name=puuid_b,json=puuidB,proto3" json:"puuid_b,omitempty"`
+	GamesWith    int32                  `protobuf:"varint,3,opt,name=games_with,json=gamesWith,proto3" json:"games_with,omitempty"`
+	WinsWith     int32                  `protobuf:"varint,4,opt,name=wins_with,json=winsWith,proto3" json:"wins_with,omitempty"`
+	GamesAgainst int32                  `protobuf:"varint,5,opt,name=games_against,json=gamesAgainst,proto3" json:"games_against,omitempty"`
+	// won by player a
+	WinsAgainst   int32 `protobuf:"varint,6,opt,name=wins_against,json=winsAgainst,proto3" json:"wins_against,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedRecord) Reset() {
+	*x = SharedRecord{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedRecord) ProtoMessage() {}
+
+func (x *SharedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedRecord.ProtoReflect.Descriptor instead.
+func (*SharedRecord) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SharedRecord) GetPuuidA() string {
+	if x != nil {
+		return x.PuuidA
+	}
+	return ""
+}
+
+func (x *SharedRecord) GetPuuidB() string {
+	if x != nil {
+		return x.PuuidB
+	}
+	return ""
+}
+
+func (x *SharedRecord) GetGamesWith() int32 {
+	if x != nil {
+		return x.GamesWith
+	}
+	return 0
+}
+
+func (x *SharedRecord) GetWinsWith() int32 {
+	if x != nil {
+		return x.WinsWith
+	}
+	return 0
+}
+
+func (x *SharedRecord) GetGamesAgainst() int32 {
+	if x != nil {
+		return x.GamesAgainst
+	}
+	return 0
+}
+
+func (x *SharedRecord) GetWinsAgainst() int32 {
+	if x != nil {
+		return x.WinsAgainst
+	}
+	return 0
+}
+
+type ComparePlayersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in request order
+	Players []*ComparedPlayer `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// played by at least two of the players, most played first
+	Agents []*UsageOverlap `protobuf:"bytes,2,rep,name=agents,proto3" json:"agents,omitempty"`
+	Maps   []*UsageOverlap `protobuf:"bytes,3,rep,name=maps,proto3" json:"maps,omitempty"`
+	// pairs that shared at least one match
+	Records       []*SharedRecord `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePlayersResponse) Reset() {
+	*x = ComparePlayersResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePlayersResponse) ProtoMessage() {}
+
+func (x *ComparePlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePlayersResponse.ProtoReflect.Descriptor instead.
+func (*ComparePlayersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ComparePlayersResponse) GetPlayers() []*ComparedPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ComparePlayersResponse) GetAgents() []*UsageOverlap {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *ComparePlayersResponse) GetMaps() []*UsageOverlap {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *ComparePlayersResponse) GetRecords() []*SharedRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "rr" (default), "win_rate", "kd", "rating" or "games"
+	Metric   string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	Region   string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	SeasonId string `protobuf:"bytes,3,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// only members of this group
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	// matches needed to be ranked, defaults to 5
+	MinGames int32  `protobuf:"varint,5,opt,name=min_games,json=minGames,proto3" json:"min_games,omitempty"`
+	Cursor   string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// defaults to 50, at most 200
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetLeaderboardRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetMinGames() int32 {
+	if x != nil {
+		return x.MinGames
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LeaderboardEntry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Rank   int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Puuid  string                 `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tag    string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Region string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	// current rank, not the rank at the end of season_id
+	Tier    *Tier   `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`
+	Rr      int32   `protobuf:"varint,7,opt,name=rr,proto3" json:"rr,omitempty"`
+	Games   int32   `protobuf:"varint,8,opt,name=games,proto3" json:"games,omitempty"`
+	Wins    int32   `protobuf:"varint,9,opt,name=wins,proto3" json:"wins,omitempty"`
+	WinRate float32 `protobuf:"fixed32,10,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	KdRatio float32 `protobuf:"fixed32,11,opt,name=kd_ratio,json=kdRatio,proto3" json:"kd_ratio,omitempty"`
+	// unset without rated matches
+	AvgRating     *float32 `protobuf:"fixed32,12,opt,name=avg_rating,json=avgRating,proto3,oneof" json:"avg_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetRr() int32 {
+	if x != nil {
+		return x.Rr
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetKdRatio() float32 {
+	if x != nil {
+		return x.KdRatio
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetAvgRating() float32 {
+	if x != nil && x.AvgRating != nil {
+		return *x.AvgRating
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// empty on the last page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AddGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Puuid         string                 `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberRequest) Reset() {
+	*x = AddGroupMemberRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberRequest) ProtoMessage() {}
+
+func (x *AddGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddGroupMemberRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AddGroupMemberRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type AddGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberResponse) Reset() {
+	*x = AddGroupMemberResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberResponse) ProtoMessage() {}
+
+func (x *AddGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{95}
+}
+
+type RemoveGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Puuid         string                 `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RemoveGroupMemberRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{97}
+}
+
+type ListGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListGroupMembersRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type ListGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuids        []string               `protobuf:"bytes,1,rep,name=puuids,proto3" json:"puuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListGroupMembersResponse) GetPuuids() []string {
+	if x != nil {
+		return x.Puuids
+	}
+	return nil
+}
+
+type GetOfficialLeaderboardRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Region string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// matched anywhere in "name#tag"
+	Search string `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	// entries ranked below this, 0 starts at the top
+	AfterRank int32 `protobuf:"varint,3,opt,name=after_rank,json=afterRank,proto3" json:"after_rank,omitempty"`
+	// defaults to 50, at most 200
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfficialLeaderboardRequest) Reset() {
+	*x = GetOfficialLeaderboardRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfficialLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfficialLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetOfficialLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfficialLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetOfficialLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetOfficialLeaderboardRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetOfficialLeaderboardRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetOfficialLeaderboardRequest) GetAfterRank() int32 {
+	if x != nil {
+		return x.AfterRank
+	}
+	return 0
+}
+
+func (x *GetOfficialLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OfficialLeaderboardEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rank  int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// empty for anonymized players, like name and tag
+	Puuid string `protobuf:"bytes,2,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tag   string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// id only
+	Tier *Tier `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
+	Rr   int32 `protobuf:"varint,6,opt,name=rr,proto3" json:"rr,omitempty"`
+	Wins int32 `protobuf:"varint,7,opt,name=wins,proto3" json:"wins,omitempty"`
+	// rank in the snapshot before, unset when unranked there
+	PreviousRank *int32 `protobuf:"varint,8,opt,name=previous_rank,json=previousRank,proto3,oneof" json:"previous_rank,omitempty"`
+	// the player is stored here and can be opened with GetPlayerByPuuid
+	Tracked       bool `protobuf:"varint,9,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfficialLeaderboardEntry) Reset() {
+	*x = OfficialLeaderboardEntry{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfficialLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfficialLeaderboardEntry) ProtoMessage() {}
+
+func (x *OfficialLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfficialLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*OfficialLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *OfficialLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *OfficialLeaderboardEntry) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+func (x *OfficialLeaderboardEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OfficialLeaderboardEntry) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *OfficialLeaderboardEntry) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+func (x *OfficialLeaderboardEntry) GetRr() int32 {
+	if x != nil {
+		return x.Rr
+	}
+	return 0
+}
+
+func (x *OfficialLeaderboardEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *OfficialLeaderboardEntry) GetPreviousRank() int32 {
+	if x != nil && x.PreviousRank != nil {
+		return *x.PreviousRank
+	}
+	return 0
+}
+
+func (x *OfficialLeaderboardEntry) GetTracked() bool {
+	if x != nil {
+		return x.Tracked
+	}
+	return false
+}
+
+type GetOfficialLeaderboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unset fields and no entries until the region is first ingested
+	FetchedAt     string                      `protobuf:"bytes,1,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Entries       []*OfficialLeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfficialLeaderboardResponse) Reset() {
+	*x = GetOfficialLeaderboardResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfficialLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfficialLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetOfficialLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfficialLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetOfficialLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetOfficialLeaderboardResponse) GetFetchedAt() string {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return ""
+}
+
+func (x *GetOfficialLeaderboardResponse) GetEntries() []*OfficialLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetSuspicionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuspicionRequest) Reset() {
+	*x = GetSuspicionRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspicionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspicionRequest) ProtoMessage() {}
+
+func (x *GetSuspicionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspicionRequest.ProtoReflect.Descriptor instead.
+func (*GetSuspicionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetSuspicionRequest) GetPuuid() string {
+	if x != nil {
+		return x.Puuid
+	}
+	return ""
+}
+
+type SuspicionSignal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// low_level_high_rank, rating_above_lobby, rr_per_win or session_stat_shift
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// false when there wasn't enough data, the signal then adds nothing
+	Available bool    `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Value     float32 `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	// strength grows from 0 at threshold to 1 at saturation of value
+	Threshold  float32 `protobuf:"fixed32,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Saturation float32 `protobuf:"fixed32,5,opt,name=saturation,proto3" json:"saturation,omitempty"`
+	Strength   float32 `protobuf:"fixed32,6,opt,name=strength,proto3" json:"strength,omitempty"`
+	Weight     float32 `protobuf:"fixed32,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	// points added to the score
+	Contribution  float32 `protobuf:"fixed32,8,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	Detail        string  `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspicionSignal) Reset() {
+	*x = SuspicionSignal{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspicionSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspicionSignal) ProtoMessage() {}
+
+func (x *SuspicionSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspicionSignal.ProtoReflect.Descriptor instead.
+func (*SuspicionSignal) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SuspicionSignal) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SuspicionSignal) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SuspicionSignal) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetThreshold() float32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetSaturation() float32 {
+	if x != nil {
+		return x.Saturation
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetStrength() float32 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetContribution() float32 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *SuspicionSignal) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type GetSuspicionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// 0-100
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	// share of the signal weight that had enough data
+	Coverage      float32            `protobuf:"fixed32,3,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	Signals       []*SuspicionSignal `protobuf:"bytes,4,rep,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuspicionResponse) Reset() {
+	*x = GetSuspicionResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuspicionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuspicionResponse) ProtoMessage() {}
+
+func (x *GetSuspicionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuspicionResponse.ProtoReflect.Descriptor instead.
+func (*GetSuspicionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetSuspicionResponse) GetPuuid() string {
 	if x != nil {
-		return x.CharacterId
+		return x.Puuid
 	}
 	return ""
 }
 
-func (x *Match) GetDamageTaken() int32 {
+func (x *GetSuspicionResponse) GetScore() float32 {
 	if x != nil {
-		return x.DamageTaken
+		return x.Score
 	}
 	return 0
 }
 
-func (x *Match) GetDamageDealt() int32 {
+func (x *GetSuspicionResponse) GetCoverage() float32 {
 	if x != nil {
-		return x.DamageDealt
+		return x.Coverage
 	}
 	return 0
 }
 
-type MatchesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+func (x *GetSuspicionResponse) GetSignals() []*SuspicionSignal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type GetRankProjectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Puuid string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// horizon of the promotion chance, defaults to 10 and is capped at 100
+	Games         int32 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchesResponse) Reset() {
-	*x = MatchesResponse{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[5]
+func (x *GetRankProjectionRequest) Reset() {
+	*x = GetRankProjectionRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchesResponse) String() string {
+func (x *GetRankProjectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchesResponse) ProtoMessage() {}
+func (*GetRankProjectionRequest) ProtoMessage() {}
 
-func (x *MatchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[5]
+func (x *GetRankProjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,40 +7969,73 @@ func (x *MatchesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchesResponse.ProtoReflect.Descriptor instead.
-func (*MatchesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetRankProjectionRequest.ProtoReflect.Descriptor instead.
+func (*GetRankProjectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *MatchesResponse) GetMatches() []*Match {
+func (x *GetRankProjectionRequest) GetPuuid() string {
 	if x != nil {
-		return x.Matches
+		return x.Puuid
 	}
-	return nil
+	return ""
 }
 
-type SearchSuggestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRankProjectionRequest) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
 }
 
-func (x *SearchSuggestionsRequest) Reset() {
-	*x = SearchSuggestionsRequest{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[6]
+type GetRankProjectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	Tier          *Tier                  `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	RankingInTier int32                  `protobuf:"varint,3,opt,name=ranking_in_tier,json=rankingInTier,proto3" json:"ranking_in_tier,omitempty"`
+	Elo           int32                  `protobuf:"varint,4,opt,name=elo,proto3" json:"elo,omitempty"`
+	// latest rated games the model is fitted on
+	SampleGames int32   `protobuf:"varint,5,opt,name=sample_games,json=sampleGames,proto3" json:"sample_games,omitempty"`
+	Wins        int32   `protobuf:"varint,6,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses      int32   `protobuf:"varint,7,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws       int32   `protobuf:"varint,8,opt,name=draws,proto3" json:"draws,omitempty"`
+	WinRate     float32 `protobuf:"fixed32,9,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	// confidence band of the win rate, the other bands follow from it
+	WinRateLow        float32 `protobuf:"fixed32,10,opt,name=win_rate_low,json=winRateLow,proto3" json:"win_rate_low,omitempty"`
+	WinRateHigh       float32 `protobuf:"fixed32,11,opt,name=win_rate_high,json=winRateHigh,proto3" json:"win_rate_high,omitempty"`
+	AvgGain           float32 `protobuf:"fixed32,12,opt,name=avg_gain,json=avgGain,proto3" json:"avg_gain,omitempty"`
+	AvgLoss           float32 `protobuf:"fixed32,13,opt,name=avg_loss,json=avgLoss,proto3" json:"avg_loss,omitempty"`
+	ExpectedRrPerGame float32 `protobuf:"fixed32,14,opt,name=expected_rr_per_game,json=expectedRrPerGame,proto3" json:"expected_rr_per_game,omitempty"`
+	// unset when promotion isn't near certain within 500 games
+	ExpectedGames     *float32 `protobuf:"fixed32,15,opt,name=expected_games,json=expectedGames,proto3,oneof" json:"expected_games,omitempty"`
+	ExpectedGamesLow  *float32 `protobuf:"fixed32,16,opt,name=expected_games_low,json=expectedGamesLow,proto3,oneof" json:"expected_games_low,omitempty"`
+	ExpectedGamesHigh *float32 `protobuf:"fixed32,17,opt,name=expected_games_high,json=expectedGamesHigh,proto3,oneof" json:"expected_games_high,omitempty"`
+	Games             int32    `protobuf:"varint,18,opt,name=games,proto3" json:"games,omitempty"`
+	// chance to reach the next tier within games
+	PromotionChance     float32 `protobuf:"fixed32,19,opt,name=promotion_chance,json=promotionChance,proto3" json:"promotion_chance,omitempty"`
+	PromotionChanceLow  float32 `protobuf:"fixed32,20,opt,name=promotion_chance_low,json=promotionChanceLow,proto3" json:"promotion_chance_low,omitempty"`
+	PromotionChanceHigh float32 `protobuf:"fixed32,21,opt,name=promotion_chance_high,json=promotionChanceHigh,proto3" json:"promotion_chance_high,omitempty"`
+	// set when no projection could be made
+	UnavailableReason string `protobuf:"bytes,22,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetRankProjectionResponse) Reset() {
+	*x = GetRankProjectionResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchSuggestionsRequest) String() string {
+func (x *GetRankProjectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchSuggestionsRequest) ProtoMessage() {}
+func (*GetRankProjectionResponse) ProtoMessage() {}
 
-func (x *SearchSuggestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[6]
+func (x *GetRankProjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,99 +8046,195 @@ func (x *SearchSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchSuggestionsRequest.ProtoReflect.Descriptor instead.
-func (*SearchSuggestionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetRankProjectionResponse.ProtoReflect.Descriptor instead.
+func (*GetRankProjectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *SearchSuggestionsRequest) GetQuery() string {
+func (x *GetRankProjectionResponse) GetPuuid() string {
 	if x != nil {
-		return x.Query
+		return x.Puuid
 	}
 	return ""
 }
 
-type SearchSuggestionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Suggestions   []*PlayerResponse      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRankProjectionResponse) GetTier() *Tier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
 }
 
-func (x *SearchSuggestionsResponse) Reset() {
-	*x = SearchSuggestionsResponse{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetRankProjectionResponse) GetRankingInTier() int32 {
+	if x != nil {
+		return x.RankingInTier
+	}
+	return 0
 }
 
-func (x *SearchSuggestionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetRankProjectionResponse) GetElo() int32 {
+	if x != nil {
+		return x.Elo
+	}
+	return 0
 }
 
-func (*SearchSuggestionsResponse) ProtoMessage() {}
+func (x *GetRankProjectionResponse) GetSampleGames() int32 {
+	if x != nil {
+		return x.SampleGames
+	}
+	return 0
+}
 
-func (x *SearchSuggestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[7]
+func (x *GetRankProjectionResponse) GetWins() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Wins
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SearchSuggestionsResponse.ProtoReflect.Descriptor instead.
-func (*SearchSuggestionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{7}
+func (x *GetRankProjectionResponse) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
 }
 
-func (x *SearchSuggestionsResponse) GetSuggestions() []*PlayerResponse {
+func (x *GetRankProjectionResponse) GetDraws() int32 {
 	if x != nil {
-		return x.Suggestions
+		return x.Draws
 	}
-	return nil
+	return 0
 }
 
-// PlayerMatch - removed redundant fields (teamRedScore, teamBlueScore, started_at, mode, source, region, cluster, version, map_id, map_name)
-// These are now in MatchMetadata
-type PlayerMatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Agent         string                 `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
-	Kills         int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
-	Deaths        int32                  `protobuf:"varint,6,opt,name=deaths,proto3" json:"deaths,omitempty"`
-	Assists       int32                  `protobuf:"varint,7,opt,name=assists,proto3" json:"assists,omitempty"`
-	Score         int32                  `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
-	HasWon        bool                   `protobuf:"varint,9,opt,name=has_won,json=hasWon,proto3" json:"has_won,omitempty"`
-	Team          string                 `protobuf:"bytes,10,opt,name=team,proto3" json:"team,omitempty"`
-	Tier          *Tier                  `protobuf:"bytes,11,opt,name=tier,proto3" json:"tier,omitempty"`
-	CharacterId   string                 `protobuf:"bytes,12,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	DamageTaken   int32                  `protobuf:"varint,13,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
-	DamageDealt   int32                  `protobuf:"varint,14,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+func (x *GetRankProjectionResponse) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetWinRateLow() float32 {
+	if x != nil {
+		return x.WinRateLow
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetWinRateHigh() float32 {
+	if x != nil {
+		return x.WinRateHigh
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetAvgGain() float32 {
+	if x != nil {
+		return x.AvgGain
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetAvgLoss() float32 {
+	if x != nil {
+		return x.AvgLoss
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetExpectedRrPerGame() float32 {
+	if x != nil {
+		return x.ExpectedRrPerGame
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetExpectedGames() float32 {
+	if x != nil && x.ExpectedGames != nil {
+		return *x.ExpectedGames
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetExpectedGamesLow() float32 {
+	if x != nil && x.ExpectedGamesLow != nil {
+		return *x.ExpectedGamesLow
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetExpectedGamesHigh() float32 {
+	if x != nil && x.ExpectedGamesHigh != nil {
+		return *x.ExpectedGamesHigh
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetPromotionChance() float32 {
+	if x != nil {
+		return x.PromotionChance
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetPromotionChanceLow() float32 {
+	if x != nil {
+		return x.PromotionChanceLow
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetPromotionChanceHigh() float32 {
+	if x != nil {
+		return x.PromotionChanceHigh
+	}
+	return 0
+}
+
+func (x *GetRankProjectionResponse) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+type WebhookSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// match.new, rank.up, rank.down, rr.changed, streak.win
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// empty for every player
+	Puuid string `protobuf:"bytes,4,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	// rr.changed only fires when RR crosses this value
+	RrThreshold   *int32 `protobuf:"varint,5,opt,name=rr_threshold,json=rrThreshold,proto3,oneof" json:"rr_threshold,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerMatch) Reset() {
-	*x = PlayerMatch{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[8]
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerMatch) String() string {
+func (x *WebhookSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerMatch) ProtoMessage() {}
+func (*WebhookSubscription) ProtoMessage() {}
 
-func (x *PlayerMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[8]
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,131 +8245,205 @@ func (x *PlayerMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerMatch.ProtoReflect.Descriptor instead.
-func (*PlayerMatch) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *PlayerMatch) GetPuuid() string {
+func (x *WebhookSubscription) GetId() string {
 	if x != nil {
-		return x.Puuid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PlayerMatch) GetName() string {
+func (x *WebhookSubscription) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *PlayerMatch) GetTag() string {
+func (x *WebhookSubscription) GetEventTypes() []string {
 	if x != nil {
-		return x.Tag
+		return x.EventTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *PlayerMatch) GetAgent() string {
+func (x *WebhookSubscription) GetPuuid() string {
 	if x != nil {
-		return x.Agent
+		return x.Puuid
 	}
 	return ""
 }
 
-func (x *PlayerMatch) GetKills() int32 {
-	if x != nil {
-		return x.Kills
+func (x *WebhookSubscription) GetRrThreshold() int32 {
+	if x != nil && x.RrThreshold != nil {
+		return *x.RrThreshold
 	}
 	return 0
 }
 
-func (x *PlayerMatch) GetDeaths() int32 {
+func (x *WebhookSubscription) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Deaths
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *PlayerMatch) GetAssists() int32 {
+type CreateWebhookRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Url         string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes  []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Puuid       string                 `protobuf:"bytes,3,opt,name=puuid,proto3" json:"puuid,omitempty"`
+	RrThreshold *int32                 `protobuf:"varint,4,opt,name=rr_threshold,json=rrThreshold,proto3,oneof" json:"rr_threshold,omitempty"`
+	// generated when empty
+	Secret        string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[109]
 	if x != nil {
-		return x.Assists
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerMatch) GetScore() int32 {
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Score
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *PlayerMatch) GetHasWon() bool {
+func (x *CreateWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.HasWon
+		return x.EventTypes
 	}
-	return false
+	return nil
 }
 
-func (x *PlayerMatch) GetTeam() string {
+func (x *CreateWebhookRequest) GetPuuid() string {
 	if x != nil {
-		return x.Team
+		return x.Puuid
 	}
 	return ""
 }
 
-func (x *PlayerMatch) GetTier() *Tier {
+func (x *CreateWebhookRequest) GetRrThreshold() int32 {
+	if x != nil && x.RrThreshold != nil {
+		return *x.RrThreshold
+	}
+	return 0
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
 	if x != nil {
-		return x.Tier
+		return x.Secret
 	}
-	return nil
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// HMAC-SHA256 key for X-Webhook-Signature, only returned here
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PlayerMatch) GetCharacterId() string {
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[110]
 	if x != nil {
-		return x.CharacterId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerMatch) GetDamageTaken() int32 {
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateWebhookResponse) GetSubscription() *WebhookSubscription {
 	if x != nil {
-		return x.DamageTaken
+		return x.Subscription
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerMatch) GetDamageDealt() int32 {
+func (x *CreateWebhookResponse) GetSecret() string {
 	if x != nil {
-		return x.DamageDealt
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-type GetMatchRequest struct {
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMatchRequest) Reset() {
-	*x = GetMatchRequest{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[9]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMatchRequest) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMatchRequest) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[9]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,41 +8454,39 @@ func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
-func (*GetMatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetMatchRequest) GetMatchId() string {
+func (x *DeleteWebhookRequest) GetId() string {
 	if x != nil {
-		return x.MatchId
+		return x.Id
 	}
 	return ""
 }
 
-type GetMatchResponse struct {
+type DeleteWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *MatchMetadata         `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Players       []*PlayerMatch         `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMatchResponse) Reset() {
-	*x = GetMatchResponse{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[10]
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMatchResponse) String() string {
+func (x *DeleteWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMatchResponse) ProtoMessage() {}
+func (*DeleteWebhookResponse) ProtoMessage() {}
 
-func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[10]
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,58 +8497,69 @@ func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
-func (*GetMatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetMatchResponse) GetMetadata() *MatchMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMatchResponse) GetPlayers() []*PlayerMatch {
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[113]
 	if x != nil {
-		return x.Players
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type MatchMetadata struct {
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{113}
+}
+
+type ListWebhooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	MapName       string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
-	MapId         string                 `protobuf:"bytes,3,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
-	GameVersion   string                 `protobuf:"bytes,4,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
-	TeamRedScore  int32                  `protobuf:"varint,5,opt,name=team_red_score,json=teamRedScore,proto3" json:"team_red_score,omitempty"`
-	TeamBlueScore int32                  `protobuf:"varint,6,opt,name=team_blue_score,json=teamBlueScore,proto3" json:"team_blue_score,omitempty"`
-	Region        string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
-	Cluster       string                 `protobuf:"bytes,8,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Mode          string                 `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`
-	SeasonId      string                 `protobuf:"bytes,10,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
-	GameStart     int64                  `protobuf:"varint,11,opt,name=game_start,json=gameStart,proto3" json:"game_start,omitempty"`
-	RoundsPlayed  int32                  `protobuf:"varint,12,opt,name=rounds_played,json=roundsPlayed,proto3" json:"rounds_played,omitempty"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchMetadata) Reset() {
-	*x = MatchMetadata{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[11]
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchMetadata) String() string {
+func (x *ListWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchMetadata) ProtoMessage() {}
+func (*ListWebhooksResponse) ProtoMessage() {}
 
-func (x *MatchMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[11]
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,118 +8570,175 @@ func (x *MatchMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchMetadata.ProtoReflect.Descriptor instead.
-func (*MatchMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *MatchMetadata) GetMatchId() string {
+func (x *ListWebhooksResponse) GetSubscriptions() []*WebhookSubscription {
 	if x != nil {
-		return x.MatchId
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// "pending", "delivered" or "failed"
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int64  `protobuf:"varint,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastStatusCode int32  `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt    int64  `protobuf:"varint,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Payload        string `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MatchMetadata) GetMapName() string {
+func (x *WebhookDelivery) GetSubscriptionId() string {
 	if x != nil {
-		return x.MapName
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-func (x *MatchMetadata) GetMapId() string {
+func (x *WebhookDelivery) GetEventId() string {
 	if x != nil {
-		return x.MapId
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *MatchMetadata) GetGameVersion() string {
+func (x *WebhookDelivery) GetEventType() string {
 	if x != nil {
-		return x.GameVersion
+		return x.EventType
 	}
 	return ""
 }
 
-func (x *MatchMetadata) GetTeamRedScore() int32 {
+func (x *WebhookDelivery) GetStatus() string {
 	if x != nil {
-		return x.TeamRedScore
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *MatchMetadata) GetTeamBlueScore() int32 {
+func (x *WebhookDelivery) GetAttempts() int32 {
 	if x != nil {
-		return x.TeamBlueScore
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *MatchMetadata) GetRegion() string {
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
 	if x != nil {
-		return x.Region
+		return x.NextAttemptAt
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchMetadata) GetCluster() string {
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
 	if x != nil {
-		return x.Cluster
+		return x.LastStatusCode
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchMetadata) GetMode() string {
+func (x *WebhookDelivery) GetLastError() string {
 	if x != nil {
-		return x.Mode
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *MatchMetadata) GetSeasonId() string {
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
 	if x != nil {
-		return x.SeasonId
+		return x.DeliveredAt
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchMetadata) GetGameStart() int64 {
+func (x *WebhookDelivery) GetCreatedAt() int64 {
 	if x != nil {
-		return x.GameStart
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *MatchMetadata) GetRoundsPlayed() int32 {
+func (x *WebhookDelivery) GetPayload() string {
 	if x != nil {
-		return x.RoundsPlayed
+		return x.Payload
 	}
-	return 0
+	return ""
 }
 
-type GetPlayerByPuuidRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Puuid         string                 `protobuf:"bytes,1,opt,name=puuid,proto3" json:"puuid,omitempty"`
-	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for all subscriptions
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Limit          int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetPlayerByPuuidRequest) Reset() {
-	*x = GetPlayerByPuuidRequest{}
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[12]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerByPuuidRequest) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerByPuuidRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetPlayerByPuuidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[12]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,23 +8749,67 @@ func (x *GetPlayerByPuuidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerByPuuidRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerByPuuidRequest) Descriptor() ([]byte, []int) {
-	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetPlayerByPuuidRequest) GetPuuid() string {
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() string {
 	if x != nil {
-		return x.Puuid
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-func (x *GetPlayerByPuuidRequest) GetRefresh() bool {
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Refresh
+		return x.Limit
 	}
-	return false
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valorant_v1_tracker_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valorant_v1_tracker_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
 }
 
 var File_proto_valorant_v1_tracker_proto protoreflect.FileDescriptor
@@ -1099,7 +8820,7 @@ const file_proto_valorant_v1_tracker_proto_rawDesc = "" +
 	"\rPlayerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x18\n" +
-	"\arefresh\x18\x03 \x01(\bR\arefresh\"\xe3\x02\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"\xfd\x06\n" +
 	"\x0ePlayerResponse\x12\x14\n" +
 	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -1114,13 +8835,52 @@ const file_proto_valorant_v1_tracker_proto_rawDesc = "" +
 	"\rtotal_matches\x18\n" +
 	" \x01(\x05R\ftotalMatches\x12\x19\n" +
 	"\bkd_ratio\x18\v \x01(\x02R\akdRatio\x12\x19\n" +
-	"\bwin_rate\x18\f \x01(\x02R\awinRate\"*\n" +
+	"\bwin_rate\x18\f \x01(\x02R\awinRate\x12\x10\n" +
+	"\x03acs\x18\r \x01(\x02R\x03acs\x12\x10\n" +
+	"\x03adr\x18\x0e \x01(\x02R\x03adr\x12\x17\n" +
+	"\x04kast\x18\x0f \x01(\x02H\x00R\x04kast\x88\x01\x01\x12!\n" +
+	"\ffirst_bloods\x18\x10 \x01(\x05R\vfirstBloods\x12!\n" +
+	"\ffirst_deaths\x18\x11 \x01(\x05R\vfirstDeaths\x12\x1d\n" +
+	"\n" +
+	"plus_minus\x18\x12 \x01(\x05R\tplusMinus\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\x13 \x01(\x02H\x01R\tavgRating\x88\x01\x01\x12.\n" +
+	"\x10leaderboard_rank\x18\x14 \x01(\x05H\x02R\x0fleaderboardRank\x88\x01\x01\x12-\n" +
+	"\x12leaderboard_region\x18\x15 \x01(\tR\x11leaderboardRegion\x12.\n" +
+	"\astreaks\x18\x16 \x01(\v2\x14.valorant.v1.StreaksR\astreaks\x12A\n" +
+	"\x0eseason_streaks\x18\x17 \x03(\v2\x1a.valorant.v1.SeasonStreaksR\rseasonStreaks\x12%\n" +
+	"\x04tilt\x18\x18 \x01(\v2\x11.valorant.v1.TiltR\x04tilt\x12,\n" +
+	"\x12first_kill_matches\x18\x19 \x01(\x05R\x10firstKillMatchesB\a\n" +
+	"\x05_kastB\r\n" +
+	"\v_avg_ratingB\x13\n" +
+	"\x11_leaderboard_rank\"\x9d\x01\n" +
+	"\aStreaks\x12!\n" +
+	"\fcurrent_wins\x18\x01 \x01(\x05R\vcurrentWins\x12%\n" +
+	"\x0ecurrent_losses\x18\x02 \x01(\x05R\rcurrentLosses\x12!\n" +
+	"\flongest_wins\x18\x03 \x01(\x05R\vlongestWins\x12%\n" +
+	"\x0elongest_losses\x18\x04 \x01(\x05R\rlongestLosses\"\\\n" +
+	"\rSeasonStreaks\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12.\n" +
+	"\astreaks\x18\x02 \x01(\v2\x14.valorant.v1.StreaksR\astreaks\"\x8a\x01\n" +
+	"\tTiltSplit\x12\x14\n" +
+	"\x05games\x18\x01 \x01(\x05R\x05games\x12\x19\n" +
+	"\bwin_rate\x18\x02 \x01(\x02R\awinRate\x12\x19\n" +
+	"\bkd_ratio\x18\x03 \x01(\x02R\akdRatio\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\x04 \x01(\x02H\x00R\tavgRating\x88\x01\x01B\r\n" +
+	"\v_avg_rating\"\xca\x01\n" +
+	"\x04Tilt\x12!\n" +
+	"\fafter_losses\x18\x01 \x01(\x05R\vafterLosses\x122\n" +
+	"\bbaseline\x18\x02 \x01(\v2\x16.valorant.v1.TiltSplitR\bbaseline\x129\n" +
+	"\fafter_streak\x18\x03 \x01(\v2\x16.valorant.v1.TiltSplitR\vafterStreak\x12\x16\n" +
+	"\x06tilted\x18\x04 \x01(\bR\x06tilted\x12\x18\n" +
+	"\ainsight\x18\x05 \x01(\tR\ainsight\"*\n" +
 	"\x04Tier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"@\n" +
 	"\x0eMatchesRequest\x12\x14\n" +
 	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x18\n" +
-	"\arefresh\x18\x02 \x01(\bR\arefresh\"\x83\x05\n" +
+	"\arefresh\x18\x02 \x01(\bR\arefresh\"\xb9\a\n" +
 	"\x05Match\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x19\n" +
 	"\bmap_name\x18\x02 \x01(\tR\amapName\x12\x12\n" +
@@ -1146,13 +8906,27 @@ const file_proto_valorant_v1_tracker_proto_rawDesc = "" +
 	"\x06map_id\x18\x13 \x01(\tR\x05mapId\x12!\n" +
 	"\fcharacter_id\x18\x14 \x01(\tR\vcharacterId\x12!\n" +
 	"\fdamage_taken\x18\x15 \x01(\x05R\vdamageTaken\x12!\n" +
-	"\fdamage_dealt\x18\x16 \x01(\x05R\vdamageDealt\"?\n" +
+	"\fdamage_dealt\x18\x16 \x01(\x05R\vdamageDealt\x12\x10\n" +
+	"\x03acs\x18\x17 \x01(\x02R\x03acs\x12\x10\n" +
+	"\x03adr\x18\x18 \x01(\x02R\x03adr\x12\x17\n" +
+	"\x04kast\x18\x19 \x01(\x02H\x00R\x04kast\x88\x01\x01\x12&\n" +
+	"\ffirst_bloods\x18\x1a \x01(\x05H\x01R\vfirstBloods\x88\x01\x01\x12&\n" +
+	"\ffirst_deaths\x18\x1b \x01(\x05H\x02R\vfirstDeaths\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"plus_minus\x18\x1c \x01(\x05R\tplusMinus\x12\x1b\n" +
+	"\x06rating\x18\x1d \x01(\x02H\x03R\x06rating\x88\x01\x01\x125\n" +
+	"\n" +
+	"lobby_rank\x18\x1e \x01(\v2\x16.valorant.v1.LobbyRankR\tlobbyRankB\a\n" +
+	"\x05_kastB\x0f\n" +
+	"\r_first_bloodsB\x0f\n" +
+	"\r_first_deathsB\t\n" +
+	"\a_rating\"?\n" +
 	"\x0fMatchesResponse\x12,\n" +
 	"\amatches\x18\x01 \x03(\v2\x12.valorant.v1.MatchR\amatches\"0\n" +
 	"\x18SearchSuggestionsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"Z\n" +
 	"\x19SearchSuggestionsResponse\x12=\n" +
-	"\vsuggestions\x18\x01 \x03(\v2\x1b.valorant.v1.PlayerResponseR\vsuggestions\"\xfa\x02\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1b.valorant.v1.PlayerResponseR\vsuggestions\"\xbb\x05\n" +
 	"\vPlayerMatch\x12\x14\n" +
 	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -1168,12 +8942,44 @@ const file_proto_valorant_v1_tracker_proto_rawDesc = "" +
 	"\x04tier\x18\v \x01(\v2\x11.valorant.v1.TierR\x04tier\x12!\n" +
 	"\fcharacter_id\x18\f \x01(\tR\vcharacterId\x12!\n" +
 	"\fdamage_taken\x18\r \x01(\x05R\vdamageTaken\x12!\n" +
-	"\fdamage_dealt\x18\x0e \x01(\x05R\vdamageDealt\",\n" +
+	"\fdamage_dealt\x18\x0e \x01(\x05R\vdamageDealt\x12\x10\n" +
+	"\x03acs\x18\x0f \x01(\x02R\x03acs\x12\x10\n" +
+	"\x03adr\x18\x10 \x01(\x02R\x03adr\x12\x17\n" +
+	"\x04kast\x18\x11 \x01(\x02H\x00R\x04kast\x88\x01\x01\x12&\n" +
+	"\ffirst_bloods\x18\x12 \x01(\x05H\x01R\vfirstBloods\x88\x01\x01\x12&\n" +
+	"\ffirst_deaths\x18\x13 \x01(\x05H\x02R\vfirstDeaths\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"plus_minus\x18\x14 \x01(\x05R\tplusMinus\x12\x1b\n" +
+	"\x06rating\x18\x15 \x01(\x02H\x03R\x06rating\x88\x01\x01\x12,\n" +
+	"\x0fsuspicion_score\x18\x16 \x01(\x02H\x04R\x0esuspicionScore\x88\x01\x01B\a\n" +
+	"\x05_kastB\x0f\n" +
+	"\r_first_bloodsB\x0f\n" +
+	"\r_first_deathsB\t\n" +
+	"\a_ratingB\x12\n" +
+	"\x10_suspicion_score\",\n" +
 	"\x0fGetMatchRequest\x12\x19\n" +
-	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"~\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"\xe6\x01\n" +
 	"\x10GetMatchResponse\x126\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1a.valorant.v1.MatchMetadataR\bmetadata\x122\n" +
-	"\aplayers\x18\x02 \x03(\v2\x18.valorant.v1.PlayerMatchR\aplayers\"\xf4\x02\n" +
+	"\aplayers\x18\x02 \x03(\v2\x18.valorant.v1.PlayerMatchR\aplayers\x12/\n" +
+	"\x06awards\x18\x03 \x03(\v2\x17.valorant.v1.MatchAwardR\x06awards\x125\n" +
+	"\n" +
+	"lobby_rank\x18\x04 \x01(\v2\x16.valorant.v1.LobbyRankR\tlobbyRank\"\x99\x01\n" +
+	"\bTeamRank\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\x12\x1e\n" +
+	"\bavg_tier\x18\x02 \x01(\x02H\x00R\aavgTier\x88\x01\x01\x12%\n" +
+	"\x0eranked_players\x18\x03 \x01(\x05R\rrankedPlayers\x12%\n" +
+	"\x0estored_players\x18\x04 \x01(\x05R\rstoredPlayersB\v\n" +
+	"\t_avg_tier\"\x90\x01\n" +
+	"\tLobbyRank\x12+\n" +
+	"\x05teams\x18\x01 \x03(\v2\x15.valorant.v1.TeamRankR\x05teams\x12+\n" +
+	"\x05lobby\x18\x02 \x01(\v2\x15.valorant.v1.TeamRankR\x05lobby\x12)\n" +
+	"\x10expected_players\x18\x03 \x01(\x05R\x0fexpectedPlayers\"L\n" +
+	"\n" +
+	"MatchAward\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x02R\x05value\"\xf4\x02\n" +
 	"\rMatchMetadata\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x19\n" +
 	"\bmap_name\x18\x02 \x01(\tR\amapName\x12\x15\n" +
@@ -1191,14 +8997,640 @@ const file_proto_valorant_v1_tracker_proto_rawDesc = "" +
 	"\rrounds_played\x18\f \x01(\x05R\froundsPlayed\"I\n" +
 	"\x17GetPlayerByPuuidRequest\x12\x14\n" +
 	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x18\n" +
-	"\arefresh\x18\x02 \x01(\bR\arefresh2\xa4\x03\n" +
+	"\arefresh\x18\x02 \x01(\bR\arefresh\"-\n" +
+	"\x19GetIntegrityReportRequest\x12\x10\n" +
+	"\x03run\x18\x01 \x01(\bR\x03run\"\xa5\x01\n" +
+	"\x0eIntegrityIssue\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12\x14\n" +
+	"\x05puuid\x18\x03 \x01(\tR\x05puuid\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x1a\n" +
+	"\brepaired\x18\x05 \x01(\bR\brepaired\x12\x1a\n" +
+	"\bdeferred\x18\x06 \x01(\bR\bdeferred\"\xf2\x01\n" +
+	"\x1aGetIntegrityReportResponse\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x02 \x01(\x03R\n" +
+	"finishedAt\x12'\n" +
+	"\x0fmatches_scanned\x18\x03 \x01(\x05R\x0ematchesScanned\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\x05R\brepaired\x12\x1a\n" +
+	"\bdeferred\x18\x05 \x01(\x05R\bdeferred\x123\n" +
+	"\x06issues\x18\x06 \x03(\v2\x1b.valorant.v1.IntegrityIssueR\x06issues\"L\n" +
+	"\x13FollowPlayerRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\"\x16\n" +
+	"\x14FollowPlayerResponse\"N\n" +
+	"\x15UnfollowPlayerRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\"\x18\n" +
+	"\x16UnfollowPlayerResponse\"_\n" +
+	"\x0eGetFeedRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\tR\n" +
+	"followerId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x84\x02\n" +
+	"\bFeedItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\tR\n" +
+	"occurredAt\x123\n" +
+	"\x06player\x18\x04 \x01(\v2\x1b.valorant.v1.PlayerResponseR\x06player\x12(\n" +
+	"\x05match\x18\x05 \x01(\v2\x12.valorant.v1.MatchR\x05match\x126\n" +
+	"\rprevious_tier\x18\x06 \x01(\v2\x11.valorant.v1.TierR\fpreviousTier\x12\x1c\n" +
+	"\tmilestone\x18\a \x01(\tR\tmilestone\"_\n" +
+	"\x0fGetFeedResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.valorant.v1.FeedItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"*\n" +
+	"\x12WatchPlayerRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\"\x9a\x01\n" +
+	"\bRRChange\x126\n" +
+	"\rprevious_tier\x18\x01 \x01(\v2\x11.valorant.v1.TierR\fpreviousTier\x12\x1f\n" +
+	"\vprevious_rr\x18\x02 \x01(\x05R\n" +
+	"previousRr\x12%\n" +
+	"\x04tier\x18\x03 \x01(\v2\x11.valorant.v1.TierR\x04tier\x12\x0e\n" +
+	"\x02rr\x18\x04 \x01(\x05R\x02rr\"c\n" +
+	"\rRefreshStatus\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12&\n" +
+	"\x0fnext_refresh_at\x18\x02 \x01(\x03R\rnextRefreshAt\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x88\x02\n" +
+	"\x13WatchPlayerResponse\x129\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1b.valorant.v1.PlayerResponseH\x00R\bsnapshot\x121\n" +
+	"\tnew_match\x18\x02 \x01(\v2\x12.valorant.v1.MatchH\x00R\bnewMatch\x124\n" +
+	"\trr_change\x18\x03 \x01(\v2\x15.valorant.v1.RRChangeH\x00R\brrChange\x12C\n" +
+	"\x0erefresh_status\x18\x04 \x01(\v2\x1a.valorant.v1.RefreshStatusH\x00R\rrefreshStatusB\b\n" +
+	"\x06update\"w\n" +
+	"\x12GetSessionsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x1f\n" +
+	"\vgap_minutes\x18\x02 \x01(\x05R\n" +
+	"gapMinutes\x12\x14\n" +
+	"\x05today\x18\x03 \x01(\bR\x05today\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"_\n" +
+	"\fSessionAgent\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amatches\x18\x03 \x01(\x05R\amatches\"\xc3\x04\n" +
+	"\aSession\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x02 \x01(\tR\aendedAt\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\x12\x18\n" +
+	"\amatches\x18\x04 \x01(\x05R\amatches\x12\x12\n" +
+	"\x04wins\x18\x05 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x06 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\a \x01(\x05R\x05draws\x12\x14\n" +
+	"\x05kills\x18\b \x01(\x05R\x05kills\x12\x16\n" +
+	"\x06deaths\x18\t \x01(\x05R\x06deaths\x12\x18\n" +
+	"\aassists\x18\n" +
+	" \x01(\x05R\aassists\x12\x19\n" +
+	"\bkd_ratio\x18\v \x01(\x02R\akdRatio\x12\x15\n" +
+	"\x06net_rr\x18\f \x01(\x05R\x05netRr\x121\n" +
+	"\x06agents\x18\r \x03(\v2\x19.valorant.v1.SessionAgentR\x06agents\x120\n" +
+	"\n" +
+	"start_tier\x18\x0e \x01(\v2\x11.valorant.v1.TierR\tstartTier\x12,\n" +
+	"\bend_tier\x18\x0f \x01(\v2\x11.valorant.v1.TierR\aendTier\x12\x1b\n" +
+	"\tmatch_ids\x18\x10 \x03(\tR\bmatchIds\x12.\n" +
+	"\astreaks\x18\x11 \x01(\v2\x14.valorant.v1.StreaksR\astreaks\x12\x1d\n" +
+	"\n" +
+	"tilt_games\x18\x12 \x01(\x05R\ttiltGames\"n\n" +
+	"\x13GetSessionsResponse\x120\n" +
+	"\bsessions\x18\x01 \x03(\v2\x14.valorant.v1.SessionR\bsessions\x12%\n" +
+	"\x04tilt\x18\x02 \x01(\v2\x11.valorant.v1.TiltR\x04tilt\"\xc3\x01\n" +
+	"\vStatsFilter\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12#\n" +
+	"\rstarted_after\x18\x03 \x01(\tR\fstartedAfter\x12%\n" +
+	"\x0estarted_before\x18\x04 \x01(\tR\rstartedBefore\x12!\n" +
+	"\fcharacter_id\x18\x05 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05patch\x18\x06 \x01(\tR\x05patch\"\x84\x01\n" +
+	"\x14GetAgentStatsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\x12$\n" +
+	"\x0egroup_by_patch\x18\x03 \x01(\bR\fgroupByPatch\"\xd4\x02\n" +
+	"\n" +
+	"AgentStats\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05games\x18\x03 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\x05 \x01(\x02R\awinRate\x12\x14\n" +
+	"\x05kills\x18\x06 \x01(\x05R\x05kills\x12\x16\n" +
+	"\x06deaths\x18\a \x01(\x05R\x06deaths\x12\x18\n" +
+	"\aassists\x18\b \x01(\x05R\aassists\x12\x19\n" +
+	"\bkd_ratio\x18\t \x01(\x02R\akdRatio\x12\x10\n" +
+	"\x03adr\x18\n" +
+	" \x01(\x02R\x03adr\x12\x1b\n" +
+	"\tavg_score\x18\v \x01(\x02R\bavgScore\x12\"\n" +
+	"\ravg_rr_change\x18\f \x01(\x02R\vavgRrChange\x12\x14\n" +
+	"\x05patch\x18\r \x01(\tR\x05patch\"H\n" +
+	"\x15GetAgentStatsResponse\x12/\n" +
+	"\x06agents\x18\x01 \x03(\v2\x17.valorant.v1.AgentStatsR\x06agents\"\x82\x01\n" +
+	"\x12GetMapStatsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\x12$\n" +
+	"\x0egroup_by_patch\x18\x03 \x01(\bR\fgroupByPatch\"\xc8\x03\n" +
+	"\bMapStats\x12\x15\n" +
+	"\x06map_id\x18\x01 \x01(\tR\x05mapId\x12\x19\n" +
+	"\bmap_name\x18\x02 \x01(\tR\amapName\x12\x14\n" +
+	"\x05games\x18\x03 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\x05 \x01(\x02R\awinRate\x12\x1d\n" +
+	"\n" +
+	"rounds_won\x18\x06 \x01(\x05R\troundsWon\x12\x16\n" +
+	"\x06rounds\x18\a \x01(\x05R\x06rounds\x12$\n" +
+	"\x0eround_win_rate\x18\b \x01(\x02R\froundWinRate\x12\x19\n" +
+	"\bkd_ratio\x18\t \x01(\x02R\akdRatio\x12\x10\n" +
+	"\x03acs\x18\n" +
+	" \x01(\x02R\x03acs\x126\n" +
+	"\x15attack_round_win_rate\x18\v \x01(\x02H\x00R\x12attackRoundWinRate\x88\x01\x01\x128\n" +
+	"\x16defense_round_win_rate\x18\f \x01(\x02H\x01R\x13defenseRoundWinRate\x88\x01\x01\x12\x14\n" +
+	"\x05patch\x18\r \x01(\tR\x05patchB\x18\n" +
+	"\x16_attack_round_win_rateB\x19\n" +
+	"\x17_defense_round_win_rate\"@\n" +
+	"\x13GetMapStatsResponse\x12)\n" +
+	"\x04maps\x18\x01 \x03(\v2\x15.valorant.v1.MapStatsR\x04maps\"\x86\x01\n" +
+	"\x16GetOverallStatsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\x12$\n" +
+	"\x0egroup_by_patch\x18\x03 \x01(\bR\fgroupByPatch\"\x80\x03\n" +
+	"\fOverallStats\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\x12\x14\n" +
+	"\x05games\x18\x02 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x04 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x05 \x01(\x05R\x05draws\x12\x19\n" +
+	"\bwin_rate\x18\x06 \x01(\x02R\awinRate\x12\x14\n" +
+	"\x05kills\x18\a \x01(\x05R\x05kills\x12\x16\n" +
+	"\x06deaths\x18\b \x01(\x05R\x06deaths\x12\x18\n" +
+	"\aassists\x18\t \x01(\x05R\aassists\x12\x19\n" +
+	"\bkd_ratio\x18\n" +
+	" \x01(\x02R\akdRatio\x12\x10\n" +
+	"\x03acs\x18\v \x01(\x02R\x03acs\x12\x10\n" +
+	"\x03adr\x18\f \x01(\x02R\x03adr\x12\x1b\n" +
+	"\trr_change\x18\r \x01(\x05R\brrChange\x12\"\n" +
+	"\ravg_rr_change\x18\x0e \x01(\x02R\vavgRrChange\x12\x1f\n" +
+	"\vrated_games\x18\x0f \x01(\x05R\n" +
+	"ratedGames\"J\n" +
+	"\x17GetOverallStatsResponse\x12/\n" +
+	"\x05stats\x18\x01 \x03(\v2\x19.valorant.v1.OverallStatsR\x05stats\"`\n" +
+	"\x16GetClusterStatsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\"\xe9\x02\n" +
+	"\fClusterStats\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x14\n" +
+	"\x05games\x18\x02 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x03 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x04 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x05 \x01(\x05R\x05draws\x12\x19\n" +
+	"\bwin_rate\x18\x06 \x01(\x02R\awinRate\x12\x19\n" +
+	"\bkd_ratio\x18\a \x01(\x02R\akdRatio\x12\x10\n" +
+	"\x03acs\x18\b \x01(\x02R\x03acs\x12\x10\n" +
+	"\x03adr\x18\t \x01(\x02R\x03adr\x12'\n" +
+	"\ravg_rr_change\x18\n" +
+	" \x01(\x02H\x00R\vavgRrChange\x88\x01\x01\x12\x1f\n" +
+	"\vrated_games\x18\v \x01(\x05R\n" +
+	"ratedGames\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\f \x01(\x02H\x01R\tavgRating\x88\x01\x01B\x10\n" +
+	"\x0e_avg_rr_changeB\r\n" +
+	"\v_avg_rating\"\x85\x01\n" +
+	"\x17GetClusterStatsResponse\x125\n" +
+	"\bclusters\x18\x01 \x03(\v2\x19.valorant.v1.ClusterStatsR\bclusters\x123\n" +
+	"\aoverall\x18\x02 \x01(\v2\x19.valorant.v1.OverallStatsR\aoverall\"a\n" +
+	"\x1dGetClusterDistributionRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\tR\x05patch\"\xdc\x01\n" +
+	"\fClusterShare\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x18\n" +
+	"\amatches\x18\x03 \x01(\x05R\amatches\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\x02R\x05share\x12\x18\n" +
+	"\aplayers\x18\x05 \x01(\x05R\aplayers\x12\x1d\n" +
+	"\n" +
+	"avg_rounds\x18\x06 \x01(\x02R\tavgRounds\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\a \x01(\x02H\x00R\tavgRating\x88\x01\x01B\r\n" +
+	"\v_avg_rating\"q\n" +
+	"\x1eGetClusterDistributionResponse\x125\n" +
+	"\bclusters\x18\x01 \x03(\v2\x19.valorant.v1.ClusterShareR\bclusters\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\x05R\amatches\"_\n" +
+	"\x15GetWeaponStatsRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\"\x9d\x02\n" +
+	"\vWeaponStats\x12\x14\n" +
+	"\x05kills\x18\x01 \x01(\x05R\x05kills\x12\x16\n" +
+	"\x06rounds\x18\x02 \x01(\x05R\x06rounds\x12\x1f\n" +
+	"\vround_kills\x18\x03 \x01(\x05R\n" +
+	"roundKills\x12+\n" +
+	"\x0fkills_per_round\x18\x04 \x01(\x02H\x00R\rkillsPerRound\x88\x01\x01\x12(\n" +
+	"\rheadshot_rate\x18\x05 \x01(\x02H\x01R\fheadshotRate\x88\x01\x01\x12-\n" +
+	"\x10damage_per_round\x18\x06 \x01(\x02H\x02R\x0edamagePerRound\x88\x01\x01B\x12\n" +
+	"\x10_kills_per_roundB\x10\n" +
+	"\x0e_headshot_rateB\x13\n" +
+	"\x11_damage_per_round\"r\n" +
+	"\x0eWeaponMapStats\x12\x15\n" +
+	"\x06map_id\x18\x01 \x01(\tR\x05mapId\x12\x19\n" +
+	"\bmap_name\x18\x02 \x01(\tR\amapName\x12.\n" +
+	"\x05stats\x18\x03 \x01(\v2\x18.valorant.v1.WeaponStatsR\x05stats\"\x88\x01\n" +
+	"\x11WeaponSeasonStats\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12&\n" +
+	"\x0ffirst_played_at\x18\x02 \x01(\tR\rfirstPlayedAt\x12.\n" +
+	"\x05stats\x18\x03 \x01(\v2\x18.valorant.v1.WeaponStatsR\x05stats\"\xea\x01\n" +
+	"\x0fWeaponBreakdown\x12\x1b\n" +
+	"\tweapon_id\x18\x01 \x01(\tR\bweaponId\x12\x1f\n" +
+	"\vweapon_name\x18\x02 \x01(\tR\n" +
+	"weaponName\x12.\n" +
+	"\x05stats\x18\x03 \x01(\v2\x18.valorant.v1.WeaponStatsR\x05stats\x12/\n" +
+	"\x04maps\x18\x04 \x03(\v2\x1b.valorant.v1.WeaponMapStatsR\x04maps\x128\n" +
+	"\aseasons\x18\x05 \x03(\v2\x1e.valorant.v1.WeaponSeasonStatsR\aseasons\"P\n" +
+	"\x16GetWeaponStatsResponse\x126\n" +
+	"\aweapons\x18\x01 \x03(\v2\x1c.valorant.v1.WeaponBreakdownR\aweapons\"X\n" +
+	"\x14GetWeaponMetaRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\tR\x05patch\"\xb4\x02\n" +
+	"\n" +
+	"WeaponMeta\x12\x1b\n" +
+	"\tweapon_id\x18\x01 \x01(\tR\bweaponId\x12\x1f\n" +
+	"\vweapon_name\x18\x02 \x01(\tR\n" +
+	"weaponName\x12\x14\n" +
+	"\x05kills\x18\x03 \x01(\x05R\x05kills\x12\x1d\n" +
+	"\n" +
+	"kill_share\x18\x04 \x01(\x02R\tkillShare\x12\x16\n" +
+	"\x06rounds\x18\x05 \x01(\x05R\x06rounds\x12\x1b\n" +
+	"\tpick_rate\x18\x06 \x01(\x02R\bpickRate\x12(\n" +
+	"\rheadshot_rate\x18\a \x01(\x02H\x00R\fheadshotRate\x88\x01\x01\x12-\n" +
+	"\x10damage_per_round\x18\b \x01(\x02H\x01R\x0edamagePerRound\x88\x01\x01B\x10\n" +
+	"\x0e_headshot_rateB\x13\n" +
+	"\x11_damage_per_round\"\x85\x01\n" +
+	"\x15GetWeaponMetaResponse\x121\n" +
+	"\aweapons\x18\x01 \x03(\v2\x17.valorant.v1.WeaponMetaR\aweapons\x12\x14\n" +
+	"\x05kills\x18\x02 \x01(\x05R\x05kills\x12#\n" +
+	"\rplayer_rounds\x18\x03 \x01(\x05R\fplayerRounds\"\x14\n" +
+	"\x12ListPatchesRequest\"\x85\x01\n" +
+	"\x05Patch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05major\x18\x02 \x01(\x05R\x05major\x12\x14\n" +
+	"\x05minor\x18\x03 \x01(\x05R\x05minor\x12\"\n" +
+	"\rfirst_seen_at\x18\x04 \x01(\tR\vfirstSeenAt\x12\x18\n" +
+	"\amatches\x18\x05 \x01(\x05R\amatches\"C\n" +
+	"\x13ListPatchesResponse\x12,\n" +
+	"\apatches\x18\x01 \x03(\v2\x12.valorant.v1.PatchR\apatches\"?\n" +
+	"\x13GetPatchMetaRequest\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"\xda\x01\n" +
+	"\n" +
+	"PatchAgent\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05games\x18\x03 \x01(\x05R\x05games\x12\x18\n" +
+	"\amatches\x18\x04 \x01(\x05R\amatches\x12\x1b\n" +
+	"\tpick_rate\x18\x05 \x01(\x02R\bpickRate\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\a \x01(\x02R\awinRate\x12\x19\n" +
+	"\bkd_ratio\x18\b \x01(\x02R\akdRatio\"w\n" +
+	"\x14GetPatchMetaResponse\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\x05R\amatches\x12/\n" +
+	"\x06agents\x18\x03 \x03(\v2\x17.valorant.v1.PatchAgentR\x06agents\"\x85\x01\n" +
+	"\x1aGetOpponentStrengthRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\x12\x1f\n" +
+	"\vbucket_days\x18\x03 \x01(\x05R\n" +
+	"bucketDays\"\xd5\x01\n" +
+	"\x16OpponentStrengthPeriod\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\x05R\amatches\x12)\n" +
+	"\x04team\x18\x03 \x01(\v2\x15.valorant.v1.TeamRankR\x04team\x123\n" +
+	"\topponents\x18\x04 \x01(\v2\x15.valorant.v1.TeamRankR\topponents\x12+\n" +
+	"\x11opponent_coverage\x18\x05 \x01(\x02R\x10opponentCoverage\"\x8f\x02\n" +
+	"\x1bGetOpponentStrengthResponse\x12=\n" +
+	"\aperiods\x18\x01 \x03(\v2#.valorant.v1.OpponentStrengthPeriodR\aperiods\x12=\n" +
+	"\aoverall\x18\x02 \x01(\v2#.valorant.v1.OpponentStrengthPeriodR\aoverall\x12#\n" +
+	"\rrated_matches\x18\x03 \x01(\x05R\fratedMatches\x124\n" +
+	"\x14tier_change_per_week\x18\x04 \x01(\x02H\x00R\x11tierChangePerWeek\x88\x01\x01B\x17\n" +
+	"\x15_tier_change_per_week\"w\n" +
+	"\x11GetHeatmapRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.valorant.v1.StatsFilterR\x06filter\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\xd7\x02\n" +
+	"\rHeatmapBucket\x12\x1d\n" +
+	"\aweekday\x18\x01 \x01(\x05H\x00R\aweekday\x88\x01\x01\x12\x17\n" +
+	"\x04hour\x18\x02 \x01(\x05H\x01R\x04hour\x88\x01\x01\x12\x14\n" +
+	"\x05games\x18\x03 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\x05 \x01(\x02R\awinRate\x12\x19\n" +
+	"\bkd_ratio\x18\x06 \x01(\x02R\akdRatio\x12\x10\n" +
+	"\x03acs\x18\a \x01(\x02R\x03acs\x12'\n" +
+	"\ravg_rr_change\x18\b \x01(\x02H\x02R\vavgRrChange\x88\x01\x01\x12\x19\n" +
+	"\brr_games\x18\t \x01(\x05R\arrGames\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\n" +
+	" \x01(\x02H\x03R\tavgRating\x88\x01\x01B\n" +
+	"\n" +
+	"\b_weekdayB\a\n" +
+	"\x05_hourB\x10\n" +
+	"\x0e_avg_rr_changeB\r\n" +
+	"\v_avg_rating\"\x82\x02\n" +
+	"\x12GetHeatmapResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x124\n" +
+	"\aoverall\x18\x02 \x01(\v2\x1a.valorant.v1.HeatmapBucketR\aoverall\x120\n" +
+	"\x05hours\x18\x03 \x03(\v2\x1a.valorant.v1.HeatmapBucketR\x05hours\x126\n" +
+	"\bweekdays\x18\x04 \x03(\v2\x1a.valorant.v1.HeatmapBucketR\bweekdays\x120\n" +
+	"\x05cells\x18\x05 \x03(\v2\x1a.valorant.v1.HeatmapBucketR\x05cells\"\x8d\x03\n" +
+	"\tEncounter\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"games_with\x18\x04 \x01(\x05R\tgamesWith\x12\x1b\n" +
+	"\twins_with\x18\x05 \x01(\x05R\bwinsWith\x12\"\n" +
+	"\rwin_rate_with\x18\x06 \x01(\x02R\vwinRateWith\x12#\n" +
+	"\rgames_against\x18\a \x01(\x05R\fgamesAgainst\x12!\n" +
+	"\fwins_against\x18\b \x01(\x05R\vwinsAgainst\x12(\n" +
+	"\x10win_rate_against\x18\t \x01(\x02R\x0ewinRateAgainst\x12\"\n" +
+	"\rlast_match_id\x18\n" +
+	" \x01(\tR\vlastMatchId\x12$\n" +
+	"\x0elast_played_at\x18\v \x01(\tR\flastPlayedAt\x12(\n" +
+	"\x10last_as_teammate\x18\f \x01(\bR\x0elastAsTeammate\"B\n" +
+	"\x14GetEncountersRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"O\n" +
+	"\x15GetEncountersResponse\x126\n" +
+	"\n" +
+	"encounters\x18\x01 \x03(\v2\x16.valorant.v1.EncounterR\n" +
+	"encounters\"v\n" +
+	"\x13GetEncounterRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x1f\n" +
+	"\vother_puuid\x18\x02 \x01(\tR\n" +
+	"otherPuuid\x12(\n" +
+	"\x10exclude_match_id\x18\x03 \x01(\tR\x0eexcludeMatchId\"L\n" +
+	"\x14GetEncounterResponse\x124\n" +
+	"\tencounter\x18\x01 \x01(\v2\x16.valorant.v1.EncounterR\tencounter\"\x89\x01\n" +
+	"\n" +
+	"QueueSplit\x12\x14\n" +
+	"\x05games\x18\x01 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\x03 \x01(\x02R\awinRate\x12\x1b\n" +
+	"\trr_change\x18\x04 \x01(\x05R\brrChange\x12\x19\n" +
+	"\bkd_ratio\x18\x05 \x01(\x02R\akdRatio\"\xa1\x01\n" +
+	"\x0fTeammateSynergy\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\x12-\n" +
+	"\x05stats\x18\x04 \x01(\v2\x17.valorant.v1.QueueSplitR\x05stats\x12#\n" +
+	"\rpremade_games\x18\x05 \x01(\x05R\fpremadeGames\"\\\n" +
+	"\x11GetSynergyRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x1b\n" +
+	"\tmin_games\x18\x02 \x01(\x05R\bminGames\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xdb\x01\n" +
+	"\x12GetSynergyResponse\x12:\n" +
+	"\tteammates\x18\x01 \x03(\v2\x1c.valorant.v1.TeammateSynergyR\tteammates\x12+\n" +
+	"\x04solo\x18\x02 \x01(\v2\x17.valorant.v1.QueueSplitR\x04solo\x12-\n" +
+	"\x05party\x18\x03 \x01(\v2\x17.valorant.v1.QueueSplitR\x05party\x12-\n" +
+	"\x12unclassified_games\x18\x04 \x01(\x05R\x11unclassifiedGames\"G\n" +
+	"\tPlayerRef\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\"w\n" +
+	"\x15ComparePlayersRequest\x120\n" +
+	"\aplayers\x18\x01 \x03(\v2\x16.valorant.v1.PlayerRefR\aplayers\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"\xa8\x01\n" +
+	"\tRankPoint\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12%\n" +
+	"\x04tier\x18\x03 \x01(\v2\x11.valorant.v1.TierR\x04tier\x12&\n" +
+	"\x0franking_in_tier\x18\x04 \x01(\x05R\rrankingInTier\x12\x1d\n" +
+	"\n" +
+	"mmr_change\x18\x05 \x01(\x05R\tmmrChange\"\x82\x01\n" +
+	"\x0eComparedPlayer\x123\n" +
+	"\x06player\x18\x01 \x01(\v2\x1b.valorant.v1.PlayerResponseR\x06player\x12;\n" +
+	"\rrank_timeline\x18\x02 \x03(\v2\x16.valorant.v1.RankPointR\frankTimeline\"\\\n" +
+	"\fUsageOverlap\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05games\x18\x03 \x03(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x04 \x03(\x05R\x04wins\"\xc4\x01\n" +
+	"\fSharedRecord\x12\x17\n" +
+	"\apuuid_a\x18\x01 \x01(\tR\x06puuidA\x12\x17\n" +
+	"\apuuid_b\x18\x02 \x01(\tR\x06puuidB\x12\x1d\n" +
+	"\n" +
+	"games_with\x18\x03 \x01(\x05R\tgamesWith\x12\x1b\n" +
+	"\twins_with\x18\x04 \x01(\x05R\bwinsWith\x12#\n" +
+	"\rgames_against\x18\x05 \x01(\x05R\fgamesAgainst\x12!\n" +
+	"\fwins_against\x18\x06 \x01(\x05R\vwinsAgainst\"\xe6\x01\n" +
+	"\x16ComparePlayersResponse\x125\n" +
+	"\aplayers\x18\x01 \x03(\v2\x1b.valorant.v1.ComparedPlayerR\aplayers\x121\n" +
+	"\x06agents\x18\x02 \x03(\v2\x19.valorant.v1.UsageOverlapR\x06agents\x12-\n" +
+	"\x04maps\x18\x03 \x03(\v2\x19.valorant.v1.UsageOverlapR\x04maps\x123\n" +
+	"\arecords\x18\x04 \x03(\v2\x19.valorant.v1.SharedRecordR\arecords\"\xc5\x01\n" +
+	"\x15GetLeaderboardRequest\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x12\x14\n" +
+	"\x05group\x18\x04 \x01(\tR\x05group\x12\x1b\n" +
+	"\tmin_games\x18\x05 \x01(\x05R\bminGames\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"\xc4\x02\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12%\n" +
+	"\x04tier\x18\x06 \x01(\v2\x11.valorant.v1.TierR\x04tier\x12\x0e\n" +
+	"\x02rr\x18\a \x01(\x05R\x02rr\x12\x14\n" +
+	"\x05games\x18\b \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\t \x01(\x05R\x04wins\x12\x19\n" +
+	"\bwin_rate\x18\n" +
+	" \x01(\x02R\awinRate\x12\x19\n" +
+	"\bkd_ratio\x18\v \x01(\x02R\akdRatio\x12\"\n" +
+	"\n" +
+	"avg_rating\x18\f \x01(\x02H\x00R\tavgRating\x88\x01\x01B\r\n" +
+	"\v_avg_rating\"r\n" +
+	"\x16GetLeaderboardResponse\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.valorant.v1.LeaderboardEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"C\n" +
+	"\x15AddGroupMemberRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\"\x18\n" +
+	"\x16AddGroupMemberResponse\"F\n" +
+	"\x18RemoveGroupMemberRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\"\x1b\n" +
+	"\x19RemoveGroupMemberResponse\"/\n" +
+	"\x17ListGroupMembersRequest\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\"2\n" +
+	"\x18ListGroupMembersResponse\x12\x16\n" +
+	"\x06puuids\x18\x01 \x03(\tR\x06puuids\"\x84\x01\n" +
+	"\x1dGetOfficialLeaderboardRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x16\n" +
+	"\x06search\x18\x02 \x01(\tR\x06search\x12\x1d\n" +
+	"\n" +
+	"after_rank\x18\x03 \x01(\x05R\tafterRank\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x8b\x02\n" +
+	"\x18OfficialLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05puuid\x18\x02 \x01(\tR\x05puuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12%\n" +
+	"\x04tier\x18\x05 \x01(\v2\x11.valorant.v1.TierR\x04tier\x12\x0e\n" +
+	"\x02rr\x18\x06 \x01(\x05R\x02rr\x12\x12\n" +
+	"\x04wins\x18\a \x01(\x05R\x04wins\x12(\n" +
+	"\rprevious_rank\x18\b \x01(\x05H\x00R\fpreviousRank\x88\x01\x01\x12\x18\n" +
+	"\atracked\x18\t \x01(\bR\atrackedB\x10\n" +
+	"\x0e_previous_rank\"\x80\x01\n" +
+	"\x1eGetOfficialLeaderboardResponse\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\x01 \x01(\tR\tfetchedAt\x12?\n" +
+	"\aentries\x18\x02 \x03(\v2%.valorant.v1.OfficialLeaderboardEntryR\aentries\"+\n" +
+	"\x13GetSuspicionRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\"\x87\x02\n" +
+	"\x0fSuspicionSignal\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x02R\x05value\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x02R\tthreshold\x12\x1e\n" +
+	"\n" +
+	"saturation\x18\x05 \x01(\x02R\n" +
+	"saturation\x12\x1a\n" +
+	"\bstrength\x18\x06 \x01(\x02R\bstrength\x12\x16\n" +
+	"\x06weight\x18\a \x01(\x02R\x06weight\x12\"\n" +
+	"\fcontribution\x18\b \x01(\x02R\fcontribution\x12\x16\n" +
+	"\x06detail\x18\t \x01(\tR\x06detail\"\x96\x01\n" +
+	"\x14GetSuspicionResponse\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\x12\x1a\n" +
+	"\bcoverage\x18\x03 \x01(\x02R\bcoverage\x126\n" +
+	"\asignals\x18\x04 \x03(\v2\x1c.valorant.v1.SuspicionSignalR\asignals\"F\n" +
+	"\x18GetRankProjectionRequest\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12\x14\n" +
+	"\x05games\x18\x02 \x01(\x05R\x05games\"\xeb\x06\n" +
+	"\x19GetRankProjectionResponse\x12\x14\n" +
+	"\x05puuid\x18\x01 \x01(\tR\x05puuid\x12%\n" +
+	"\x04tier\x18\x02 \x01(\v2\x11.valorant.v1.TierR\x04tier\x12&\n" +
+	"\x0franking_in_tier\x18\x03 \x01(\x05R\rrankingInTier\x12\x10\n" +
+	"\x03elo\x18\x04 \x01(\x05R\x03elo\x12!\n" +
+	"\fsample_games\x18\x05 \x01(\x05R\vsampleGames\x12\x12\n" +
+	"\x04wins\x18\x06 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\a \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\b \x01(\x05R\x05draws\x12\x19\n" +
+	"\bwin_rate\x18\t \x01(\x02R\awinRate\x12 \n" +
+	"\fwin_rate_low\x18\n" +
+	" \x01(\x02R\n" +
+	"winRateLow\x12\"\n" +
+	"\rwin_rate_high\x18\v \x01(\x02R\vwinRateHigh\x12\x19\n" +
+	"\bavg_gain\x18\f \x01(\x02R\aavgGain\x12\x19\n" +
+	"\bavg_loss\x18\r \x01(\x02R\aavgLoss\x12/\n" +
+	"\x14expected_rr_per_game\x18\x0e \x01(\x02R\x11expectedRrPerGame\x12*\n" +
+	"\x0eexpected_games\x18\x0f \x01(\x02H\x00R\rexpectedGames\x88\x01\x01\x121\n" +
+	"\x12expected_games_low\x18\x10 \x01(\x02H\x01R\x10expectedGamesLow\x88\x01\x01\x123\n" +
+	"\x13expected_games_high\x18\x11 \x01(\x02H\x02R\x11expectedGamesHigh\x88\x01\x01\x12\x14\n" +
+	"\x05games\x18\x12 \x01(\x05R\x05games\x12)\n" +
+	"\x10promotion_chance\x18\x13 \x01(\x02R\x0fpromotionChance\x120\n" +
+	"\x14promotion_chance_low\x18\x14 \x01(\x02R\x12promotionChanceLow\x122\n" +
+	"\x15promotion_chance_high\x18\x15 \x01(\x02R\x13promotionChanceHigh\x12-\n" +
+	"\x12unavailable_reason\x18\x16 \x01(\tR\x11unavailableReasonB\x11\n" +
+	"\x0f_expected_gamesB\x15\n" +
+	"\x13_expected_games_lowB\x16\n" +
+	"\x14_expected_games_high\"\xc6\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x14\n" +
+	"\x05puuid\x18\x04 \x01(\tR\x05puuid\x12&\n" +
+	"\frr_threshold\x18\x05 \x01(\x05H\x00R\vrrThreshold\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAtB\x0f\n" +
+	"\r_rr_threshold\"\xb0\x01\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12\x14\n" +
+	"\x05puuid\x18\x03 \x01(\tR\x05puuid\x12&\n" +
+	"\frr_threshold\x18\x04 \x01(\x05H\x00R\vrrThreshold\x88\x01\x01\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secretB\x0f\n" +
+	"\r_rr_threshold\"u\n" +
+	"\x15CreateWebhookResponse\x12D\n" +
+	"\fsubscription\x18\x01 \x01(\v2 .valorant.v1.WebhookSubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteWebhookResponse\"\x15\n" +
+	"\x13ListWebhooksRequest\"^\n" +
+	"\x14ListWebhooksResponse\x12F\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2 .valorant.v1.WebhookSubscriptionR\rsubscriptions\"\x85\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\x03R\rnextAttemptAt\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\x03R\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\apayload\x18\f \x01(\tR\apayload\"]\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"]\n" +
+	"\x1dListWebhookDeliveriesResponse\x12<\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1c.valorant.v1.WebhookDeliveryR\n" +
+	"deliveries2\x86\x1a\n" +
 	"\x0fValorantTracker\x12D\n" +
 	"\tGetPlayer\x12\x1a.valorant.v1.PlayerRequest\x1a\x1b.valorant.v1.PlayerResponse\x12G\n" +
 	"\n" +
 	"GetMatches\x12\x1b.valorant.v1.MatchesRequest\x1a\x1c.valorant.v1.MatchesResponse\x12b\n" +
 	"\x11SearchSuggestions\x12%.valorant.v1.SearchSuggestionsRequest\x1a&.valorant.v1.SearchSuggestionsResponse\x12G\n" +
 	"\bGetMatch\x12\x1c.valorant.v1.GetMatchRequest\x1a\x1d.valorant.v1.GetMatchResponse\x12U\n" +
-	"\x10GetPlayerByPuuid\x12$.valorant.v1.GetPlayerByPuuidRequest\x1a\x1b.valorant.v1.PlayerResponseB\x99\x01\n" +
+	"\x10GetPlayerByPuuid\x12$.valorant.v1.GetPlayerByPuuidRequest\x1a\x1b.valorant.v1.PlayerResponse\x12S\n" +
+	"\fFollowPlayer\x12 .valorant.v1.FollowPlayerRequest\x1a!.valorant.v1.FollowPlayerResponse\x12Y\n" +
+	"\x0eUnfollowPlayer\x12\".valorant.v1.UnfollowPlayerRequest\x1a#.valorant.v1.UnfollowPlayerResponse\x12D\n" +
+	"\aGetFeed\x12\x1b.valorant.v1.GetFeedRequest\x1a\x1c.valorant.v1.GetFeedResponse\x12R\n" +
+	"\vWatchPlayer\x12\x1f.valorant.v1.WatchPlayerRequest\x1a .valorant.v1.WatchPlayerResponse0\x01\x12P\n" +
+	"\vGetSessions\x12\x1f.valorant.v1.GetSessionsRequest\x1a .valorant.v1.GetSessionsResponse\x12V\n" +
+	"\rGetAgentStats\x12!.valorant.v1.GetAgentStatsRequest\x1a\".valorant.v1.GetAgentStatsResponse\x12P\n" +
+	"\vGetMapStats\x12\x1f.valorant.v1.GetMapStatsRequest\x1a .valorant.v1.GetMapStatsResponse\x12h\n" +
+	"\x13GetOpponentStrength\x12'.valorant.v1.GetOpponentStrengthRequest\x1a(.valorant.v1.GetOpponentStrengthResponse\x12M\n" +
+	"\n" +
+	"GetHeatmap\x12\x1e.valorant.v1.GetHeatmapRequest\x1a\x1f.valorant.v1.GetHeatmapResponse\x12\\\n" +
+	"\x0fGetOverallStats\x12#.valorant.v1.GetOverallStatsRequest\x1a$.valorant.v1.GetOverallStatsResponse\x12P\n" +
+	"\vListPatches\x12\x1f.valorant.v1.ListPatchesRequest\x1a .valorant.v1.ListPatchesResponse\x12\\\n" +
+	"\x0fGetClusterStats\x12#.valorant.v1.GetClusterStatsRequest\x1a$.valorant.v1.GetClusterStatsResponse\x12q\n" +
+	"\x16GetClusterDistribution\x12*.valorant.v1.GetClusterDistributionRequest\x1a+.valorant.v1.GetClusterDistributionResponse\x12Y\n" +
+	"\x0eGetWeaponStats\x12\".valorant.v1.GetWeaponStatsRequest\x1a#.valorant.v1.GetWeaponStatsResponse\x12V\n" +
+	"\rGetWeaponMeta\x12!.valorant.v1.GetWeaponMetaRequest\x1a\".valorant.v1.GetWeaponMetaResponse\x12S\n" +
+	"\fGetPatchMeta\x12 .valorant.v1.GetPatchMetaRequest\x1a!.valorant.v1.GetPatchMetaResponse\x12V\n" +
+	"\rGetEncounters\x12!.valorant.v1.GetEncountersRequest\x1a\".valorant.v1.GetEncountersResponse\x12S\n" +
+	"\fGetEncounter\x12 .valorant.v1.GetEncounterRequest\x1a!.valorant.v1.GetEncounterResponse\x12M\n" +
+	"\n" +
+	"GetSynergy\x12\x1e.valorant.v1.GetSynergyRequest\x1a\x1f.valorant.v1.GetSynergyResponse\x12Y\n" +
+	"\x0eComparePlayers\x12\".valorant.v1.ComparePlayersRequest\x1a#.valorant.v1.ComparePlayersResponse\x12Y\n" +
+	"\x0eGetLeaderboard\x12\".valorant.v1.GetLeaderboardRequest\x1a#.valorant.v1.GetLeaderboardResponse\x12q\n" +
+	"\x16GetOfficialLeaderboard\x12*.valorant.v1.GetOfficialLeaderboardRequest\x1a+.valorant.v1.GetOfficialLeaderboardResponse\x12b\n" +
+	"\x11GetRankProjection\x12%.valorant.v1.GetRankProjectionRequest\x1a&.valorant.v1.GetRankProjectionResponse\x12e\n" +
+	"\x12GetIntegrityReport\x12&.valorant.v1.GetIntegrityReportRequest\x1a'.valorant.v1.GetIntegrityReportResponse\x12V\n" +
+	"\rCreateWebhook\x12!.valorant.v1.CreateWebhookRequest\x1a\".valorant.v1.CreateWebhookResponse\x12V\n" +
+	"\rDeleteWebhook\x12!.valorant.v1.DeleteWebhookRequest\x1a\".valorant.v1.DeleteWebhookResponse\x12S\n" +
+	"\fListWebhooks\x12 .valorant.v1.ListWebhooksRequest\x1a!.valorant.v1.ListWebhooksResponse\x12n\n" +
+	"\x15ListWebhookDeliveries\x12).valorant.v1.ListWebhookDeliveriesRequest\x1a*.valorant.v1.ListWebhookDeliveriesResponse\x12Y\n" +
+	"\x0eAddGroupMember\x12\".valorant.v1.AddGroupMemberRequest\x1a#.valorant.v1.AddGroupMemberResponse\x12b\n" +
+	"\x11RemoveGroupMember\x12%.valorant.v1.RemoveGroupMemberRequest\x1a&.valorant.v1.RemoveGroupMemberResponse\x12_\n" +
+	"\x10ListGroupMembers\x12$.valorant.v1.ListGroupMembersRequest\x1a%.valorant.v1.ListGroupMembersResponse\x12S\n" +
+	"\fGetSuspicion\x12 .valorant.v1.GetSuspicionRequest\x1a!.valorant.v1.GetSuspicionResponseB\x99\x01\n" +
 	"\x0fcom.valorant.v1B\fTrackerProtoP\x01Z+valorant-tracker/gen/valorant/v1;valorantv1\xa2\x02\x03VXX\xaa\x02\vValorant.V1\xca\x02\vValorant\\V1\xe2\x02\x17Valorant\\V1\\GPBMetadata\xea\x02\fValorant::V1b\x06proto3"
 
 var (
@@ -1213,45 +9645,295 @@ func file_proto_valorant_v1_tracker_proto_rawDescGZIP() []byte {
 	return file_proto_valorant_v1_tracker_proto_rawDescData
 }
 
-var file_proto_valorant_v1_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_valorant_v1_tracker_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
 var file_proto_valorant_v1_tracker_proto_goTypes = []any{
-	(*PlayerRequest)(nil),             // 0: valorant.v1.PlayerRequest
-	(*PlayerResponse)(nil),            // 1: valorant.v1.PlayerResponse
-	(*Tier)(nil),                      // 2: valorant.v1.Tier
-	(*MatchesRequest)(nil),            // 3: valorant.v1.MatchesRequest
-	(*Match)(nil),                     // 4: valorant.v1.Match
-	(*MatchesResponse)(nil),           // 5: valorant.v1.MatchesResponse
-	(*SearchSuggestionsRequest)(nil),  // 6: valorant.v1.SearchSuggestionsRequest
-	(*SearchSuggestionsResponse)(nil), // 7: valorant.v1.SearchSuggestionsResponse
-	(*PlayerMatch)(nil),               // 8: valorant.v1.PlayerMatch
-	(*GetMatchRequest)(nil),           // 9: valorant.v1.GetMatchRequest
-	(*GetMatchResponse)(nil),          // 10: valorant.v1.GetMatchResponse
-	(*MatchMetadata)(nil),             // 11: valorant.v1.MatchMetadata
-	(*GetPlayerByPuuidRequest)(nil),   // 12: valorant.v1.GetPlayerByPuuidRequest
+	(*PlayerRequest)(nil),                  // 0: valorant.v1.PlayerRequest
+	(*PlayerResponse)(nil),                 // 1: valorant.v1.PlayerResponse
+	(*Streaks)(nil),                        // 2: valorant.v1.Streaks
+	(*SeasonStreaks)(nil),                  // 3: valorant.v1.SeasonStreaks
+	(*TiltSplit)(nil),                      // 4: valorant.v1.TiltSplit
+	(*Tilt)(nil),                           // 5: valorant.v1.Tilt
+	(*Tier)(nil),                           // 6: valorant.v1.Tier
+	(*MatchesRequest)(nil),                 // 7: valorant.v1.MatchesRequest
+	(*Match)(nil),                          // 8: valorant.v1.Match
+	(*MatchesResponse)(nil),                // 9: valorant.v1.MatchesResponse
+	(*SearchSuggestionsRequest)(nil),       // 10: valorant.v1.SearchSuggestionsRequest
+	(*SearchSuggestionsResponse)(nil),      // 11: valorant.v1.SearchSuggestionsResponse
+	(*PlayerMatch)(nil),                    // 12: valorant.v1.PlayerMatch
+	(*GetMatchRequest)(nil),                // 13: valorant.v1.GetMatchRequest
+	(*GetMatchResponse)(nil),               // 14: valorant.v1.GetMatchResponse
+	(*TeamRank)(nil),                       // 15: valorant.v1.TeamRank
+	(*LobbyRank)(nil),                      // 16: valorant.v1.LobbyRank
+	(*MatchAward)(nil),                     // 17: valorant.v1.MatchAward
+	(*MatchMetadata)(nil),                  // 18: valorant.v1.MatchMetadata
+	(*GetPlayerByPuuidRequest)(nil),        // 19: valorant.v1.GetPlayerByPuuidRequest
+	(*GetIntegrityReportRequest)(nil),      // 20: valorant.v1.GetIntegrityReportRequest
+	(*IntegrityIssue)(nil),                 // 21: valorant.v1.IntegrityIssue
+	(*GetIntegrityReportResponse)(nil),     // 22: valorant.v1.GetIntegrityReportResponse
+	(*FollowPlayerRequest)(nil),            // 23: valorant.v1.FollowPlayerRequest
+	(*FollowPlayerResponse)(nil),           // 24: valorant.v1.FollowPlayerResponse
+	(*UnfollowPlayerRequest)(nil),          // 25: valorant.v1.UnfollowPlayerRequest
+	(*UnfollowPlayerResponse)(nil),         // 26: valorant.v1.UnfollowPlayerResponse
+	(*GetFeedRequest)(nil),                 // 27: valorant.v1.GetFeedRequest
+	(*FeedItem)(nil),                       // 28: valorant.v1.FeedItem
+	(*GetFeedResponse)(nil),                // 29: valorant.v1.GetFeedResponse
+	(*WatchPlayerRequest)(nil),             // 30: valorant.v1.WatchPlayerRequest
+	(*RRChange)(nil),                       // 31: valorant.v1.RRChange
+	(*RefreshStatus)(nil),                  // 32: valorant.v1.RefreshStatus
+	(*WatchPlayerResponse)(nil),            // 33: valorant.v1.WatchPlayerResponse
+	(*GetSessionsRequest)(nil),             // 34: valorant.v1.GetSessionsRequest
+	(*SessionAgent)(nil),                   // 35: valorant.v1.SessionAgent
+	(*Session)(nil),                        // 36: valorant.v1.Session
+	(*GetSessionsResponse)(nil),            // 37: valorant.v1.GetSessionsResponse
+	(*StatsFilter)(nil),                    // 38: valorant.v1.StatsFilter
+	(*GetAgentStatsRequest)(nil),           // 39: valorant.v1.GetAgentStatsRequest
+	(*AgentStats)(nil),                     // 40: valorant.v1.AgentStats
+	(*GetAgentStatsResponse)(nil),          // 41: valorant.v1.GetAgentStatsResponse
+	(*GetMapStatsRequest)(nil),             // 42: valorant.v1.GetMapStatsRequest
+	(*MapStats)(nil),                       // 43: valorant.v1.MapStats
+	(*GetMapStatsResponse)(nil),            // 44: valorant.v1.GetMapStatsResponse
+	(*GetOverallStatsRequest)(nil),         // 45: valorant.v1.GetOverallStatsRequest
+	(*OverallStats)(nil),                   // 46: valorant.v1.OverallStats
+	(*GetOverallStatsResponse)(nil),        // 47: valorant.v1.GetOverallStatsResponse
+	(*GetClusterStatsRequest)(nil),         // 48: valorant.v1.GetClusterStatsRequest
+	(*ClusterStats)(nil),                   // 49: valorant.v1.ClusterStats
+	(*GetClusterStatsResponse)(nil),        // 50: valorant.v1.GetClusterStatsResponse
+	(*GetClusterDistributionRequest)(nil),  // 51: valorant.v1.GetClusterDistributionRequest
+	(*ClusterShare)(nil),                   // 52: valorant.v1.ClusterShare
+	(*GetClusterDistributionResponse)(nil), // 53: valorant.v1.GetClusterDistributionResponse
+	(*GetWeaponStatsRequest)(nil),          // 54: valorant.v1.GetWeaponStatsRequest
+	(*WeaponStats)(nil),                    // 55: valorant.v1.WeaponStats
+	(*WeaponMapStats)(nil),                 // 56: valorant.v1.WeaponMapStats
+	(*WeaponSeasonStats)(nil),              // 57: valorant.v1.WeaponSeasonStats
+	(*WeaponBreakdown)(nil),                // 58: valorant.v1.WeaponBreakdown
+	(*GetWeaponStatsResponse)(nil),         // 59: valorant.v1.GetWeaponStatsResponse
+	(*GetWeaponMetaRequest)(nil),           // 60: valorant.v1.GetWeaponMetaRequest
+	(*WeaponMeta)(nil),                     // 61: valorant.v1.WeaponMeta
+	(*GetWeaponMetaResponse)(nil),          // 62: valorant.v1.GetWeaponMetaResponse
+	(*ListPatchesRequest)(nil),             // 63: valorant.v1.ListPatchesRequest
+	(*Patch)(nil),                          // 64: valorant.v1.Patch
+	(*ListPatchesResponse)(nil),            // 65: valorant.v1.ListPatchesResponse
+	(*GetPatchMetaRequest)(nil),            // 66: valorant.v1.GetPatchMetaRequest
+	(*PatchAgent)(nil),                     // 67: valorant.v1.PatchAgent
+	(*GetPatchMetaResponse)(nil),           // 68: valorant.v1.GetPatchMetaResponse
+	(*GetOpponentStrengthRequest)(nil),     // 69: valorant.v1.GetOpponentStrengthRequest
+	(*OpponentStrengthPeriod)(nil),         // 70: valorant.v1.OpponentStrengthPeriod
+	(*GetOpponentStrengthResponse)(nil),    // 71: valorant.v1.GetOpponentStrengthResponse
+	(*GetHeatmapRequest)(nil),              // 72: valorant.v1.GetHeatmapRequest
+	(*HeatmapBucket)(nil),                  // 73: valorant.v1.HeatmapBucket
+	(*GetHeatmapResponse)(nil),             // 74: valorant.v1.GetHeatmapResponse
+	(*Encounter)(nil),                      // 75: valorant.v1.Encounter
+	(*GetEncountersRequest)(nil),           // 76: valorant.v1.GetEncountersRequest
+	(*GetEncountersResponse)(nil),          // 77: valorant.v1.GetEncountersResponse
+	(*GetEncounterRequest)(nil),            // 78: valorant.v1.GetEncounterRequest
+	(*GetEncounterResponse)(nil),           // 79: valorant.v1.GetEncounterResponse
+	(*QueueSplit)(nil),                     // 80: valorant.v1.QueueSplit
+	(*TeammateSynergy)(nil),                // 81: valorant.v1.TeammateSynergy
+	(*GetSynergyRequest)(nil),              // 82: valorant.v1.GetSynergyRequest
+	(*GetSynergyResponse)(nil),             // 83: valorant.v1.GetSynergyResponse
+	(*PlayerRef)(nil),                      // 84: valorant.v1.PlayerRef
+	(*ComparePlayersRequest)(nil),          // 85: valorant.v1.ComparePlayersRequest
+	(*RankPoint)(nil),                      // 86: valorant.v1.RankPoint
+	(*ComparedPlayer)(nil),                 // 87: valorant.v1.ComparedPlayer
+	(*UsageOverlap)(nil),                   // 88: valorant.v1.UsageOverlap
+	(*SharedRecord)(nil),                   // 89: valorant.v1.SharedRecord
+	(*ComparePlayersResponse)(nil),         // 90: valorant.v1.ComparePlayersResponse
+	(*GetLeaderboardRequest)(nil),          // 91: valorant.v1.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),               // 92: valorant.v1.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),         // 93: valorant.v1.GetLeaderboardResponse
+	(*AddGroupMemberRequest)(nil),          // 94: valorant.v1.AddGroupMemberRequest
+	(*AddGroupMemberResponse)(nil),         // 95: valorant.v1.AddGroupMemberResponse
+	(*RemoveGroupMemberRequest)(nil),       // 96: valorant.v1.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil),      // 97: valorant.v1.RemoveGroupMemberResponse
+	(*ListGroupMembersRequest)(nil),        // 98: valorant.v1.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),       // 99: valorant.v1.ListGroupMembersResponse
+	(*GetOfficialLeaderboardRequest)(nil),  // 100: valorant.v1.GetOfficialLeaderboardRequest
+	(*OfficialLeaderboardEntry)(nil),       // 101: valorant.v1.OfficialLeaderboardEntry
+	(*GetOfficialLeaderboardResponse)(nil), // 102: valorant.v1.GetOfficialLeaderboardResponse
+	(*GetSuspicionRequest)(nil),            // 103: valorant.v1.GetSuspicionRequest
+	(*SuspicionSignal)(nil),                // 104: valorant.v1.SuspicionSignal
+	(*GetSuspicionResponse)(nil),           // 105: valorant.v1.GetSuspicionResponse
+	(*GetRankProjectionRequest)(nil),       // 106: valorant.v1.GetRankProjectionRequest
+	(*GetRankProjectionResponse)(nil),      // 107: valorant.v1.GetRankProjectionResponse
+	(*WebhookSubscription)(nil),            // 108: valorant.v1.WebhookSubscription
+	(*CreateWebhookRequest)(nil),           // 109: valorant.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),          // 110: valorant.v1.CreateWebhookResponse
+	(*DeleteWebhookRequest)(nil),           // 111: valorant.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),          // 112: valorant.v1.DeleteWebhookResponse
+	(*ListWebhooksRequest)(nil),            // 113: valorant.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 114: valorant.v1.ListWebhooksResponse
+	(*WebhookDelivery)(nil),                // 115: valorant.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),   // 116: valorant.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),  // 117: valorant.v1.ListWebhookDeliveriesResponse
 }
 var file_proto_valorant_v1_tracker_proto_depIdxs = []int32{
-	2,  // 0: valorant.v1.PlayerResponse.current_tier:type_name -> valorant.v1.Tier
-	2,  // 1: valorant.v1.Match.tier:type_name -> valorant.v1.Tier
-	4,  // 2: valorant.v1.MatchesResponse.matches:type_name -> valorant.v1.Match
-	1,  // 3: valorant.v1.SearchSuggestionsResponse.suggestions:type_name -> valorant.v1.PlayerResponse
-	2,  // 4: valorant.v1.PlayerMatch.tier:type_name -> valorant.v1.Tier
-	11, // 5: valorant.v1.GetMatchResponse.metadata:type_name -> valorant.v1.MatchMetadata
-	8,  // 6: valorant.v1.GetMatchResponse.players:type_name -> valorant.v1.PlayerMatch
-	0,  // 7: valorant.v1.ValorantTracker.GetPlayer:input_type -> valorant.v1.PlayerRequest
-	3,  // 8: valorant.v1.ValorantTracker.GetMatches:input_type -> valorant.v1.MatchesRequest
-	6,  // 9: valorant.v1.ValorantTracker.SearchSuggestions:input_type -> valorant.v1.SearchSuggestionsRequest
-	9,  // 10: valorant.v1.ValorantTracker.GetMatch:input_type -> valorant.v1.GetMatchRequest
-	12, // 11: valorant.v1.ValorantTracker.GetPlayerByPuuid:input_type -> valorant.v1.GetPlayerByPuuidRequest
-	1,  // 12: valorant.v1.ValorantTracker.GetPlayer:output_type -> valorant.v1.PlayerResponse
-	5,  // 13: valorant.v1.ValorantTracker.GetMatches:output_type -> valorant.v1.MatchesResponse
-	7,  // 14: valorant.v1.ValorantTracker.SearchSuggestions:output_type -> valorant.v1.SearchSuggestionsResponse
-	10, // 15: valorant.v1.ValorantTracker.GetMatch:output_type -> valorant.v1.GetMatchResponse
-	1,  // 16: valorant.v1.ValorantTracker.GetPlayerByPuuid:output_type -> valorant.v1.PlayerResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	6,   // 0: valorant.v1.PlayerResponse.current_tier:type_name -> valorant.v1.Tier
+	2,   // 1: valorant.v1.PlayerResponse.streaks:type_name -> valorant.v1.Streaks
+	3,   // 2: valorant.v1.PlayerResponse.season_streaks:type_name -> valorant.v1.SeasonStreaks
+	5,   // 3: valorant.v1.PlayerResponse.tilt:type_name -> valorant.v1.Tilt
+	2,   // 4: valorant.v1.SeasonStreaks.streaks:type_name -> valorant.v1.Streaks
+	4,   // 5: valorant.v1.Tilt.baseline:type_name -> valorant.v1.TiltSplit
+	4,   // 6: valorant.v1.Tilt.after_streak:type_name -> valorant.v1.TiltSplit
+	6,   // 7: valorant.v1.Match.tier:type_name -> valorant.v1.Tier
+	16,  // 8: valorant.v1.Match.lobby_rank:type_name -> valorant.v1.LobbyRank
+	8,   // 9: valorant.v1.MatchesResponse.matches:type_name -> valorant.v1.Match
+	1,   // 10: valorant.v1.SearchSuggestionsResponse.suggestions:type_name -> valorant.v1.PlayerResponse
+	6,   // 11: valorant.v1.PlayerMatch.tier:type_name -> valorant.v1.Tier
+	18,  // 12: valorant.v1.GetMatchResponse.metadata:type_name -> valorant.v1.MatchMetadata
+	12,  // 13: valorant.v1.GetMatchResponse.players:type_name -> valorant.v1.PlayerMatch
+	17,  // 14: valorant.v1.GetMatchResponse.awards:type_name -> valorant.v1.MatchAward
+	16,  // 15: valorant.v1.GetMatchResponse.lobby_rank:type_name -> valorant.v1.LobbyRank
+	15,  // 16: valorant.v1.LobbyRank.teams:type_name -> valorant.v1.TeamRank
+	15,  // 17: valorant.v1.LobbyRank.lobby:type_name -> valorant.v1.TeamRank
+	21,  // 18: valorant.v1.GetIntegrityReportResponse.issues:type_name -> valorant.v1.IntegrityIssue
+	1,   // 19: valorant.v1.FeedItem.player:type_name -> valorant.v1.PlayerResponse
+	8,   // 20: valorant.v1.FeedItem.match:type_name -> valorant.v1.Match
+	6,   // 21: valorant.v1.FeedItem.previous_tier:type_name -> valorant.v1.Tier
+	28,  // 22: valorant.v1.GetFeedResponse.items:type_name -> valorant.v1.FeedItem
+	6,   // 23: valorant.v1.RRChange.previous_tier:type_name -> valorant.v1.Tier
+	6,   // 24: valorant.v1.RRChange.tier:type_name -> valorant.v1.Tier
+	1,   // 25: valorant.v1.WatchPlayerResponse.snapshot:type_name -> valorant.v1.PlayerResponse
+	8,   // 26: valorant.v1.WatchPlayerResponse.new_match:type_name -> valorant.v1.Match
+	31,  // 27: valorant.v1.WatchPlayerResponse.rr_change:type_name -> valorant.v1.RRChange
+	32,  // 28: valorant.v1.WatchPlayerResponse.refresh_status:type_name -> valorant.v1.RefreshStatus
+	35,  // 29: valorant.v1.Session.agents:type_name -> valorant.v1.SessionAgent
+	6,   // 30: valorant.v1.Session.start_tier:type_name -> valorant.v1.Tier
+	6,   // 31: valorant.v1.Session.end_tier:type_name -> valorant.v1.Tier
+	2,   // 32: valorant.v1.Session.streaks:type_name -> valorant.v1.Streaks
+	36,  // 33: valorant.v1.GetSessionsResponse.sessions:type_name -> valorant.v1.Session
+	5,   // 34: valorant.v1.GetSessionsResponse.tilt:type_name -> valorant.v1.Tilt
+	38,  // 35: valorant.v1.GetAgentStatsRequest.filter:type_name -> valorant.v1.StatsFilter
+	40,  // 36: valorant.v1.GetAgentStatsResponse.agents:type_name -> valorant.v1.AgentStats
+	38,  // 37: valorant.v1.GetMapStatsRequest.filter:type_name -> valorant.v1.StatsFilter
+	43,  // 38: valorant.v1.GetMapStatsResponse.maps:type_name -> valorant.v1.MapStats
+	38,  // 39: valorant.v1.GetOverallStatsRequest.filter:type_name -> valorant.v1.StatsFilter
+	46,  // 40: valorant.v1.GetOverallStatsResponse.stats:type_name -> valorant.v1.OverallStats
+	38,  // 41: valorant.v1.GetClusterStatsRequest.filter:type_name -> valorant.v1.StatsFilter
+	49,  // 42: valorant.v1.GetClusterStatsResponse.clusters:type_name -> valorant.v1.ClusterStats
+	46,  // 43: valorant.v1.GetClusterStatsResponse.overall:type_name -> valorant.v1.OverallStats
+	52,  // 44: valorant.v1.GetClusterDistributionResponse.clusters:type_name -> valorant.v1.ClusterShare
+	38,  // 45: valorant.v1.GetWeaponStatsRequest.filter:type_name -> valorant.v1.StatsFilter
+	55,  // 46: valorant.v1.WeaponMapStats.stats:type_name -> valorant.v1.WeaponStats
+	55,  // 47: valorant.v1.WeaponSeasonStats.stats:type_name -> valorant.v1.WeaponStats
+	55,  // 48: valorant.v1.WeaponBreakdown.stats:type_name -> valorant.v1.WeaponStats
+	56,  // 49: valorant.v1.WeaponBreakdown.maps:type_name -> valorant.v1.WeaponMapStats
+	57,  // 50: valorant.v1.WeaponBreakdown.seasons:type_name -> valorant.v1.WeaponSeasonStats
+	58,  // 51: valorant.v1.GetWeaponStatsResponse.weapons:type_name -> valorant.v1.WeaponBreakdown
+	61,  // 52: valorant.v1.GetWeaponMetaResponse.weapons:type_name -> valorant.v1.WeaponMeta
+	64,  // 53: valorant.v1.ListPatchesResponse.patches:type_name -> valorant.v1.Patch
+	67,  // 54: valorant.v1.GetPatchMetaResponse.agents:type_name -> valorant.v1.PatchAgent
+	38,  // 55: valorant.v1.GetOpponentStrengthRequest.filter:type_name -> valorant.v1.StatsFilter
+	15,  // 56: valorant.v1.OpponentStrengthPeriod.team:type_name -> valorant.v1.TeamRank
+	15,  // 57: valorant.v1.OpponentStrengthPeriod.opponents:type_name -> valorant.v1.TeamRank
+	70,  // 58: valorant.v1.GetOpponentStrengthResponse.periods:type_name -> valorant.v1.OpponentStrengthPeriod
+	70,  // 59: valorant.v1.GetOpponentStrengthResponse.overall:type_name -> valorant.v1.OpponentStrengthPeriod
+	38,  // 60: valorant.v1.GetHeatmapRequest.filter:type_name -> valorant.v1.StatsFilter
+	73,  // 61: valorant.v1.GetHeatmapResponse.overall:type_name -> valorant.v1.HeatmapBucket
+	73,  // 62: valorant.v1.GetHeatmapResponse.hours:type_name -> valorant.v1.HeatmapBucket
+	73,  // 63: valorant.v1.GetHeatmapResponse.weekdays:type_name -> valorant.v1.HeatmapBucket
+	73,  // 64: valorant.v1.GetHeatmapResponse.cells:type_name -> valorant.v1.HeatmapBucket
+	75,  // 65: valorant.v1.GetEncountersResponse.encounters:type_name -> valorant.v1.Encounter
+	75,  // 66: valorant.v1.GetEncounterResponse.encounter:type_name -> valorant.v1.Encounter
+	80,  // 67: valorant.v1.TeammateSynergy.stats:type_name -> valorant.v1.QueueSplit
+	81,  // 68: valorant.v1.GetSynergyResponse.teammates:type_name -> valorant.v1.TeammateSynergy
+	80,  // 69: valorant.v1.GetSynergyResponse.solo:type_name -> valorant.v1.QueueSplit
+	80,  // 70: valorant.v1.GetSynergyResponse.party:type_name -> valorant.v1.QueueSplit
+	84,  // 71: valorant.v1.ComparePlayersRequest.players:type_name -> valorant.v1.PlayerRef
+	6,   // 72: valorant.v1.RankPoint.tier:type_name -> valorant.v1.Tier
+	1,   // 73: valorant.v1.ComparedPlayer.player:type_name -> valorant.v1.PlayerResponse
+	86,  // 74: valorant.v1.ComparedPlayer.rank_timeline:type_name -> valorant.v1.RankPoint
+	87,  // 75: valorant.v1.ComparePlayersResponse.players:type_name -> valorant.v1.ComparedPlayer
+	88,  // 76: valorant.v1.ComparePlayersResponse.agents:type_name -> valorant.v1.UsageOverlap
+	88,  // 77: valorant.v1.ComparePlayersResponse.maps:type_name -> valorant.v1.UsageOverlap
+	89,  // 78: valorant.v1.ComparePlayersResponse.records:type_name -> valorant.v1.SharedRecord
+	6,   // 79: valorant.v1.LeaderboardEntry.tier:type_name -> valorant.v1.Tier
+	92,  // 80: valorant.v1.GetLeaderboardResponse.entries:type_name -> valorant.v1.LeaderboardEntry
+	6,   // 81: valorant.v1.OfficialLeaderboardEntry.tier:type_name -> valorant.v1.Tier
+	101, // 82: valorant.v1.GetOfficialLeaderboardResponse.entries:type_name -> valorant.v1.OfficialLeaderboardEntry
+	104, // 83: valorant.v1.GetSuspicionResponse.signals:type_name -> valorant.v1.SuspicionSignal
+	6,   // 84: valorant.v1.GetRankProjectionResponse.tier:type_name -> valorant.v1.Tier
+	108, // 85: valorant.v1.CreateWebhookResponse.subscription:type_name -> valorant.v1.WebhookSubscription
+	108, // 86: valorant.v1.ListWebhooksResponse.subscriptions:type_name -> valorant.v1.WebhookSubscription
+	115, // 87: valorant.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> valorant.v1.WebhookDelivery
+	0,   // 88: valorant.v1.ValorantTracker.GetPlayer:input_type -> valorant.v1.PlayerRequest
+	7,   // 89: valorant.v1.ValorantTracker.GetMatches:input_type -> valorant.v1.MatchesRequest
+	10,  // 90: valorant.v1.ValorantTracker.SearchSuggestions:input_type -> valorant.v1.SearchSuggestionsRequest
+	13,  // 91: valorant.v1.ValorantTracker.GetMatch:input_type -> valorant.v1.GetMatchRequest
+	19,  // 92: valorant.v1.ValorantTracker.GetPlayerByPuuid:input_type -> valorant.v1.GetPlayerByPuuidRequest
+	23,  // 93: valorant.v1.ValorantTracker.FollowPlayer:input_type -> valorant.v1.FollowPlayerRequest
+	25,  // 94: valorant.v1.ValorantTracker.UnfollowPlayer:input_type -> valorant.v1.UnfollowPlayerRequest
+	27,  // 95: valorant.v1.ValorantTracker.GetFeed:input_type -> valorant.v1.GetFeedRequest
+	30,  // 96: valorant.v1.ValorantTracker.WatchPlayer:input_type -> valorant.v1.WatchPlayerRequest
+	34,  // 97: valorant.v1.ValorantTracker.GetSessions:input_type -> valorant.v1.GetSessionsRequest
+	39,  // 98: valorant.v1.ValorantTracker.GetAgentStats:input_type -> valorant.v1.GetAgentStatsRequest
+	42,  // 99: valorant.v1.ValorantTracker.GetMapStats:input_type -> valorant.v1.GetMapStatsRequest
+	69,  // 100: valorant.v1.ValorantTracker.GetOpponentStrength:input_type -> valorant.v1.GetOpponentStrengthRequest
+	72,  // 101: valorant.v1.ValorantTracker.GetHeatmap:input_type -> valorant.v1.GetHeatmapRequest
+	45,  // 102: valorant.v1.ValorantTracker.GetOverallStats:input_type -> valorant.v1.GetOverallStatsRequest
+	63,  // 103: valorant.v1.ValorantTracker.ListPatches:input_type -> valorant.v1.ListPatchesRequest
+	48,  // 104: valorant.v1.ValorantTracker.GetClusterStats:input_type -> valorant.v1.GetClusterStatsRequest
+	51,  // 105: valorant.v1.ValorantTracker.GetClusterDistribution:input_type -> valorant.v1.GetClusterDistributionRequest
+	54,  // 106: valorant.v1.ValorantTracker.GetWeaponStats:input_type -> valorant.v1.GetWeaponStatsRequest
+	60,  // 107: valorant.v1.ValorantTracker.GetWeaponMeta:input_type -> valorant.v1.GetWeaponMetaRequest
+	66,  // 108: valorant.v1.ValorantTracker.GetPatchMeta:input_type -> valorant.v1.GetPatchMetaRequest
+	76,  // 109: valorant.v1.ValorantTracker.GetEncounters:input_type -> valorant.v1.GetEncountersRequest
+	78,  // 110: valorant.v1.ValorantTracker.GetEncounter:input_type -> valorant.v1.GetEncounterRequest
+	82,  // 111: valorant.v1.ValorantTracker.GetSynergy:input_type -> valorant.v1.GetSynergyRequest
+	85,  // 112: valorant.v1.ValorantTracker.ComparePlayers:input_type -> valorant.v1.ComparePlayersRequest
+	91,  // 113: valorant.v1.ValorantTracker.GetLeaderboard:input_type -> valorant.v1.GetLeaderboardRequest
+	100, // 114: valorant.v1.ValorantTracker.GetOfficialLeaderboard:input_type -> valorant.v1.GetOfficialLeaderboardRequest
+	106, // 115: valorant.v1.ValorantTracker.GetRankProjection:input_type -> valorant.v1.GetRankProjectionRequest
+	20,  // 116: valorant.v1.ValorantTracker.GetIntegrityReport:input_type -> valorant.v1.GetIntegrityReportRequest
+	109, // 117: valorant.v1.ValorantTracker.CreateWebhook:input_type -> valorant.v1.CreateWebhookRequest
+	111, // 118: valorant.v1.ValorantTracker.DeleteWebhook:input_type -> valorant.v1.DeleteWebhookRequest
+	113, // 119: valorant.v1.ValorantTracker.ListWebhooks:input_type -> valorant.v1.ListWebhooksRequest
+	116, // 120: valorant.v1.ValorantTracker.ListWebhookDeliveries:input_type -> valorant.v1.ListWebhookDeliveriesRequest
+	94,  // 121: valorant.v1.ValorantTracker.AddGroupMember:input_type -> valorant.v1.AddGroupMemberRequest
+	96,  // 122: valorant.v1.ValorantTracker.RemoveGroupMember:input_type -> valorant.v1.RemoveGroupMemberRequest
+	98,  // 123: valorant.v1.ValorantTracker.ListGroupMembers:input_type -> valorant.v1.ListGroupMembersRequest
+	103, // 124: valorant.v1.ValorantTracker.GetSuspicion:input_type -> valorant.v1.GetSuspicionRequest
+	1,   // 125: valorant.v1.ValorantTracker.GetPlayer:output_type -> valorant.v1.PlayerResponse
+	9,   // 126: valorant.v1.ValorantTracker.GetMatches:output_type -> valorant.v1.MatchesResponse
+	11,  // 127: valorant.v1.ValorantTracker.SearchSuggestions:output_type -> valorant.v1.SearchSuggestionsResponse
+	14,  // 128: valorant.v1.ValorantTracker.GetMatch:output_type -> valorant.v1.GetMatchResponse
+	1,   // 129: valorant.v1.ValorantTracker.GetPlayerByPuuid:output_type -> valorant.v1.PlayerResponse
+	24,  // 130: valorant.v1.ValorantTracker.FollowPlayer:output_type -> valorant.v1.FollowPlayerResponse
+	26,  // 131: valorant.v1.ValorantTracker.UnfollowPlayer:output_type -> valorant.v1.UnfollowPlayerResponse
+	29,  // 132: valorant.v1.ValorantTracker.GetFeed:output_type -> valorant.v1.GetFeedResponse
+	33,  // 133: valorant.v1.ValorantTracker.WatchPlayer:output_type -> valorant.v1.WatchPlayerResponse
+	37,  // 134: valorant.v1.ValorantTracker.GetSessions:output_type -> valorant.v1.GetSessionsResponse
+	41,  // 135: valorant.v1.ValorantTracker.GetAgentStats:output_type -> valorant.v1.GetAgentStatsResponse
+	44,  // 136: valorant.v1.ValorantTracker.GetMapStats:output_type -> valorant.v1.GetMapStatsResponse
+	71,  // 137: valorant.v1.ValorantTracker.GetOpponentStrength:output_type -> valorant.v1.GetOpponentStrengthResponse
+	74,  // 138: valorant.v1.ValorantTracker.GetHeatmap:output_type -> valorant.v1.GetHeatmapResponse
+	47,  // 139: valorant.v1.ValorantTracker.GetOverallStats:output_type -> valorant.v1.GetOverallStatsResponse
+	65,  // 140: valorant.v1.ValorantTracker.ListPatches:output_type -> valorant.v1.ListPatchesResponse
+	50,  // 141: valorant.v1.ValorantTracker.GetClusterStats:output_type -> valorant.v1.GetClusterStatsResponse
+	53,  // 142: valorant.v1.ValorantTracker.GetClusterDistribution:output_type -> valorant.v1.GetClusterDistributionResponse
+	59,  // 143: valorant.v1.ValorantTracker.GetWeaponStats:output_type -> valorant.v1.GetWeaponStatsResponse
+	62,  // 144: valorant.v1.ValorantTracker.GetWeaponMeta:output_type -> valorant.v1.GetWeaponMetaResponse
+	68,  // 145: valorant.v1.ValorantTracker.GetPatchMeta:output_type -> valorant.v1.GetPatchMetaResponse
+	77,  // 146: valorant.v1.ValorantTracker.GetEncounters:output_type -> valorant.v1.GetEncountersResponse
+	79,  // 147: valorant.v1.ValorantTracker.GetEncounter:output_type -> valorant.v1.GetEncounterResponse
+	83,  // 148: valorant.v1.ValorantTracker.GetSynergy:output_type -> valorant.v1.GetSynergyResponse
+	90,  // 149: valorant.v1.ValorantTracker.ComparePlayers:output_type -> valorant.v1.ComparePlayersResponse
+	93,  // 150: valorant.v1.ValorantTracker.GetLeaderboard:output_type -> valorant.v1.GetLeaderboardResponse
+	102, // 151: valorant.v1.ValorantTracker.GetOfficialLeaderboard:output_type -> valorant.v1.GetOfficialLeaderboardResponse
+	107, // 152: valorant.v1.ValorantTracker.GetRankProjection:output_type -> valorant.v1.GetRankProjectionResponse
+	22,  // 153: valorant.v1.ValorantTracker.GetIntegrityReport:output_type -> valorant.v1.GetIntegrityReportResponse
+	110, // 154: valorant.v1.ValorantTracker.CreateWebhook:output_type -> valorant.v1.CreateWebhookResponse
+	112, // 155: valorant.v1.ValorantTracker.DeleteWebhook:output_type -> valorant.v1.DeleteWebhookResponse
+	114, // 156: valorant.v1.ValorantTracker.ListWebhooks:output_type -> valorant.v1.ListWebhooksResponse
+	117, // 157: valorant.v1.ValorantTracker.ListWebhookDeliveries:output_type -> valorant.v1.ListWebhookDeliveriesResponse
+	95,  // 158: valorant.v1.ValorantTracker.AddGroupMember:output_type -> valorant.v1.AddGroupMemberResponse
+	97,  // 159: valorant.v1.ValorantTracker.RemoveGroupMember:output_type -> valorant.v1.RemoveGroupMemberResponse
+	99,  // 160: valorant.v1.ValorantTracker.ListGroupMembers:output_type -> valorant.v1.ListGroupMembersResponse
+	105, // 161: valorant.v1.ValorantTracker.GetSuspicion:output_type -> valorant.v1.GetSuspicionResponse
+	125, // [125:162] is the sub-list for method output_type
+	88,  // [88:125] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_proto_valorant_v1_tracker_proto_init() }
@@ -1259,13 +9941,36 @@ func file_proto_valorant_v1_tracker_proto_init() {
 	if File_proto_valorant_v1_tracker_proto != nil {
 		return
 	}
+	file_proto_valorant_v1_tracker_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[15].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[33].OneofWrappers = []any{
+		(*WatchPlayerResponse_Snapshot)(nil),
+		(*WatchPlayerResponse_NewMatch)(nil),
+		(*WatchPlayerResponse_RrChange)(nil),
+		(*WatchPlayerResponse_RefreshStatus)(nil),
+	}
+	file_proto_valorant_v1_tracker_proto_msgTypes[43].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[49].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[52].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[55].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[61].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[71].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[73].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[92].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[101].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[107].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[108].OneofWrappers = []any{}
+	file_proto_valorant_v1_tracker_proto_msgTypes[109].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_valorant_v1_tracker_proto_rawDesc), len(file_proto_valorant_v1_tracker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   118,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
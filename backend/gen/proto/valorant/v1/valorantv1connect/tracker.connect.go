@@ -49,6 +49,101 @@ const (
 	// ValorantTrackerGetPlayerByPuuidProcedure is the fully-qualified name of the ValorantTracker's
 	// GetPlayerByPuuid RPC.
 	ValorantTrackerGetPlayerByPuuidProcedure = "/valorant.v1.ValorantTracker/GetPlayerByPuuid"
+	// ValorantTrackerFollowPlayerProcedure is the fully-qualified name of the ValorantTracker's
+	// FollowPlayer RPC.
+	ValorantTrackerFollowPlayerProcedure = "/valorant.v1.ValorantTracker/FollowPlayer"
+	// ValorantTrackerUnfollowPlayerProcedure is the fully-qualified name of the ValorantTracker's
+	// UnfollowPlayer RPC.
+	ValorantTrackerUnfollowPlayerProcedure = "/valorant.v1.ValorantTracker/UnfollowPlayer"
+	// ValorantTrackerGetFeedProcedure is the fully-qualified name of the ValorantTracker's GetFeed RPC.
+	ValorantTrackerGetFeedProcedure = "/valorant.v1.ValorantTracker/GetFeed"
+	// ValorantTrackerWatchPlayerProcedure is the fully-qualified name of the ValorantTracker's
+	// WatchPlayer RPC.
+	ValorantTrackerWatchPlayerProcedure = "/valorant.v1.ValorantTracker/WatchPlayer"
+	// ValorantTrackerGetSessionsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetSessions RPC.
+	ValorantTrackerGetSessionsProcedure = "/valorant.v1.ValorantTracker/GetSessions"
+	// ValorantTrackerGetAgentStatsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetAgentStats RPC.
+	ValorantTrackerGetAgentStatsProcedure = "/valorant.v1.ValorantTracker/GetAgentStats"
+	// ValorantTrackerGetMapStatsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetMapStats RPC.
+	ValorantTrackerGetMapStatsProcedure = "/valorant.v1.ValorantTracker/GetMapStats"
+	// ValorantTrackerGetOpponentStrengthProcedure is the fully-qualified name of the ValorantTracker's
+	// GetOpponentStrength RPC.
+	ValorantTrackerGetOpponentStrengthProcedure = "/valorant.v1.ValorantTracker/GetOpponentStrength"
+	// ValorantTrackerGetHeatmapProcedure is the fully-qualified name of the ValorantTracker's
+	// GetHeatmap RPC.
+	ValorantTrackerGetHeatmapProcedure = "/valorant.v1.ValorantTracker/GetHeatmap"
+	// ValorantTrackerGetOverallStatsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetOverallStats RPC.
+	ValorantTrackerGetOverallStatsProcedure = "/valorant.v1.ValorantTracker/GetOverallStats"
+	// ValorantTrackerListPatchesProcedure is the fully-qualified name of the ValorantTracker's
+	// ListPatches RPC.
+	ValorantTrackerListPatchesProcedure = "/valorant.v1.ValorantTracker/ListPatches"
+	// ValorantTrackerGetClusterStatsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetClusterStats RPC.
+	ValorantTrackerGetClusterStatsProcedure = "/valorant.v1.ValorantTracker/GetClusterStats"
+	// ValorantTrackerGetClusterDistributionProcedure is the fully-qualified name of the
+	// ValorantTracker's GetClusterDistribution RPC.
+	ValorantTrackerGetClusterDistributionProcedure = "/valorant.v1.ValorantTracker/GetClusterDistribution"
+	// ValorantTrackerGetWeaponStatsProcedure is the fully-qualified name of the ValorantTracker's
+	// GetWeaponStats RPC.
+	ValorantTrackerGetWeaponStatsProcedure = "/valorant.v1.ValorantTracker/GetWeaponStats"
+	// ValorantTrackerGetWeaponMetaProcedure is the fully-qualified name of the ValorantTracker's
+	// GetWeaponMeta RPC.
+	ValorantTrackerGetWeaponMetaProcedure = "/valorant.v1.ValorantTracker/GetWeaponMeta"
+	// ValorantTrackerGetPatchMetaProcedure is the fully-qualified name of the ValorantTracker's
+	// GetPatchMeta RPC.
+	ValorantTrackerGetPatchMetaProcedure = "/valorant.v1.ValorantTracker/GetPatchMeta"
+	// ValorantTrackerGetEncountersProcedure is the fully-qualified name of the ValorantTracker's
+	// GetEncounters RPC.
+	ValorantTrackerGetEncountersProcedure = "/valorant.v1.ValorantTracker/GetEncounters"
+	// ValorantTrackerGetEncounterProcedure is the fully-qualified name of the ValorantTracker's
+	// GetEncounter RPC.
+	ValorantTrackerGetEncounterProcedure = "/valorant.v1.ValorantTracker/GetEncounter"
+	// ValorantTrackerGetSynergyProcedure is the fully-qualified name of the ValorantTracker's
+	// GetSynergy RPC.
+	ValorantTrackerGetSynergyProcedure = "/valorant.v1.ValorantTracker/GetSynergy"
+	// ValorantTrackerComparePlayersProcedure is the fully-qualified name of the ValorantTracker's
+	// ComparePlayers RPC.
+	ValorantTrackerComparePlayersProcedure = "/valorant.v1.ValorantTracker/ComparePlayers"
+	// ValorantTrackerGetLeaderboardProcedure is the fully-qualified name of the ValorantTracker's
+	// GetLeaderboard RPC.
+	ValorantTrackerGetLeaderboardProcedure = "/valorant.v1.ValorantTracker/GetLeaderboard"
+	// ValorantTrackerGetOfficialLeaderboardProcedure is the fully-qualified name of the
+	// ValorantTracker's GetOfficialLeaderboard RPC.
+	ValorantTrackerGetOfficialLeaderboardProcedure = "/valorant.v1.ValorantTracker/GetOfficialLeaderboard"
+	// ValorantTrackerGetRankProjectionProcedure is the fully-qualified name of the ValorantTracker's
+	// GetRankProjection RPC.
+	ValorantTrackerGetRankProjectionProcedure = "/valorant.v1.ValorantTracker/GetRankProjection"
+	// ValorantTrackerGetIntegrityReportProcedure is the fully-qualified name of the ValorantTracker's
+	// GetIntegrityReport RPC.
+	ValorantTrackerGetIntegrityReportProcedure = "/valorant.v1.ValorantTracker/GetIntegrityReport"
+	// ValorantTrackerCreateWebhookProcedure is the fully-qualified name of the ValorantTracker's
+	// CreateWebhook RPC.
+	ValorantTrackerCreateWebhookProcedure = "/valorant.v1.ValorantTracker/CreateWebhook"
+	// ValorantTrackerDeleteWebhookProcedure is the fully-qualified name of the ValorantTracker's
+	// DeleteWebhook RPC.
+	ValorantTrackerDeleteWebhookProcedure = "/valorant.v1.ValorantTracker/DeleteWebhook"
+	// ValorantTrackerListWebhooksProcedure is the fully-qualified name of the ValorantTracker's
+	// ListWebhooks RPC.
+	ValorantTrackerListWebhooksProcedure = "/valorant.v1.ValorantTracker/ListWebhooks"
+	// ValorantTrackerListWebhookDeliveriesProcedure is the fully-qualified name of the
+	// ValorantTracker's ListWebhookDeliveries RPC.
+	ValorantTrackerListWebhookDeliveriesProcedure = "/valorant.v1.ValorantTracker/ListWebhookDeliveries"
+	// ValorantTrackerAddGroupMemberProcedure is the fully-qualified name of the ValorantTracker's
+	// AddGroupMember RPC.
+	ValorantTrackerAddGroupMemberProcedure = "/valorant.v1.ValorantTracker/AddGroupMember"
+	// ValorantTrackerRemoveGroupMemberProcedure is the fully-qualified name of the ValorantTracker's
+	// RemoveGroupMember RPC.
+	ValorantTrackerRemoveGroupMemberProcedure = "/valorant.v1.ValorantTracker/RemoveGroupMember"
+	// ValorantTrackerListGroupMembersProcedure is the fully-qualified name of the ValorantTracker's
+	// ListGroupMembers RPC.
+	ValorantTrackerListGroupMembersProcedure = "/valorant.v1.ValorantTracker/ListGroupMembers"
+	// ValorantTrackerGetSuspicionProcedure is the fully-qualified name of the ValorantTracker's
+	// GetSuspicion RPC.
+	ValorantTrackerGetSuspicionProcedure = "/valorant.v1.ValorantTracker/GetSuspicion"
 )
 
 // ValorantTrackerClient is a client for the valorant.v1.ValorantTracker service.
@@ -58,6 +153,39 @@ type ValorantTrackerClient interface {
 	SearchSuggestions(context.Context, *connect.Request[v1.SearchSuggestionsRequest]) (*connect.Response[v1.SearchSuggestionsResponse], error)
 	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
 	GetPlayerByPuuid(context.Context, *connect.Request[v1.GetPlayerByPuuidRequest]) (*connect.Response[v1.PlayerResponse], error)
+	FollowPlayer(context.Context, *connect.Request[v1.FollowPlayerRequest]) (*connect.Response[v1.FollowPlayerResponse], error)
+	UnfollowPlayer(context.Context, *connect.Request[v1.UnfollowPlayerRequest]) (*connect.Response[v1.UnfollowPlayerResponse], error)
+	GetFeed(context.Context, *connect.Request[v1.GetFeedRequest]) (*connect.Response[v1.GetFeedResponse], error)
+	WatchPlayer(context.Context, *connect.Request[v1.WatchPlayerRequest]) (*connect.ServerStreamForClient[v1.WatchPlayerResponse], error)
+	GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error)
+	GetAgentStats(context.Context, *connect.Request[v1.GetAgentStatsRequest]) (*connect.Response[v1.GetAgentStatsResponse], error)
+	GetMapStats(context.Context, *connect.Request[v1.GetMapStatsRequest]) (*connect.Response[v1.GetMapStatsResponse], error)
+	GetOpponentStrength(context.Context, *connect.Request[v1.GetOpponentStrengthRequest]) (*connect.Response[v1.GetOpponentStrengthResponse], error)
+	GetHeatmap(context.Context, *connect.Request[v1.GetHeatmapRequest]) (*connect.Response[v1.GetHeatmapResponse], error)
+	GetOverallStats(context.Context, *connect.Request[v1.GetOverallStatsRequest]) (*connect.Response[v1.GetOverallStatsResponse], error)
+	ListPatches(context.Context, *connect.Request[v1.ListPatchesRequest]) (*connect.Response[v1.ListPatchesResponse], error)
+	GetClusterStats(context.Context, *connect.Request[v1.GetClusterStatsRequest]) (*connect.Response[v1.GetClusterStatsResponse], error)
+	GetClusterDistribution(context.Context, *connect.Request[v1.GetClusterDistributionRequest]) (*connect.Response[v1.GetClusterDistributionResponse], error)
+	GetWeaponStats(context.Context, *connect.Request[v1.GetWeaponStatsRequest]) (*connect.Response[v1.GetWeaponStatsResponse], error)
+	GetWeaponMeta(context.Context, *connect.Request[v1.GetWeaponMetaRequest]) (*connect.Response[v1.GetWeaponMetaResponse], error)
+	GetPatchMeta(context.Context, *connect.Request[v1.GetPatchMetaRequest]) (*connect.Response[v1.GetPatchMetaResponse], error)
+	GetEncounters(context.Context, *connect.Request[v1.GetEncountersRequest]) (*connect.Response[v1.GetEncountersResponse], error)
+	GetEncounter(context.Context, *connect.Request[v1.GetEncounterRequest]) (*connect.Response[v1.GetEncounterResponse], error)
+	GetSynergy(context.Context, *connect.Request[v1.GetSynergyRequest]) (*connect.Response[v1.GetSynergyResponse], error)
+	ComparePlayers(context.Context, *connect.Request[v1.ComparePlayersRequest]) (*connect.Response[v1.ComparePlayersResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error)
+	GetOfficialLeaderboard(context.Context, *connect.Request[v1.GetOfficialLeaderboardRequest]) (*connect.Response[v1.GetOfficialLeaderboardResponse], error)
+	GetRankProjection(context.Context, *connect.Request[v1.GetRankProjectionRequest]) (*connect.Response[v1.GetRankProjectionResponse], error)
+	// admin
+	GetIntegrityReport(context.Context, *connect.Request[v1.GetIntegrityReportRequest]) (*connect.Response[v1.GetIntegrityReportResponse], error)
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error)
+	RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error)
+	ListGroupMembers(context.Context, *connect.Request[v1.ListGroupMembersRequest]) (*connect.Response[v1.ListGroupMembersResponse], error)
+	GetSuspicion(context.Context, *connect.Request[v1.GetSuspicionRequest]) (*connect.Response[v1.GetSuspicionResponse], error)
 }
 
 // NewValorantTrackerClient constructs a client for the valorant.v1.ValorantTracker service. By
@@ -101,16 +229,240 @@ func NewValorantTrackerClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(valorantTrackerMethods.ByName("GetPlayerByPuuid")),
 			connect.WithClientOptions(opts...),
 		),
+		followPlayer: connect.NewClient[v1.FollowPlayerRequest, v1.FollowPlayerResponse](
+			httpClient,
+			baseURL+ValorantTrackerFollowPlayerProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("FollowPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		unfollowPlayer: connect.NewClient[v1.UnfollowPlayerRequest, v1.UnfollowPlayerResponse](
+			httpClient,
+			baseURL+ValorantTrackerUnfollowPlayerProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("UnfollowPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		getFeed: connect.NewClient[v1.GetFeedRequest, v1.GetFeedResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetFeedProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		watchPlayer: connect.NewClient[v1.WatchPlayerRequest, v1.WatchPlayerResponse](
+			httpClient,
+			baseURL+ValorantTrackerWatchPlayerProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("WatchPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		getSessions: connect.NewClient[v1.GetSessionsRequest, v1.GetSessionsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetSessionsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		getAgentStats: connect.NewClient[v1.GetAgentStatsRequest, v1.GetAgentStatsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetAgentStatsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetAgentStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getMapStats: connect.NewClient[v1.GetMapStatsRequest, v1.GetMapStatsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetMapStatsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetMapStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getOpponentStrength: connect.NewClient[v1.GetOpponentStrengthRequest, v1.GetOpponentStrengthResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetOpponentStrengthProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetOpponentStrength")),
+			connect.WithClientOptions(opts...),
+		),
+		getHeatmap: connect.NewClient[v1.GetHeatmapRequest, v1.GetHeatmapResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetHeatmapProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetHeatmap")),
+			connect.WithClientOptions(opts...),
+		),
+		getOverallStats: connect.NewClient[v1.GetOverallStatsRequest, v1.GetOverallStatsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetOverallStatsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetOverallStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listPatches: connect.NewClient[v1.ListPatchesRequest, v1.ListPatchesResponse](
+			httpClient,
+			baseURL+ValorantTrackerListPatchesProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("ListPatches")),
+			connect.WithClientOptions(opts...),
+		),
+		getClusterStats: connect.NewClient[v1.GetClusterStatsRequest, v1.GetClusterStatsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetClusterStatsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetClusterStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getClusterDistribution: connect.NewClient[v1.GetClusterDistributionRequest, v1.GetClusterDistributionResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetClusterDistributionProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetClusterDistribution")),
+			connect.WithClientOptions(opts...),
+		),
+		getWeaponStats: connect.NewClient[v1.GetWeaponStatsRequest, v1.GetWeaponStatsResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetWeaponStatsProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetWeaponStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getWeaponMeta: connect.NewClient[v1.GetWeaponMetaRequest, v1.GetWeaponMetaResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetWeaponMetaProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetWeaponMeta")),
+			connect.WithClientOptions(opts...),
+		),
+		getPatchMeta: connect.NewClient[v1.GetPatchMetaRequest, v1.GetPatchMetaResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetPatchMetaProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetPatchMeta")),
+			connect.WithClientOptions(opts...),
+		),
+		getEncounters: connect.NewClient[v1.GetEncountersRequest, v1.GetEncountersResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetEncountersProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetEncounters")),
+			connect.WithClientOptions(opts...),
+		),
+		getEncounter: connect.NewClient[v1.GetEncounterRequest, v1.GetEncounterResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetEncounterProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetEncounter")),
+			connect.WithClientOptions(opts...),
+		),
+		getSynergy: connect.NewClient[v1.GetSynergyRequest, v1.GetSynergyResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetSynergyProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetSynergy")),
+			connect.WithClientOptions(opts...),
+		),
+		comparePlayers: connect.NewClient[v1.ComparePlayersRequest, v1.ComparePlayersResponse](
+			httpClient,
+			baseURL+ValorantTrackerComparePlayersProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("ComparePlayers")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[v1.GetLeaderboardRequest, v1.GetLeaderboardResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetLeaderboardProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getOfficialLeaderboard: connect.NewClient[v1.GetOfficialLeaderboardRequest, v1.GetOfficialLeaderboardResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetOfficialLeaderboardProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetOfficialLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getRankProjection: connect.NewClient[v1.GetRankProjectionRequest, v1.GetRankProjectionResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetRankProjectionProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetRankProjection")),
+			connect.WithClientOptions(opts...),
+		),
+		getIntegrityReport: connect.NewClient[v1.GetIntegrityReportRequest, v1.GetIntegrityReportResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetIntegrityReportProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetIntegrityReport")),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[v1.CreateWebhookRequest, v1.CreateWebhookResponse](
+			httpClient,
+			baseURL+ValorantTrackerCreateWebhookProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("CreateWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+ValorantTrackerDeleteWebhookProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[v1.ListWebhooksRequest, v1.ListWebhooksResponse](
+			httpClient,
+			baseURL+ValorantTrackerListWebhooksProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("ListWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+ValorantTrackerListWebhookDeliveriesProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		addGroupMember: connect.NewClient[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse](
+			httpClient,
+			baseURL+ValorantTrackerAddGroupMemberProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("AddGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeGroupMember: connect.NewClient[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse](
+			httpClient,
+			baseURL+ValorantTrackerRemoveGroupMemberProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("RemoveGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listGroupMembers: connect.NewClient[v1.ListGroupMembersRequest, v1.ListGroupMembersResponse](
+			httpClient,
+			baseURL+ValorantTrackerListGroupMembersProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("ListGroupMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		getSuspicion: connect.NewClient[v1.GetSuspicionRequest, v1.GetSuspicionResponse](
+			httpClient,
+			baseURL+ValorantTrackerGetSuspicionProcedure,
+			connect.WithSchema(valorantTrackerMethods.ByName("GetSuspicion")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // valorantTrackerClient implements ValorantTrackerClient.
 type valorantTrackerClient struct {
-	getPlayer         *connect.Client[v1.PlayerRequest, v1.PlayerResponse]
-	getMatches        *connect.Client[v1.MatchesRequest, v1.MatchesResponse]
-	searchSuggestions *connect.Client[v1.SearchSuggestionsRequest, v1.SearchSuggestionsResponse]
-	getMatch          *connect.Client[v1.GetMatchRequest, v1.GetMatchResponse]
-	getPlayerByPuuid  *connect.Client[v1.GetPlayerByPuuidRequest, v1.PlayerResponse]
+	getPlayer              *connect.Client[v1.PlayerRequest, v1.PlayerResponse]
+	getMatches             *connect.Client[v1.MatchesRequest, v1.MatchesResponse]
+	searchSuggestions      *connect.Client[v1.SearchSuggestionsRequest, v1.SearchSuggestionsResponse]
+	getMatch               *connect.Client[v1.GetMatchRequest, v1.GetMatchResponse]
+	getPlayerByPuuid       *connect.Client[v1.GetPlayerByPuuidRequest, v1.PlayerResponse]
+	followPlayer           *connect.Client[v1.FollowPlayerRequest, v1.FollowPlayerResponse]
+	unfollowPlayer         *connect.Client[v1.UnfollowPlayerRequest, v1.UnfollowPlayerResponse]
+	getFeed                *connect.Client[v1.GetFeedRequest, v1.GetFeedResponse]
+	watchPlayer            *connect.Client[v1.WatchPlayerRequest, v1.WatchPlayerResponse]
+	getSessions            *connect.Client[v1.GetSessionsRequest, v1.GetSessionsResponse]
+	getAgentStats          *connect.Client[v1.GetAgentStatsRequest, v1.GetAgentStatsResponse]
+	getMapStats            *connect.Client[v1.GetMapStatsRequest, v1.GetMapStatsResponse]
+	getOpponentStrength    *connect.Client[v1.GetOpponentStrengthRequest, v1.GetOpponentStrengthResponse]
+	getHeatmap             *connect.Client[v1.GetHeatmapRequest, v1.GetHeatmapResponse]
+	getOverallStats        *connect.Client[v1.GetOverallStatsRequest, v1.GetOverallStatsResponse]
+	listPatches            *connect.Client[v1.ListPatchesRequest, v1.ListPatchesResponse]
+	getClusterStats        *connect.Client[v1.GetClusterStatsRequest, v1.GetClusterStatsResponse]
+	getClusterDistribution *connect.Client[v1.GetClusterDistributionRequest, v1.GetClusterDistributionResponse]
+	getWeaponStats         *connect.Client[v1.GetWeaponStatsRequest, v1.GetWeaponStatsResponse]
+	getWeaponMeta          *connect.Client[v1.GetWeaponMetaRequest, v1.GetWeaponMetaResponse]
+	getPatchMeta           *connect.Client[v1.GetPatchMetaRequest, v1.GetPatchMetaResponse]
+	getEncounters          *connect.Client[v1.GetEncountersRequest, v1.GetEncountersResponse]
+	getEncounter           *connect.Client[v1.GetEncounterRequest, v1.GetEncounterResponse]
+	getSynergy             *connect.Client[v1.GetSynergyRequest, v1.GetSynergyResponse]
+	comparePlayers         *connect.Client[v1.ComparePlayersRequest, v1.ComparePlayersResponse]
+	getLeaderboard         *connect.Client[v1.GetLeaderboardRequest, v1.GetLeaderboardResponse]
+	getOfficialLeaderboard *connect.Client[v1.GetOfficialLeaderboardRequest, v1.GetOfficialLeaderboardResponse]
+	getRankProjection      *connect.Client[v1.GetRankProjectionRequest, v1.GetRankProjectionResponse]
+	getIntegrityReport     *connect.Client[v1.GetIntegrityReportRequest, v1.GetIntegrityReportResponse]
+	createWebhook          *connect.Client[v1.CreateWebhookRequest, v1.CreateWebhookResponse]
+	deleteWebhook          *connect.Client[v1.DeleteWebhookRequest, v1.DeleteWebhookResponse]
+	listWebhooks           *connect.Client[v1.ListWebhooksRequest, v1.ListWebhooksResponse]
+	listWebhookDeliveries  *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	addGroupMember         *connect.Client[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse]
+	removeGroupMember      *connect.Client[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse]
+	listGroupMembers       *connect.Client[v1.ListGroupMembersRequest, v1.ListGroupMembersResponse]
+	getSuspicion           *connect.Client[v1.GetSuspicionRequest, v1.GetSuspicionResponse]
 }
 
 // GetPlayer calls valorant.v1.ValorantTracker.GetPlayer.
@@ -138,6 +490,166 @@ func (c *valorantTrackerClient) GetPlayerByPuuid(ctx context.Context, req *conne
 	return c.getPlayerByPuuid.CallUnary(ctx, req)
 }
 
+// FollowPlayer calls valorant.v1.ValorantTracker.FollowPlayer.
+func (c *valorantTrackerClient) FollowPlayer(ctx context.Context, req *connect.Request[v1.FollowPlayerRequest]) (*connect.Response[v1.FollowPlayerResponse], error) {
+	return c.followPlayer.CallUnary(ctx, req)
+}
+
+// UnfollowPlayer calls valorant.v1.ValorantTracker.UnfollowPlayer.
+func (c *valorantTrackerClient) UnfollowPlayer(ctx context.Context, req *connect.Request[v1.UnfollowPlayerRequest]) (*connect.Response[v1.UnfollowPlayerResponse], error) {
+	return c.unfollowPlayer.CallUnary(ctx, req)
+}
+
+// GetFeed calls valorant.v1.ValorantTracker.GetFeed.
+func (c *valorantTrackerClient) GetFeed(ctx context.Context, req *connect.Request[v1.GetFeedRequest]) (*connect.Response[v1.GetFeedResponse], error) {
+	return c.getFeed.CallUnary(ctx, req)
+}
+
+// WatchPlayer calls valorant.v1.ValorantTracker.WatchPlayer.
+func (c *valorantTrackerClient) WatchPlayer(ctx context.Context, req *connect.Request[v1.WatchPlayerRequest]) (*connect.ServerStreamForClient[v1.WatchPlayerResponse], error) {
+	return c.watchPlayer.CallServerStream(ctx, req)
+}
+
+// GetSessions calls valorant.v1.ValorantTracker.GetSessions.
+func (c *valorantTrackerClient) GetSessions(ctx context.Context, req *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error) {
+	return c.getSessions.CallUnary(ctx, req)
+}
+
+// GetAgentStats calls valorant.v1.ValorantTracker.GetAgentStats.
+func (c *valorantTrackerClient) GetAgentStats(ctx context.Context, req *connect.Request[v1.GetAgentStatsRequest]) (*connect.Response[v1.GetAgentStatsResponse], error) {
+	return c.getAgentStats.CallUnary(ctx, req)
+}
+
+// GetMapStats calls valorant.v1.ValorantTracker.GetMapStats.
+func (c *valorantTrackerClient) GetMapStats(ctx context.Context, req *connect.Request[v1.GetMapStatsRequest]) (*connect.Response[v1.GetMapStatsResponse], error) {
+	return c.getMapStats.CallUnary(ctx, req)
+}
+
+// GetOpponentStrength calls valorant.v1.ValorantTracker.GetOpponentStrength.
+func (c *valorantTrackerClient) GetOpponentStrength(ctx context.Context, req *connect.Request[v1.GetOpponentStrengthRequest]) (*connect.Response[v1.GetOpponentStrengthResponse], error) {
+	return c.getOpponentStrength.CallUnary(ctx, req)
+}
+
+// GetHeatmap calls valorant.v1.ValorantTracker.GetHeatmap.
+func (c *valorantTrackerClient) GetHeatmap(ctx context.Context, req *connect.Request[v1.GetHeatmapRequest]) (*connect.Response[v1.GetHeatmapResponse], error) {
+	return c.getHeatmap.CallUnary(ctx, req)
+}
+
+// GetOverallStats calls valorant.v1.ValorantTracker.GetOverallStats.
+func (c *valorantTrackerClient) GetOverallStats(ctx context.Context, req *connect.Request[v1.GetOverallStatsRequest]) (*connect.Response[v1.GetOverallStatsResponse], error) {
+	return c.getOverallStats.CallUnary(ctx, req)
+}
+
+// ListPatches calls valorant.v1.ValorantTracker.ListPatches.
+func (c *valorantTrackerClient) ListPatches(ctx context.Context, req *connect.Request[v1.ListPatchesRequest]) (*connect.Response[v1.ListPatchesResponse], error) {
+	return c.listPatches.CallUnary(ctx, req)
+}
+
+// GetClusterStats calls valorant.v1.ValorantTracker.GetClusterStats.
+func (c *valorantTrackerClient) GetClusterStats(ctx context.Context, req *connect.Request[v1.GetClusterStatsRequest]) (*connect.Response[v1.GetClusterStatsResponse], error) {
+	return c.getClusterStats.CallUnary(ctx, req)
+}
+
+// GetClusterDistribution calls valorant.v1.ValorantTracker.GetClusterDistribution.
+func (c *valorantTrackerClient) GetClusterDistribution(ctx context.Context, req *connect.Request[v1.GetClusterDistributionRequest]) (*connect.Response[v1.GetClusterDistributionResponse], error) {
+	return c.getClusterDistribution.CallUnary(ctx, req)
+}
+
+// GetWeaponStats calls valorant.v1.ValorantTracker.GetWeaponStats.
+func (c *valorantTrackerClient) GetWeaponStats(ctx context.Context, req *connect.Request[v1.GetWeaponStatsRequest]) (*connect.Response[v1.GetWeaponStatsResponse], error) {
+	return c.getWeaponStats.CallUnary(ctx, req)
+}
+
+// GetWeaponMeta calls valorant.v1.ValorantTracker.GetWeaponMeta.
+func (c *valorantTrackerClient) GetWeaponMeta(ctx context.Context, req *connect.Request[v1.GetWeaponMetaRequest]) (*connect.Response[v1.GetWeaponMetaResponse], error) {
+	return c.getWeaponMeta.CallUnary(ctx, req)
+}
+
+// GetPatchMeta calls valorant.v1.ValorantTracker.GetPatchMeta.
+func (c *valorantTrackerClient) GetPatchMeta(ctx context.Context, req *connect.Request[v1.GetPatchMetaRequest]) (*connect.Response[v1.GetPatchMetaResponse], error) {
+	return c.getPatchMeta.CallUnary(ctx, req)
+}
+
+// GetEncounters calls valorant.v1.ValorantTracker.GetEncounters.
+func (c *valorantTrackerClient) GetEncounters(ctx context.Context, req *connect.Request[v1.GetEncountersRequest]) (*connect.Response[v1.GetEncountersResponse], error) {
+	return c.getEncounters.CallUnary(ctx, req)
+}
+
+// GetEncounter calls valorant.v1.ValorantTracker.GetEncounter.
+func (c *valorantTrackerClient) GetEncounter(ctx context.Context, req *connect.Request[v1.GetEncounterRequest]) (*connect.Response[v1.GetEncounterResponse], error) {
+	return c.getEncounter.CallUnary(ctx, req)
+}
+
+// GetSynergy calls valorant.v1.ValorantTracker.GetSynergy.
+func (c *valorantTrackerClient) GetSynergy(ctx context.Context, req *connect.Request[v1.GetSynergyRequest]) (*connect.Response[v1.GetSynergyResponse], error) {
+	return c.getSynergy.CallUnary(ctx, req)
+}
+
+// ComparePlayers calls valorant.v1.ValorantTracker.ComparePlayers.
+func (c *valorantTrackerClient) ComparePlayers(ctx context.Context, req *connect.Request[v1.ComparePlayersRequest]) (*connect.Response[v1.ComparePlayersResponse], error) {
+	return c.comparePlayers.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls valorant.v1.ValorantTracker.GetLeaderboard.
+func (c *valorantTrackerClient) GetLeaderboard(ctx context.Context, req *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
+// GetOfficialLeaderboard calls valorant.v1.ValorantTracker.GetOfficialLeaderboard.
+func (c *valorantTrackerClient) GetOfficialLeaderboard(ctx context.Context, req *connect.Request[v1.GetOfficialLeaderboardRequest]) (*connect.Response[v1.GetOfficialLeaderboardResponse], error) {
+	return c.getOfficialLeaderboard.CallUnary(ctx, req)
+}
+
+// GetRankProjection calls valorant.v1.ValorantTracker.GetRankProjection.
+func (c *valorantTrackerClient) GetRankProjection(ctx context.Context, req *connect.Request[v1.GetRankProjectionRequest]) (*connect.Response[v1.GetRankProjectionResponse], error) {
+	return c.getRankProjection.CallUnary(ctx, req)
+}
+
+// GetIntegrityReport calls valorant.v1.ValorantTracker.GetIntegrityReport.
+func (c *valorantTrackerClient) GetIntegrityReport(ctx context.Context, req *connect.Request[v1.GetIntegrityReportRequest]) (*connect.Response[v1.GetIntegrityReportResponse], error) {
+	return c.getIntegrityReport.CallUnary(ctx, req)
+}
+
+// CreateWebhook calls valorant.v1.ValorantTracker.CreateWebhook.
+func (c *valorantTrackerClient) CreateWebhook(ctx context.Context, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls valorant.v1.ValorantTracker.DeleteWebhook.
+func (c *valorantTrackerClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls valorant.v1.ValorantTracker.ListWebhooks.
+func (c *valorantTrackerClient) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls valorant.v1.ValorantTracker.ListWebhookDeliveries.
+func (c *valorantTrackerClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// AddGroupMember calls valorant.v1.ValorantTracker.AddGroupMember.
+func (c *valorantTrackerClient) AddGroupMember(ctx context.Context, req *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error) {
+	return c.addGroupMember.CallUnary(ctx, req)
+}
+
+// RemoveGroupMember calls valorant.v1.ValorantTracker.RemoveGroupMember.
+func (c *valorantTrackerClient) RemoveGroupMember(ctx context.Context, req *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error) {
+	return c.removeGroupMember.CallUnary(ctx, req)
+}
+
+// ListGroupMembers calls valorant.v1.ValorantTracker.ListGroupMembers.
+func (c *valorantTrackerClient) ListGroupMembers(ctx context.Context, req *connect.Request[v1.ListGroupMembersRequest]) (*connect.Response[v1.ListGroupMembersResponse], error) {
+	return c.listGroupMembers.CallUnary(ctx, req)
+}
+
+// GetSuspicion calls valorant.v1.ValorantTracker.GetSuspicion.
+func (c *valorantTrackerClient) GetSuspicion(ctx context.Context, req *connect.Request[v1.GetSuspicionRequest]) (*connect.Response[v1.GetSuspicionResponse], error) {
+	return c.getSuspicion.CallUnary(ctx, req)
+}
+
 // ValorantTrackerHandler is an implementation of the valorant.v1.ValorantTracker service.
 type ValorantTrackerHandler interface {
 	GetPlayer(context.Context, *connect.Request[v1.PlayerRequest]) (*connect.Response[v1.PlayerResponse], error)
@@ -145,6 +657,39 @@ type ValorantTrackerHandler interface {
 	SearchSuggestions(context.Context, *connect.Request[v1.SearchSuggestionsRequest]) (*connect.Response[v1.SearchSuggestionsResponse], error)
 	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
 	GetPlayerByPuuid(context.Context, *connect.Request[v1.GetPlayerByPuuidRequest]) (*connect.Response[v1.PlayerResponse], error)
+	FollowPlayer(context.Context, *connect.Request[v1.FollowPlayerRequest]) (*connect.Response[v1.FollowPlayerResponse], error)
+	UnfollowPlayer(context.Context, *connect.Request[v1.UnfollowPlayerRequest]) (*connect.Response[v1.UnfollowPlayerResponse], error)
+	GetFeed(context.Context, *connect.Request[v1.GetFeedRequest]) (*connect.Response[v1.GetFeedResponse], error)
+	WatchPlayer(context.Context, *connect.Request[v1.WatchPlayerRequest], *connect.ServerStream[v1.WatchPlayerResponse]) error
+	GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error)
+	GetAgentStats(context.Context, *connect.Request[v1.GetAgentStatsRequest]) (*connect.Response[v1.GetAgentStatsResponse], error)
+	GetMapStats(context.Context, *connect.Request[v1.GetMapStatsRequest]) (*connect.Response[v1.GetMapStatsResponse], error)
+	GetOpponentStrength(context.Context, *connect.Request[v1.GetOpponentStrengthRequest]) (*connect.Response[v1.GetOpponentStrengthResponse], error)
+	GetHeatmap(context.Context, *connect.Request[v1.GetHeatmapRequest]) (*connect.Response[v1.GetHeatmapResponse], error)
+	GetOverallStats(context.Context, *connect.Request[v1.GetOverallStatsRequest]) (*connect.Response[v1.GetOverallStatsResponse], error)
+	ListPatches(context.Context, *connect.Request[v1.ListPatchesRequest]) (*connect.Response[v1.ListPatchesResponse], error)
+	GetClusterStats(context.Context, *connect.Request[v1.GetClusterStatsRequest]) (*connect.Response[v1.GetClusterStatsResponse], error)
+	GetClusterDistribution(context.Context, *connect.Request[v1.GetClusterDistributionRequest]) (*connect.Response[v1.GetClusterDistributionResponse], error)
+	GetWeaponStats(context.Context, *connect.Request[v1.GetWeaponStatsRequest]) (*connect.Response[v1.GetWeaponStatsResponse], error)
+	GetWeaponMeta(context.Context, *connect.Request[v1.GetWeaponMetaRequest]) (*connect.Response[v1.GetWeaponMetaResponse], error)
+	GetPatchMeta(context.Context, *connect.Request[v1.GetPatchMetaRequest]) (*connect.Response[v1.GetPatchMetaResponse], error)
+	GetEncounters(context.Context, *connect.Request[v1.GetEncountersRequest]) (*connect.Response[v1.GetEncountersResponse], error)
+	GetEncounter(context.Context, *connect.Request[v1.GetEncounterRequest]) (*connect.Response[v1.GetEncounterResponse], error)
+	GetSynergy(context.Context, *connect.Request[v1.GetSynergyRequest]) (*connect.Response[v1.GetSynergyResponse], error)
+	ComparePlayers(context.Context, *connect.Request[v1.ComparePlayersRequest]) (*connect.Response[v1.ComparePlayersResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error)
+	GetOfficialLeaderboard(context.Context, *connect.Request[v1.GetOfficialLeaderboardRequest]) (*connect.Response[v1.GetOfficialLeaderboardResponse], error)
+	GetRankProjection(context.Context, *connect.Request[v1.GetRankProjectionRequest]) (*connect.Response[v1.GetRankProjectionResponse], error)
+	// admin
+	GetIntegrityReport(context.Context, *connect.Request[v1.GetIntegrityReportRequest]) (*connect.Response[v1.GetIntegrityReportResponse], error)
+	CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error)
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error)
+	RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error)
+	ListGroupMembers(context.Context, *connect.Request[v1.ListGroupMembersRequest]) (*connect.Response[v1.ListGroupMembersResponse], error)
+	GetSuspicion(context.Context, *connect.Request[v1.GetSuspicionRequest]) (*connect.Response[v1.GetSuspicionResponse], error)
 }
 
 // NewValorantTrackerHandler builds an HTTP handler from the service implementation. It returns the
@@ -184,6 +729,198 @@ func NewValorantTrackerHandler(svc ValorantTrackerHandler, opts ...connect.Handl
 		connect.WithSchema(valorantTrackerMethods.ByName("GetPlayerByPuuid")),
 		connect.WithHandlerOptions(opts...),
 	)
+	valorantTrackerFollowPlayerHandler := connect.NewUnaryHandler(
+		ValorantTrackerFollowPlayerProcedure,
+		svc.FollowPlayer,
+		connect.WithSchema(valorantTrackerMethods.ByName("FollowPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerUnfollowPlayerHandler := connect.NewUnaryHandler(
+		ValorantTrackerUnfollowPlayerProcedure,
+		svc.UnfollowPlayer,
+		connect.WithSchema(valorantTrackerMethods.ByName("UnfollowPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetFeedHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetFeedProcedure,
+		svc.GetFeed,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerWatchPlayerHandler := connect.NewServerStreamHandler(
+		ValorantTrackerWatchPlayerProcedure,
+		svc.WatchPlayer,
+		connect.WithSchema(valorantTrackerMethods.ByName("WatchPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetSessionsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetSessionsProcedure,
+		svc.GetSessions,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetAgentStatsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetAgentStatsProcedure,
+		svc.GetAgentStats,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetAgentStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetMapStatsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetMapStatsProcedure,
+		svc.GetMapStats,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetMapStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetOpponentStrengthHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetOpponentStrengthProcedure,
+		svc.GetOpponentStrength,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetOpponentStrength")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetHeatmapHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetHeatmapProcedure,
+		svc.GetHeatmap,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetHeatmap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetOverallStatsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetOverallStatsProcedure,
+		svc.GetOverallStats,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetOverallStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerListPatchesHandler := connect.NewUnaryHandler(
+		ValorantTrackerListPatchesProcedure,
+		svc.ListPatches,
+		connect.WithSchema(valorantTrackerMethods.ByName("ListPatches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetClusterStatsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetClusterStatsProcedure,
+		svc.GetClusterStats,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetClusterStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetClusterDistributionHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetClusterDistributionProcedure,
+		svc.GetClusterDistribution,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetClusterDistribution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetWeaponStatsHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetWeaponStatsProcedure,
+		svc.GetWeaponStats,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetWeaponStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetWeaponMetaHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetWeaponMetaProcedure,
+		svc.GetWeaponMeta,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetWeaponMeta")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetPatchMetaHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetPatchMetaProcedure,
+		svc.GetPatchMeta,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetPatchMeta")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetEncountersHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetEncountersProcedure,
+		svc.GetEncounters,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetEncounters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetEncounterHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetEncounterProcedure,
+		svc.GetEncounter,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetEncounter")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetSynergyHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetSynergyProcedure,
+		svc.GetSynergy,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetSynergy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerComparePlayersHandler := connect.NewUnaryHandler(
+		ValorantTrackerComparePlayersProcedure,
+		svc.ComparePlayers,
+		connect.WithSchema(valorantTrackerMethods.ByName("ComparePlayers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetLeaderboardHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetOfficialLeaderboardHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetOfficialLeaderboardProcedure,
+		svc.GetOfficialLeaderboard,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetOfficialLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetRankProjectionHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetRankProjectionProcedure,
+		svc.GetRankProjection,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetRankProjection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetIntegrityReportHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetIntegrityReportProcedure,
+		svc.GetIntegrityReport,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetIntegrityReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerCreateWebhookHandler := connect.NewUnaryHandler(
+		ValorantTrackerCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(valorantTrackerMethods.ByName("CreateWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerDeleteWebhookHandler := connect.NewUnaryHandler(
+		ValorantTrackerDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(valorantTrackerMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerListWebhooksHandler := connect.NewUnaryHandler(
+		ValorantTrackerListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(valorantTrackerMethods.ByName("ListWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		ValorantTrackerListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(valorantTrackerMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerAddGroupMemberHandler := connect.NewUnaryHandler(
+		ValorantTrackerAddGroupMemberProcedure,
+		svc.AddGroupMember,
+		connect.WithSchema(valorantTrackerMethods.ByName("AddGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerRemoveGroupMemberHandler := connect.NewUnaryHandler(
+		ValorantTrackerRemoveGroupMemberProcedure,
+		svc.RemoveGroupMember,
+		connect.WithSchema(valorantTrackerMethods.ByName("RemoveGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerListGroupMembersHandler := connect.NewUnaryHandler(
+		ValorantTrackerListGroupMembersProcedure,
+		svc.ListGroupMembers,
+		connect.WithSchema(valorantTrackerMethods.ByName("ListGroupMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	valorantTrackerGetSuspicionHandler := connect.NewUnaryHandler(
+		ValorantTrackerGetSuspicionProcedure,
+		svc.GetSuspicion,
+		connect.WithSchema(valorantTrackerMethods.ByName("GetSuspicion")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/valorant.v1.ValorantTracker/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ValorantTrackerGetPlayerProcedure:
@@ -196,6 +933,70 @@ func NewValorantTrackerHandler(svc ValorantTrackerHandler, opts ...connect.Handl
 			valorantTrackerGetMatchHandler.ServeHTTP(w, r)
 		case ValorantTrackerGetPlayerByPuuidProcedure:
 			valorantTrackerGetPlayerByPuuidHandler.ServeHTTP(w, r)
+		case ValorantTrackerFollowPlayerProcedure:
+			valorantTrackerFollowPlayerHandler.ServeHTTP(w, r)
+		case ValorantTrackerUnfollowPlayerProcedure:
+			valorantTrackerUnfollowPlayerHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetFeedProcedure:
+			valorantTrackerGetFeedHandler.ServeHTTP(w, r)
+		case ValorantTrackerWatchPlayerProcedure:
+			valorantTrackerWatchPlayerHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetSessionsProcedure:
+			valorantTrackerGetSessionsHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetAgentStatsProcedure:
+			valorantTrackerGetAgentStatsHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetMapStatsProcedure:
+			valorantTrackerGetMapStatsHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetOpponentStrengthProcedure:
+			valorantTrackerGetOpponentStrengthHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetHeatmapProcedure:
+			valorantTrackerGetHeatmapHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetOverallStatsProcedure:
+			valorantTrackerGetOverallStatsHandler.ServeHTTP(w, r)
+		case ValorantTrackerListPatchesProcedure:
+			valorantTrackerListPatchesHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetClusterStatsProcedure:
+			valorantTrackerGetClusterStatsHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetClusterDistributionProcedure:
+			valorantTrackerGetClusterDistributionHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetWeaponStatsProcedure:
+			valorantTrackerGetWeaponStatsHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetWeaponMetaProcedure:
+			valorantTrackerGetWeaponMetaHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetPatchMetaProcedure:
+			valorantTrackerGetPatchMetaHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetEncountersProcedure:
+			valorantTrackerGetEncountersHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetEncounterProcedure:
+			valorantTrackerGetEncounterHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetSynergyProcedure:
+			valorantTrackerGetSynergyHandler.ServeHTTP(w, r)
+		case ValorantTrackerComparePlayersProcedure:
+			valorantTrackerComparePlayersHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetLeaderboardProcedure:
+			valorantTrackerGetLeaderboardHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetOfficialLeaderboardProcedure:
+			valorantTrackerGetOfficialLeaderboardHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetRankProjectionProcedure:
+			valorantTrackerGetRankProjectionHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetIntegrityReportProcedure:
+			valorantTrackerGetIntegrityReportHandler.ServeHTTP(w, r)
+		case ValorantTrackerCreateWebhookProcedure:
+			valorantTrackerCreateWebhookHandler.ServeHTTP(w, r)
+		case ValorantTrackerDeleteWebhookProcedure:
+			valorantTrackerDeleteWebhookHandler.ServeHTTP(w, r)
+		case ValorantTrackerListWebhooksProcedure:
+			valorantTrackerListWebhooksHandler.ServeHTTP(w, r)
+		case ValorantTrackerListWebhookDeliveriesProcedure:
+			valorantTrackerListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case ValorantTrackerAddGroupMemberProcedure:
+			valorantTrackerAddGroupMemberHandler.ServeHTTP(w, r)
+		case ValorantTrackerRemoveGroupMemberProcedure:
+			valorantTrackerRemoveGroupMemberHandler.ServeHTTP(w, r)
+		case ValorantTrackerListGroupMembersProcedure:
+			valorantTrackerListGroupMembersHandler.ServeHTTP(w, r)
+		case ValorantTrackerGetSuspicionProcedure:
+			valorantTrackerGetSuspicionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -224,3 +1025,131 @@ func (UnimplementedValorantTrackerHandler) GetMatch(context.Context, *connect.Re
 func (UnimplementedValorantTrackerHandler) GetPlayerByPuuid(context.Context, *connect.Request[v1.GetPlayerByPuuidRequest]) (*connect.Response[v1.PlayerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetPlayerByPuuid is not implemented"))
 }
+
+func (UnimplementedValorantTrackerHandler) FollowPlayer(context.Context, *connect.Request[v1.FollowPlayerRequest]) (*connect.Response[v1.FollowPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.FollowPlayer is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) UnfollowPlayer(context.Context, *connect.Request[v1.UnfollowPlayerRequest]) (*connect.Response[v1.UnfollowPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.UnfollowPlayer is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetFeed(context.Context, *connect.Request[v1.GetFeedRequest]) (*connect.Response[v1.GetFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetFeed is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) WatchPlayer(context.Context, *connect.Request[v1.WatchPlayerRequest], *connect.ServerStream[v1.WatchPlayerResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.WatchPlayer is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetSessions(context.Context, *connect.Request[v1.GetSessionsRequest]) (*connect.Response[v1.GetSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetSessions is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetAgentStats(context.Context, *connect.Request[v1.GetAgentStatsRequest]) (*connect.Response[v1.GetAgentStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetAgentStats is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetMapStats(context.Context, *connect.Request[v1.GetMapStatsRequest]) (*connect.Response[v1.GetMapStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetMapStats is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetOpponentStrength(context.Context, *connect.Request[v1.GetOpponentStrengthRequest]) (*connect.Response[v1.GetOpponentStrengthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetOpponentStrength is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetHeatmap(context.Context, *connect.Request[v1.GetHeatmapRequest]) (*connect.Response[v1.GetHeatmapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetHeatmap is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetOverallStats(context.Context, *connect.Request[v1.GetOverallStatsRequest]) (*connect.Response[v1.GetOverallStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetOverallStats is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) ListPatches(context.Context, *connect.Request[v1.ListPatchesRequest]) (*connect.Response[v1.ListPatchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.ListPatches is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetClusterStats(context.Context, *connect.Request[v1.GetClusterStatsRequest]) (*connect.Response[v1.GetClusterStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetClusterStats is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetClusterDistribution(context.Context, *connect.Request[v1.GetClusterDistributionRequest]) (*connect.Response[v1.GetClusterDistributionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetClusterDistribution is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetWeaponStats(context.Context, *connect.Request[v1.GetWeaponStatsRequest]) (*connect.Response[v1.GetWeaponStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetWeaponStats is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetWeaponMeta(context.Context, *connect.Request[v1.GetWeaponMetaRequest]) (*connect.Response[v1.GetWeaponMetaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetWeaponMeta is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetPatchMeta(context.Context, *connect.Request[v1.GetPatchMetaRequest]) (*connect.Response[v1.GetPatchMetaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetPatchMeta is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetEncounters(context.Context, *connect.Request[v1.GetEncountersRequest]) (*connect.Response[v1.GetEncountersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetEncounters is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetEncounter(context.Context, *connect.Request[v1.GetEncounterRequest]) (*connect.Response[v1.GetEncounterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetEncounter is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetSynergy(context.Context, *connect.Request[v1.GetSynergyRequest]) (*connect.Response[v1.GetSynergyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetSynergy is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) ComparePlayers(context.Context, *connect.Request[v1.ComparePlayersRequest]) (*connect.Response[v1.ComparePlayersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.ComparePlayers is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetOfficialLeaderboard(context.Context, *connect.Request[v1.GetOfficialLeaderboardRequest]) (*connect.Response[v1.GetOfficialLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetOfficialLeaderboard is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetRankProjection(context.Context, *connect.Request[v1.GetRankProjectionRequest]) (*connect.Response[v1.GetRankProjectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetRankProjection is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetIntegrityReport(context.Context, *connect.Request[v1.GetIntegrityReportRequest]) (*connect.Response[v1.GetIntegrityReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetIntegrityReport is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) CreateWebhook(context.Context, *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.AddGroupMember is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.RemoveGroupMember is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) ListGroupMembers(context.Context, *connect.Request[v1.ListGroupMembersRequest]) (*connect.Response[v1.ListGroupMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.ListGroupMembers is not implemented"))
+}
+
+func (UnimplementedValorantTrackerHandler) GetSuspicion(context.Context, *connect.Request[v1.GetSuspicionRequest]) (*connect.Response[v1.GetSuspicionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("valorant.v1.ValorantTracker.GetSuspicion is not implemented"))
+}
@@ -54,6 +54,16 @@ func (c *HDevClient) GetRateLimitInfo() RateLimitInfo {
 	return c.rateLimit
 }
 
+// HasBudget reports whether more than reserve requests are left in the current rate limit window,
+// so background jobs can back off before they eat into what user requests need.
+func (c *HDevClient) HasBudget(reserve int) bool {
+	info := c.GetRateLimitInfo()
+	if time.Since(info.UpdatedAt) > time.Duration(info.Reset)*time.Second {
+		return true // window has reset since the last response we saw
+	}
+	return info.Remaining > reserve
+}
+
 func (c *HDevClient) updateRateLimit(resp *fasthttp.Response) {
 	c.rateLimitMu.Lock()
 	defer c.rateLimitMu.Unlock()
@@ -84,6 +94,11 @@ func (c *HDevClient) GetAccount(ctx context.Context, name, tag string) (*Account
 	return doRequest[AccountResponse](ctx, c, url)
 }
 
+func (c *HDevClient) GetAccountByPuuid(ctx context.Context, puuid string) (*AccountResponse, error) {
+	url := fmt.Sprintf("https://api.henrikdev.xyz/valorant/v2/by-puuid/account/%s", puuid)
+	return doRequest[AccountResponse](ctx, c, url)
+}
+
 func (c *HDevClient) GetStoredMatches(ctx context.Context, region, puuid string) (*StoredMatchesResponse, error) {
 	url := fmt.Sprintf("https://api.henrikdev.xyz/valorant/v1/by-puuid/stored-matches/%s/%s?mode=competitive", region, puuid)
 	return doRequest[StoredMatchesResponse](ctx, c, url)
@@ -114,6 +129,12 @@ func (c *HDevClient) GetMMRByNameTag(ctx context.Context, region, name, tag stri
 	return doRequest[MMRResponse](ctx, c, url)
 }
 
+// GetLeaderboard returns one page of the official ranked leaderboard, starting after startIndex entries.
+func (c *HDevClient) GetLeaderboard(ctx context.Context, region string, startIndex, size int) (*LeaderboardResponse, error) {
+	url := fmt.Sprintf("https://api.henrikdev.xyz/valorant/v3/leaderboard/%s/pc?start_index=%d&size=%d", region, startIndex, size)
+	return doRequest[LeaderboardResponse](ctx, c, url)
+}
+
 func doRequest[T any](ctx context.Context, client *HDevClient, url string) (*T, error) {
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
@@ -247,6 +268,8 @@ type V4MatchData struct {
 	Metadata V4MatchMetadata `json:"metadata"`
 	Players  []V4Player      `json:"players"`
 	Teams    []V4Team        `json:"teams"`
+	Rounds   []V4Round       `json:"rounds"`
+	Kills    []V4Kill        `json:"kills"`
 }
 
 type V4MatchMetadata struct {
@@ -293,6 +316,7 @@ type V4Player struct {
 		Title string `json:"title"`
 	} `json:"customization"`
 	TeamID   string `json:"team_id"`
+	PartyID  string `json:"party_id"`
 	Behavior struct {
 		AfkRounds    float64 `json:"afk_rounds"`
 		FriendlyFire struct {
@@ -311,6 +335,51 @@ type V4Team struct {
 	} `json:"rounds"`
 }
 
+type V4Round struct {
+	ID          int    `json:"id"`
+	Result      string `json:"result"`
+	WinningTeam string `json:"winning_team"`
+	Plant       *struct {
+		Site string `json:"site"`
+	} `json:"plant"`
+	Defuse *struct{}            `json:"defuse"`
+	Stats  []V4RoundPlayerStats `json:"stats"`
+}
+
+type V4RoundPlayerStats struct {
+	Player V4KillPlayer `json:"player"`
+	Stats  struct {
+		Headshots int `json:"headshots"`
+		Bodyshots int `json:"bodyshots"`
+		Legshots  int `json:"legshots"`
+		Damage    int `json:"damage"`
+	} `json:"stats"`
+	Economy struct {
+		// nil when the player had no primary or sidearm, e.g. knife only
+		Weapon *struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"weapon"`
+	} `json:"economy"`
+}
+
+type V4KillPlayer struct {
+	Puuid string `json:"puuid"`
+	Team  string `json:"team"`
+}
+
+type V4Kill struct {
+	TimeInRoundInMs int            `json:"time_in_round_in_ms"`
+	Round           int            `json:"round"`
+	Killer          V4KillPlayer   `json:"killer"`
+	Victim          V4KillPlayer   `json:"victim"`
+	Assistants      []V4KillPlayer `json:"assistants"`
+	Weapon          struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"weapon"`
+}
+
 type MMRHistoryResponse struct {
 	Status int              `json:"status"`
 	Data   []MMRHistoryItem `json:"data"`
@@ -326,6 +395,30 @@ type MMRHistoryItem struct {
 	Date                string `json:"date"`
 }
 
+type LeaderboardResponse struct {
+	Status int             `json:"status"`
+	Data   LeaderboardData `json:"data"`
+}
+
+type LeaderboardData struct {
+	UpdatedAt string              `json:"updated_at"`
+	Players   []LeaderboardPlayer `json:"players"`
+}
+
+// LeaderboardPlayer has an empty puuid, name and tag when the player is anonymized.
+type LeaderboardPlayer struct {
+	Puuid           string `json:"puuid"`
+	Name            string `json:"name"`
+	Tag             string `json:"tag"`
+	Card            string `json:"card"`
+	IsAnonymized    bool   `json:"is_anonymized"`
+	IsBanned        bool   `json:"is_banned"`
+	LeaderboardRank int    `json:"leaderboard_rank"`
+	Tier            int    `json:"tier"`
+	RR              int    `json:"rr"`
+	Wins            int    `json:"wins"`
+}
+
 type MMRResponse struct {
 	Status int        `json:"status"`
 	Data   MMRCurrent `json:"data"`
@@ -372,6 +465,7 @@ type MatchV2Response struct {
 				CurrenttierPatched string `json:"currenttier_patched"`
 				PlayerCard         string `json:"player_card"`
 				PlayerTitle        string `json:"player_title"`
+				PartyID            string `json:"party_id"`
 				Stats              struct {
 					Score   int `json:"score"`
 					Kills   int `json:"kills"`
@@ -390,5 +484,46 @@ type MatchV2Response struct {
 				RoundsWon int `json:"rounds_won"`
 			} `json:"blue"`
 		} `json:"teams"`
+		Rounds []V2Round `json:"rounds"`
+		Kills  []V2Kill  `json:"kills"`
 	} `json:"data"`
 }
+
+type V2Round struct {
+	WinningTeam string `json:"winning_team"`
+	EndType     string `json:"end_type"`
+	BombPlanted bool   `json:"bomb_planted"`
+	BombDefused bool   `json:"bomb_defused"`
+	PlantEvents struct {
+		PlantSite string `json:"plant_site"`
+	} `json:"plant_events"`
+	PlayerStats []V2RoundPlayerStats `json:"player_stats"`
+}
+
+type V2RoundPlayerStats struct {
+	PlayerPuuid string `json:"player_puuid"`
+	Damage      int    `json:"damage"`
+	Headshots   int    `json:"headshots"`
+	Bodyshots   int    `json:"bodyshots"`
+	Legshots    int    `json:"legshots"`
+	Economy     struct {
+		Weapon struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"weapon"`
+	} `json:"economy"`
+}
+
+type V2Kill struct {
+	KillTimeInRound int    `json:"kill_time_in_round"`
+	Round           int    `json:"round"`
+	KillerPuuid     string `json:"killer_puuid"`
+	KillerTeam      string `json:"killer_team"`
+	VictimPuuid     string `json:"victim_puuid"`
+	VictimTeam      string `json:"victim_team"`
+	Assistants      []struct {
+		AssistantPuuid string `json:"assistant_puuid"`
+	} `json:"assistants"`
+	DamageWeaponID   string `json:"damage_weapon_id"`
+	DamageWeaponName string `json:"damage_weapon_name"`
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -16,6 +17,19 @@ type Config struct {
 	ServerPort string
 	LogLevel   string
 	CacheTTL   time.Duration
+
+	// empty disables the admin RPCs
+	AdminAPIKey string
+	// puuids the refresh scheduler keeps fresh on top of followed players
+	TrackedPuuids []string
+	// regions whose official ranked leaderboard is ingested, empty disables ingestion
+	LeaderboardRegions []string
+
+	// hex ed25519 key from the developer portal, empty disables the interactions endpoint
+	DiscordPublicKey string
+	// both needed to register the slash commands on startup
+	DiscordApplicationID string
+	DiscordBotToken      string
 }
 
 func Load(logger zerolog.Logger) (*Config, error) {
@@ -29,6 +43,20 @@ func Load(logger zerolog.Logger) (*Config, error) {
 		ServerPort: getEnv("SERVER_PORT", "8080"),
 		LogLevel:   getEnv("LOG_LEVEL", "info"),
 		CacheTTL:   5 * time.Minute,
+
+		AdminAPIKey:   getEnv("ADMIN_API_KEY", ""),
+		TrackedPuuids: getEnvList("TRACKED_PUUIDS"),
+
+		LeaderboardRegions: getEnvList("LEADERBOARD_REGIONS"),
+
+		DiscordPublicKey:     getEnv("DISCORD_PUBLIC_KEY", ""),
+		DiscordApplicationID: getEnv("DISCORD_APPLICATION_ID", ""),
+		DiscordBotToken:      getEnv("DISCORD_BOT_TOKEN", ""),
+	}
+
+	// stored snapshots and lookups use lowercase regions
+	for i, region := range cfg.LeaderboardRegions {
+		cfg.LeaderboardRegions[i] = strings.ToLower(region)
 	}
 
 	if cfg.HDevAPIKey == "" {
@@ -40,6 +68,10 @@ func Load(logger zerolog.Logger) (*Config, error) {
 		Str("server_port", cfg.ServerPort).
 		Str("log_level", cfg.LogLevel).
 		Dur("cache_ttl", cfg.CacheTTL).
+		Bool("admin_api_enabled", cfg.AdminAPIKey != "").
+		Int("tracked_puuids", len(cfg.TrackedPuuids)).
+		Strs("leaderboard_regions", cfg.LeaderboardRegions).
+		Bool("discord_enabled", cfg.DiscordPublicKey != "").
 		Msg("configuration loaded")
 
 	return cfg, nil
@@ -52,4 +84,14 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+func getEnvList(key string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 var Module = fx.Provide(Load)
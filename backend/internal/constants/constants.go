@@ -32,3 +32,165 @@ const (
 const (
 	SearchSuggestionLimit = 10
 )
+
+const (
+	ReconcileInterval       = 15 * time.Minute
+	ReconcileHDevReserve    = 30 // requests left untouched for user traffic
+	ReconcileMaxRefetches   = 20 // match refetches per run, the rest wait for the next one
+	PartialPlayerStaleAfter = 1 * time.Hour
+	PartialPlayerBatchSize  = 20
+)
+
+const (
+	RefreshSchedulerTick   = 1 * time.Minute
+	RefreshBatchSize       = 5
+	RefreshHDevReserve     = 30
+	RefreshMinInterval     = 10 * time.Minute
+	RefreshMaxInterval     = 24 * time.Hour
+	RefreshDefaultInterval = 1 * time.Hour
+	RefreshActiveWindow    = 2 * time.Hour // a match this recent means the player is mid-session
+	RefreshFrequencySample = 20
+	RefreshFailureBackoff  = 30 * time.Minute
+)
+
+const (
+	FeedDefaultLimit = 20
+	FeedMaxLimit     = 100
+)
+
+const (
+	WebhookDispatchTick    = 5 * time.Second
+	WebhookDispatchBatch   = 20
+	WebhookQueueSize       = 256
+	WebhookRequestTimeout  = 10 * time.Second
+	WebhookMaxAttempts     = 8
+	WebhookRetryBase       = 30 * time.Second // doubled after every failed attempt
+	WebhookRetryMax        = 1 * time.Hour
+	WebhookDeliveryLimit   = 50
+	WebhookDeliveryMaxList = 500
+	WinStreakMinLength     = 3
+	WinStreakSample        = 50
+)
+
+const (
+	DiscordMaxBodySize      = 1 << 20
+	DiscordResponseDeadline = 2 * time.Second // Discord drops interactions not answered within 3s
+	DiscordFollowupTimeout  = 10 * time.Second
+	DiscordRecentForm       = 5
+)
+
+const (
+	WatchPollInterval = 1 * time.Minute // upstream is still only hit once the refresh TTLs expire
+	WatchHDevReserve  = 20
+	WatchBufferSize   = 32
+)
+
+const (
+	SessionDefaultGap   = 1 * time.Hour
+	SessionMinGap       = 10 * time.Minute
+	SessionMaxGap       = 12 * time.Hour
+	SessionMatchLength  = 35 * time.Minute // game length isn't stored, a session ends this long after its last start
+	SessionTodayWindow  = 24 * time.Hour
+	SessionDefaultLimit = 20
+	SessionMaxLimit     = 100
+)
+
+const (
+	TiltLossStreak     = 3 // straight losses in a session before later games count as tilted
+	TiltMinGames       = 5
+	TiltMinWinRateDrop = 0.1
+	TiltMinRatingDrop  = 25.0 // lobby-relative rating, 500 is an average game
+	TiltMinKDDrop      = 0.1  // used when either side has no rated games
+)
+
+const (
+	KASTTradeWindow = 5 * time.Second // a death counts as traded if the killer dies this soon after
+	RegulationHalf  = 12              // rounds per half before sides swap
+)
+
+const (
+	RatingBase       = 500.0
+	RatingPerStdDev  = 150.0 // points per standard deviation above the lobby
+	RatingTierPivot  = 14    // Gold 3, lobbies averaging this rank get no adjustment
+	RatingPerTier    = 5.0
+	RatingMinLobby   = 2
+	RatingRollingAvg = 20 // matches in the PlayerResponse average
+)
+
+const (
+	EncounterDefaultLimit = 50
+	EncounterMaxLimit     = 200
+)
+
+const (
+	SynergyCoOccurrence    = 3 // shared matches that mark a teammate as premade when party ids are missing
+	SynergyDefaultMinGames = 2
+	SynergyDefaultLimit    = 20
+	SynergyMaxLimit        = 100
+)
+
+const (
+	CompareMinPlayers = 2
+	CompareMaxPlayers = 5
+)
+
+const (
+	LeaderboardDefaultMinGames = 5
+	LeaderboardDefaultLimit    = 50
+	LeaderboardMaxLimit        = 200
+)
+
+const (
+	OfficialLeaderboardInterval     = 1 * time.Hour
+	OfficialLeaderboardPageSize     = 200
+	OfficialLeaderboardDepth        = 1000 // top entries kept per region
+	OfficialLeaderboardRetention    = 30 * 24 * time.Hour
+	OfficialLeaderboardHDevReserve  = 30
+	OfficialLeaderboardDefaultLimit = 50
+	OfficialLeaderboardMaxLimit     = 200
+)
+
+const (
+	OpponentStrengthDefaultBucket = 7 * 24 * time.Hour
+	OpponentStrengthMinBucket     = 24 * time.Hour
+	OpponentStrengthMaxBucket     = 90 * 24 * time.Hour
+)
+
+// suspicion signal weights sum to 1, thresholds are where a signal starts to count and
+// saturations where it counts fully
+const (
+	SuspicionWeightLevel   = 0.3
+	SuspicionWeightRating  = 0.3
+	SuspicionWeightRR      = 0.2
+	SuspicionWeightSession = 0.2
+
+	SuspicionLevelThreshold  = 100 // account levels at or above this never count
+	SuspicionLevelSaturation = 20
+	SuspicionTierThreshold   = 12 // Gold 1
+	SuspicionTierSaturation  = 21 // Ascendant 1
+
+	SuspicionRatingThreshold  = 550.0 // lobby-relative, 500 is an average game
+	SuspicionRatingSaturation = 700.0
+	SuspicionRRThreshold      = 24.0 // RR per win
+	SuspicionRRSaturation     = 32.0
+	SuspicionShiftThreshold   = 1.3 // latest session ACS over the earlier sessions
+	SuspicionShiftSaturation  = 2.0
+
+	SuspicionSample         = 20 // latest matches looked at for rating and RR
+	SuspicionMinSample      = 5
+	SuspicionMinSession     = 3    // matches in the latest session
+	SuspicionDisplayMinimum = 0.25 // coverage needed before match pages show a score
+)
+
+const (
+	RRPerTier               = 100
+	ProjectionSample        = 30 // latest rated games the model is fitted on
+	ProjectionMinSample     = 5
+	ProjectionDefaultGames  = 10
+	ProjectionMaxGames      = 100
+	ProjectionHorizon       = 500      // games simulated for the expected count
+	ProjectionTailTolerance = 0.01     // unpromoted share allowed at the horizon
+	ProjectionMinRR         = -300     // three tiers below the current one
+	ProjectionConfidenceZ   = 1.644854 // 90% bands
+	ProjectionHighestTier   = 23       // Ascendant 3, higher tiers promote by leaderboard thresholds
+)
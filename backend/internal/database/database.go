@@ -1,24 +1,27 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 	"fmt"
+	"strings"
 	"valorant-tracker/internal/config"
 	"valorant-tracker/internal/constants"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/pressly/goose/v3"
 	"github.com/rs/zerolog"
+	"go.uber.org/fx"
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
-func New(cfg *config.Config, logger zerolog.Logger) (*sql.DB, error) {
+func New(lc fx.Lifecycle, cfg *config.Config, logger zerolog.Logger) (*sql.DB, error) {
 	logger.Info().Str("path", cfg.DBPath).Msg("connecting to database")
 
-	db, err := sql.Open("sqlite3", cfg.DBPath)
+	db, err := sql.Open("sqlite3", dsn(cfg.DBPath))
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to connect to database")
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -38,10 +41,30 @@ func New(cfg *config.Config, logger zerolog.Logger) (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
+	// registered before anything that uses the db, so it closes after they stop
+	lc.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			if err := db.Close(); err != nil {
+				logger.Warn().Err(err).Msg("error closing database connection")
+			}
+			return nil
+		},
+	})
+
 	logger.Info().Msg("database connection established and optimized")
 	return db, nil
 }
 
+// dsn carries the pragmas that only hold for the connection they are set on, the driver
+// applies them to every connection the pool opens.
+func dsn(path string) string {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + "_foreign_keys=1&_busy_timeout=5000"
+}
+
 func runMigrations(db *sql.DB, logger zerolog.Logger) error {
 	goose.SetBaseFS(embedMigrations)
 
@@ -65,8 +88,6 @@ func optimizeSQLite(sqlDB *sql.DB, logger zerolog.Logger) error {
 		{"journal_mode", "WAL"},
 		{"synchronous", "NORMAL"},
 		{"cache_size", "-64000"},
-		{"busy_timeout", "5000"},
-		{"foreign_keys", "ON"},
 		{"temp_store", "MEMORY"},
 		{"mmap_size", "268435456"}, // memory map 256MB for better performance https://sqlite.org/mmap.html
 	}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tracked_players (
+    puuid TEXT PRIMARY KEY NOT NULL,
+    source TEXT NOT NULL,
+    refresh_interval_seconds INTEGER NOT NULL,
+    next_refresh_at DATETIME NOT NULL,
+    last_refresh_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tracked_players_next_refresh ON tracked_players(next_refresh_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tracked_players;
+-- +goose StatementEnd
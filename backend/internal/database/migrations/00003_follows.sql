@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id TEXT NOT NULL,
+    puuid TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, puuid),
+    FOREIGN KEY (puuid) REFERENCES players(puuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_follows_puuid ON follows(puuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS follows;
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS match_rounds (
+    match_id TEXT NOT NULL,
+    round_number INTEGER NOT NULL,
+    winning_team TEXT NOT NULL,
+    attacking_team TEXT NOT NULL DEFAULT '',
+    end_type TEXT NOT NULL DEFAULT '',
+    bomb_planted BOOLEAN NOT NULL DEFAULT FALSE,
+    bomb_defused BOOLEAN NOT NULL DEFAULT FALSE,
+    plant_site TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (match_id, round_number),
+    FOREIGN KEY (match_id) REFERENCES matches(match_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS match_kills (
+    match_id TEXT NOT NULL,
+    round_number INTEGER NOT NULL,
+    time_in_round_ms INTEGER NOT NULL,
+    killer_puuid TEXT NOT NULL,
+    killer_team TEXT NOT NULL,
+    victim_puuid TEXT NOT NULL,
+    victim_team TEXT NOT NULL,
+    assistants TEXT NOT NULL DEFAULT '', -- comma separated puuids
+    weapon_id TEXT NOT NULL DEFAULT '',
+    weapon_name TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (match_id, round_number, time_in_round_ms, victim_puuid),
+    FOREIGN KEY (match_id) REFERENCES matches(match_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_kills_killer ON match_kills(killer_puuid);
+CREATE INDEX IF NOT EXISTS idx_match_kills_victim ON match_kills(victim_puuid);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE match_players ADD COLUMN acs REAL NOT NULL DEFAULT 0;
+ALTER TABLE match_players ADD COLUMN adr REAL NOT NULL DEFAULT 0;
+-- NULL until the match's kill feed has been ingested
+ALTER TABLE match_players ADD COLUMN kast REAL;
+ALTER TABLE match_players ADD COLUMN first_bloods INTEGER;
+ALTER TABLE match_players ADD COLUMN first_deaths INTEGER;
+ALTER TABLE match_players ADD COLUMN plus_minus INTEGER NOT NULL DEFAULT 0;
+
+-- totals are enough for these, the rest waits for a refetch with round data
+UPDATE match_players SET
+    acs = COALESCE(CAST(score AS REAL) / NULLIF((SELECT m.team_red_score + m.team_blue_score FROM matches m WHERE m.match_id = match_players.match_id), 0), 0),
+    adr = COALESCE(CAST(damage_dealt AS REAL) / NULLIF((SELECT m.team_red_score + m.team_blue_score FROM matches m WHERE m.match_id = match_players.match_id), 0), 0),
+    plus_minus = kills - deaths;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE match_players DROP COLUMN plus_minus;
+ALTER TABLE match_players DROP COLUMN first_deaths;
+ALTER TABLE match_players DROP COLUMN first_bloods;
+ALTER TABLE match_players DROP COLUMN kast;
+ALTER TABLE match_players DROP COLUMN adr;
+ALTER TABLE match_players DROP COLUMN acs;
+DROP TABLE IF EXISTS match_kills;
+DROP TABLE IF EXISTS match_rounds;
+-- +goose StatementEnd
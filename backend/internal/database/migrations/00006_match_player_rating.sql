@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NULL when the rest of the lobby wasn't known at ingestion
+ALTER TABLE match_players ADD COLUMN rating REAL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE match_players DROP COLUMN rating;
+-- +goose StatementEnd
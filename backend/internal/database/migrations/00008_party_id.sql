@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- empty when the source had no party data
+ALTER TABLE match_players ADD COLUMN party_id TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE match_players DROP COLUMN party_id;
+-- +goose StatementEnd
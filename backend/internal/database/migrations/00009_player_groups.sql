@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS player_groups (
+    group_name TEXT NOT NULL,
+    puuid TEXT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_name, puuid),
+    FOREIGN KEY (puuid) REFERENCES players(puuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_groups_puuid ON player_groups(puuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS player_groups;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS leaderboard_snapshots (
+    id TEXT PRIMARY KEY NOT NULL,
+    region TEXT NOT NULL,
+    fetched_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_leaderboard_snapshots_region ON leaderboard_snapshots(region, fetched_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- puuid, name and tag are empty for anonymized entries. No foreign key to players, most
+-- leaderboard players are never looked up here.
+CREATE TABLE IF NOT EXISTS leaderboard_entries (
+    snapshot_id TEXT NOT NULL,
+    leaderboard_rank INTEGER NOT NULL,
+    puuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    tag TEXT NOT NULL,
+    tier INTEGER NOT NULL,
+    rr INTEGER NOT NULL,
+    wins INTEGER NOT NULL,
+    PRIMARY KEY (snapshot_id, leaderboard_rank),
+    FOREIGN KEY (snapshot_id) REFERENCES leaderboard_snapshots(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_leaderboard_entries_puuid ON leaderboard_entries(puuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS leaderboard_entries;
+DROP TABLE IF EXISTS leaderboard_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- normalized from version, e.g. release-08.11-shipping-6-2554587 is 8.11. Empty when the
+-- version doesn't parse.
+ALTER TABLE matches ADD COLUMN patch TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE matches
+SET patch = CAST(substr(version, 9, 2) AS INTEGER) || '.' || substr(version, 12, 2)
+WHERE version GLOB 'release-[0-9][0-9].[0-9][0-9]-*';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_matches_patch ON matches(patch);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS patches (
+    name TEXT PRIMARY KEY NOT NULL,
+    major INTEGER NOT NULL,
+    minor INTEGER NOT NULL,
+    -- start of the earliest stored match on the patch
+    first_seen_at DATETIME NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO patches (name, major, minor, first_seen_at)
+SELECT
+    patch,
+    CAST(substr(patch, 1, instr(patch, '.') - 1) AS INTEGER),
+    CAST(substr(patch, instr(patch, '.') + 1) AS INTEGER),
+    MIN(started_at)
+FROM matches
+WHERE patch <> ''
+GROUP BY patch;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS patches;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_matches_patch;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE matches DROP COLUMN patch;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- filled in with the rounds of a match, see match_rounds
+CREATE TABLE IF NOT EXISTS match_player_rounds (
+    match_id TEXT NOT NULL,
+    round_number INTEGER NOT NULL,
+    puuid TEXT NOT NULL,
+    -- weapon bought or kept for the round, empty when there was none
+    weapon_id TEXT NOT NULL DEFAULT '',
+    weapon_name TEXT NOT NULL DEFAULT '',
+    headshots INTEGER NOT NULL DEFAULT 0,
+    bodyshots INTEGER NOT NULL DEFAULT 0,
+    legshots INTEGER NOT NULL DEFAULT 0,
+    damage INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (match_id, round_number, puuid),
+    FOREIGN KEY (match_id) REFERENCES matches(match_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_match_player_rounds_puuid ON match_player_rounds(puuid);
+CREATE INDEX IF NOT EXISTS idx_match_kills_weapon ON match_kills(weapon_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_match_kills_weapon;
+DROP TABLE IF EXISTS match_player_rounds;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a player can be tracked by the config and by followers at once, each source owns its row
+CREATE TABLE tracked_players_new (
+    puuid TEXT NOT NULL,
+    source TEXT NOT NULL,
+    refresh_interval_seconds INTEGER NOT NULL,
+    next_refresh_at DATETIME NOT NULL,
+    last_refresh_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (puuid, source)
+);
+
+INSERT INTO tracked_players_new SELECT puuid, source, refresh_interval_seconds, next_refresh_at, last_refresh_at, created_at, updated_at FROM tracked_players;
+DROP TABLE tracked_players;
+ALTER TABLE tracked_players_new RENAME TO tracked_players;
+
+CREATE INDEX IF NOT EXISTS idx_tracked_players_next_refresh ON tracked_players(next_refresh_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE tracked_players_old (
+    puuid TEXT PRIMARY KEY NOT NULL,
+    source TEXT NOT NULL,
+    refresh_interval_seconds INTEGER NOT NULL,
+    next_refresh_at DATETIME NOT NULL,
+    last_refresh_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT OR IGNORE INTO tracked_players_old SELECT puuid, source, refresh_interval_seconds, next_refresh_at, last_refresh_at, created_at, updated_at FROM tracked_players;
+DROP TABLE tracked_players;
+ALTER TABLE tracked_players_old RENAME TO tracked_players;
+
+CREATE INDEX IF NOT EXISTS idx_tracked_players_next_refresh ON tracked_players(next_refresh_at);
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v2 matches were stored with capitalised modes, filters compare against the lowercase form
+UPDATE matches SET mode = LOWER(mode) WHERE mode <> LOWER(mode);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the original casing isn't kept, lowercase modes work with the old code as well
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- v2 matches were stored in the server's local time, every other source in UTC. started_at
+-- is compared and ordered as text, so bring them onto the same offset.
+UPDATE matches
+SET started_at = strftime('%Y-%m-%d %H:%M:%S+00:00', started_at)
+WHERE source = 'v2' AND started_at NOT LIKE '%+00:00';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- UTC timestamps are read back correctly by the old code
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- foreign keys were only enforced on one pooled connection, pruned snapshots left their entries
+DELETE FROM leaderboard_entries
+WHERE snapshot_id NOT IN (SELECT id FROM leaderboard_snapshots);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- orphaned entries had no snapshot to be listed under, nothing to restore
+SELECT 1;
+-- +goose StatementEnd
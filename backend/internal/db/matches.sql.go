@@ -39,6 +39,60 @@ func (q *Queries) GetLatestMatchDate(ctx context.Context, puuid string) (time.Ti
 	return started_at, err
 }
 
+const getLobbyTiersByPuuid = `-- name: GetLobbyTiersByPuuid :many
+SELECT
+    mp.match_id,
+    mp.team,
+    CAST(SUM(CASE WHEN mp.tier >= ?1 THEN mp.tier ELSE 0 END) AS INTEGER) AS tier_sum,
+    CAST(SUM(CASE WHEN mp.tier >= ?1 THEN 1 ELSE 0 END) AS INTEGER) AS ranked_players,
+    CAST(COUNT(*) AS INTEGER) AS stored_players
+FROM match_players mp
+WHERE mp.match_id IN (SELECT own.match_id FROM match_players own WHERE own.puuid = ?2)
+GROUP BY mp.match_id, mp.team
+`
+
+type GetLobbyTiersByPuuidParams struct {
+	MinTier int64  `json:"min_tier"`
+	Puuid   string `json:"puuid"`
+}
+
+type GetLobbyTiersByPuuidRow struct {
+	MatchID       string `json:"match_id"`
+	Team          string `json:"team"`
+	TierSum       int64  `json:"tier_sum"`
+	RankedPlayers int64  `json:"ranked_players"`
+	StoredPlayers int64  `json:"stored_players"`
+}
+
+func (q *Queries) GetLobbyTiersByPuuid(ctx context.Context, arg GetLobbyTiersByPuuidParams) ([]GetLobbyTiersByPuuidRow, error) {
+	rows, err := q.db.QueryContext(ctx, getLobbyTiersByPuuid, arg.MinTier, arg.Puuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLobbyTiersByPuuidRow{}
+	for rows.Next() {
+		var i GetLobbyTiersByPuuidRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.Team,
+			&i.TierSum,
+			&i.RankedPlayers,
+			&i.StoredPlayers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMMRHistoryByMatchIDs = `-- name: GetMMRHistoryByMatchIDs :many
 SELECT id, match_id, puuid, tier, tier_name, ranking_in_tier, mmr_change, elo, date, source, created_at, updated_at FROM mmr_histories
 WHERE puuid = ? AND match_id IN (/*SLICE:match_ids*/?)
@@ -97,7 +151,7 @@ func (q *Queries) GetMMRHistoryByMatchIDs(ctx context.Context, arg GetMMRHistory
 }
 
 const getMatchMetadata = `-- name: GetMatchMetadata :one
-SELECT match_id, map_name, map_id, mode, started_at, season_id, team_red_score, team_blue_score, region, cluster, version, source, created_at, updated_at FROM matches
+SELECT match_id, map_name, map_id, mode, started_at, season_id, team_red_score, team_blue_score, region, cluster, version, source, created_at, updated_at, patch FROM matches
 WHERE match_id = ?
 LIMIT 1
 `
@@ -120,12 +174,13 @@ func (q *Queries) GetMatchMetadata(ctx context.Context, matchID string) (Match,
 		&i.Source,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Patch,
 	)
 	return i, err
 }
 
 const getMatchPlayersByMatchID = `-- name: GetMatchPlayersByMatchID :many
-SELECT match_id, puuid, name, tag, tier, tier_name, kills, deaths, assists, score, team, has_won, character_id, damage_taken, damage_dealt, created_at, updated_at FROM match_players
+SELECT match_id, puuid, name, tag, tier, tier_name, kills, deaths, assists, score, team, has_won, character_id, damage_taken, damage_dealt, created_at, updated_at, acs, adr, kast, first_bloods, first_deaths, plus_minus, rating, party_id FROM match_players
 WHERE match_id = ?
 `
 
@@ -156,6 +211,14 @@ func (q *Queries) GetMatchPlayersByMatchID(ctx context.Context, matchID string)
 			&i.DamageDealt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Acs,
+			&i.Adr,
+			&i.Kast,
+			&i.FirstBloods,
+			&i.FirstDeaths,
+			&i.PlusMinus,
+			&i.Rating,
+			&i.PartyID,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +234,7 @@ func (q *Queries) GetMatchPlayersByMatchID(ctx context.Context, matchID string)
 }
 
 const getMatchPlayersByMatchIDs = `-- name: GetMatchPlayersByMatchIDs :many
-SELECT match_id, puuid, name, tag, tier, tier_name, kills, deaths, assists, score, team, has_won, character_id, damage_taken, damage_dealt, created_at, updated_at FROM match_players
+SELECT match_id, puuid, name, tag, tier, tier_name, kills, deaths, assists, score, team, has_won, character_id, damage_taken, damage_dealt, created_at, updated_at, acs, adr, kast, first_bloods, first_deaths, plus_minus, rating, party_id FROM match_players
 WHERE puuid = ? AND match_id IN (/*SLICE:match_ids*/?)
 `
 
@@ -218,6 +281,14 @@ func (q *Queries) GetMatchPlayersByMatchIDs(ctx context.Context, arg GetMatchPla
 			&i.DamageDealt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Acs,
+			&i.Adr,
+			&i.Kast,
+			&i.FirstBloods,
+			&i.FirstDeaths,
+			&i.PlusMinus,
+			&i.Rating,
+			&i.PartyID,
 		); err != nil {
 			return nil, err
 		}
@@ -233,7 +304,7 @@ func (q *Queries) GetMatchPlayersByMatchIDs(ctx context.Context, arg GetMatchPla
 }
 
 const getMatchesByPuuid = `-- name: GetMatchesByPuuid :many
-SELECT m.match_id, m.map_name, m.map_id, m.mode, m.started_at, m.season_id, m.team_red_score, m.team_blue_score, m.region, m.cluster, m.version, m.source, m.created_at, m.updated_at FROM matches m
+SELECT m.match_id, m.map_name, m.map_id, m.mode, m.started_at, m.season_id, m.team_red_score, m.team_blue_score, m.region, m.cluster, m.version, m.source, m.created_at, m.updated_at, m.patch FROM matches m
 INNER JOIN match_players mp ON m.match_id = mp.match_id
 WHERE mp.puuid = ?
 ORDER BY m.started_at DESC
@@ -263,6 +334,7 @@ func (q *Queries) GetMatchesByPuuid(ctx context.Context, puuid string) ([]Match,
 			&i.Source,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Patch,
 		); err != nil {
 			return nil, err
 		}
@@ -277,12 +349,128 @@ func (q *Queries) GetMatchesByPuuid(ctx context.Context, puuid string) ([]Match,
 	return items, nil
 }
 
+const getRecentMatchOutcomes = `-- name: GetRecentMatchOutcomes :many
+SELECT mp.has_won FROM match_players mp
+INNER JOIN matches m ON m.match_id = mp.match_id
+WHERE mp.puuid = ?
+ORDER BY m.started_at DESC
+LIMIT ?
+`
+
+type GetRecentMatchOutcomesParams struct {
+	Puuid string `json:"puuid"`
+	Limit int64  `json:"limit"`
+}
+
+func (q *Queries) GetRecentMatchOutcomes(ctx context.Context, arg GetRecentMatchOutcomesParams) ([]bool, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentMatchOutcomes, arg.Puuid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []bool{}
+	for rows.Next() {
+		var has_won bool
+		if err := rows.Scan(&has_won); err != nil {
+			return nil, err
+		}
+		items = append(items, has_won)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentMatchStartTimes = `-- name: GetRecentMatchStartTimes :many
+SELECT m.started_at FROM matches m
+INNER JOIN match_players mp ON m.match_id = mp.match_id
+WHERE mp.puuid = ?
+ORDER BY m.started_at DESC
+LIMIT ?
+`
+
+type GetRecentMatchStartTimesParams struct {
+	Puuid string `json:"puuid"`
+	Limit int64  `json:"limit"`
+}
+
+func (q *Queries) GetRecentMatchStartTimes(ctx context.Context, arg GetRecentMatchStartTimesParams) ([]time.Time, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentMatchStartTimes, arg.Puuid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []time.Time{}
+	for rows.Next() {
+		var started_at time.Time
+		if err := rows.Scan(&started_at); err != nil {
+			return nil, err
+		}
+		items = append(items, started_at)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStoredMatchIDs = `-- name: GetStoredMatchIDs :many
+SELECT match_id FROM match_players
+WHERE puuid = ? AND match_id IN (/*SLICE:match_ids*/?)
+`
+
+type GetStoredMatchIDsParams struct {
+	Puuid    string   `json:"puuid"`
+	MatchIds []string `json:"match_ids"`
+}
+
+func (q *Queries) GetStoredMatchIDs(ctx context.Context, arg GetStoredMatchIDsParams) ([]string, error) {
+	query := getStoredMatchIDs
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Puuid)
+	if len(arg.MatchIds) > 0 {
+		for _, v := range arg.MatchIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:match_ids*/?", strings.Repeat(",?", len(arg.MatchIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:match_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var match_id string
+		if err := rows.Scan(&match_id); err != nil {
+			return nil, err
+		}
+		items = append(items, match_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertMatch = `-- name: UpsertMatch :exec
 INSERT INTO matches (
     match_id, map_name, map_id, mode, started_at, season_id,
     team_red_score, team_blue_score, region, cluster, version,
-    source, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    source, created_at, updated_at, patch
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(match_id) DO UPDATE SET
     map_name = excluded.map_name,
     map_id = excluded.map_id,
@@ -295,7 +483,8 @@ ON CONFLICT(match_id) DO UPDATE SET
     cluster = excluded.cluster,
     version = excluded.version,
     source = excluded.source,
-    updated_at = excluded.updated_at
+    updated_at = excluded.updated_at,
+    patch = excluded.patch
 `
 
 type UpsertMatchParams struct {
@@ -313,6 +502,7 @@ type UpsertMatchParams struct {
 	Source        string    `json:"source"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	Patch         string    `json:"patch"`
 }
 
 func (q *Queries) UpsertMatch(ctx context.Context, arg UpsertMatchParams) error {
@@ -331,6 +521,7 @@ func (q *Queries) UpsertMatch(ctx context.Context, arg UpsertMatchParams) error
 		arg.Source,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Patch,
 	)
 	return err
 }
@@ -340,8 +531,9 @@ INSERT INTO match_players (
     match_id, puuid, name, tag, tier, tier_name,
     kills, deaths, assists, score, team, has_won,
     character_id, damage_taken, damage_dealt,
-    created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    created_at, updated_at,
+    acs, adr, kast, first_bloods, first_deaths, plus_minus, rating, party_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(match_id, puuid) DO UPDATE SET
     name = excluded.name,
     tag = excluded.tag,
@@ -356,7 +548,15 @@ ON CONFLICT(match_id, puuid) DO UPDATE SET
     character_id = excluded.character_id,
     damage_taken = excluded.damage_taken,
     damage_dealt = excluded.damage_dealt,
-    updated_at = excluded.updated_at
+    updated_at = excluded.updated_at,
+    acs = excluded.acs,
+    adr = excluded.adr,
+    kast = COALESCE(excluded.kast, match_players.kast),
+    first_bloods = COALESCE(excluded.first_bloods, match_players.first_bloods),
+    first_deaths = COALESCE(excluded.first_deaths, match_players.first_deaths),
+    plus_minus = excluded.plus_minus,
+    rating = COALESCE(excluded.rating, match_players.rating),
+    party_id = CASE WHEN excluded.party_id <> '' THEN excluded.party_id ELSE match_players.party_id END
 `
 
 type UpsertMatchPlayerParams struct {
@@ -377,6 +577,14 @@ type UpsertMatchPlayerParams struct {
 	DamageDealt int64     `json:"damage_dealt"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	Acs         float64   `json:"acs"`
+	Adr         float64   `json:"adr"`
+	Kast        *float64  `json:"kast"`
+	FirstBloods *int64    `json:"first_bloods"`
+	FirstDeaths *int64    `json:"first_deaths"`
+	PlusMinus   int64     `json:"plus_minus"`
+	Rating      *float64  `json:"rating"`
+	PartyID     string    `json:"party_id"`
 }
 
 func (q *Queries) UpsertMatchPlayer(ctx context.Context, arg UpsertMatchPlayerParams) error {
@@ -398,6 +606,14 @@ func (q *Queries) UpsertMatchPlayer(ctx context.Context, arg UpsertMatchPlayerPa
 		arg.DamageDealt,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Acs,
+		arg.Adr,
+		arg.Kast,
+		arg.FirstBloods,
+		arg.FirstDeaths,
+		arg.PlusMinus,
+		arg.Rating,
+		arg.PartyID,
 	)
 	return err
 }
@@ -24,6 +24,7 @@ SELECT
     m.cluster,
     m.version,
     m.source,
+    m.patch,
     m.created_at as match_created_at,
     m.updated_at as match_updated_at,
     mp.puuid,
@@ -42,6 +43,13 @@ SELECT
     mp.damage_dealt,
     mp.created_at as mp_created_at,
     mp.updated_at as mp_updated_at,
+    mp.acs,
+    mp.adr,
+    mp.kast,
+    mp.first_bloods,
+    mp.first_deaths,
+    mp.plus_minus,
+    mp.rating,
     mmr.id as mmr_id,
     mmr.tier as mmr_tier,
     mmr.tier_name as mmr_tier_name,
@@ -72,6 +80,7 @@ type GetMatchesWithPlayerDataByPuuidRow struct {
 	Cluster        string     `json:"cluster"`
 	Version        string     `json:"version"`
 	Source         string     `json:"source"`
+	Patch          string     `json:"patch"`
 	MatchCreatedAt time.Time  `json:"match_created_at"`
 	MatchUpdatedAt time.Time  `json:"match_updated_at"`
 	Puuid          string     `json:"puuid"`
@@ -90,6 +99,13 @@ type GetMatchesWithPlayerDataByPuuidRow struct {
 	DamageDealt    int64      `json:"damage_dealt"`
 	MpCreatedAt    time.Time  `json:"mp_created_at"`
 	MpUpdatedAt    time.Time  `json:"mp_updated_at"`
+	Acs            float64    `json:"acs"`
+	Adr            float64    `json:"adr"`
+	Kast           *float64   `json:"kast"`
+	FirstBloods    *int64     `json:"first_bloods"`
+	FirstDeaths    *int64     `json:"first_deaths"`
+	PlusMinus      int64      `json:"plus_minus"`
+	Rating         *float64   `json:"rating"`
 	MmrID          *string    `json:"mmr_id"`
 	MmrTier        *int64     `json:"mmr_tier"`
 	MmrTierName    *string    `json:"mmr_tier_name"`
@@ -124,6 +140,7 @@ func (q *Queries) GetMatchesWithPlayerDataByPuuid(ctx context.Context, puuid str
 			&i.Cluster,
 			&i.Version,
 			&i.Source,
+			&i.Patch,
 			&i.MatchCreatedAt,
 			&i.MatchUpdatedAt,
 			&i.Puuid,
@@ -142,6 +159,13 @@ func (q *Queries) GetMatchesWithPlayerDataByPuuid(ctx context.Context, puuid str
 			&i.DamageDealt,
 			&i.MpCreatedAt,
 			&i.MpUpdatedAt,
+			&i.Acs,
+			&i.Adr,
+			&i.Kast,
+			&i.FirstBloods,
+			&i.FirstDeaths,
+			&i.PlusMinus,
+			&i.Rating,
 			&i.MmrID,
 			&i.MmrTier,
 			&i.MmrTierName,
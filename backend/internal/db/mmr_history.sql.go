@@ -58,6 +58,80 @@ func (q *Queries) GetMMRHistoryByPuuid(ctx context.Context, arg GetMMRHistoryByP
 	return items, nil
 }
 
+const getRatedGamesByPuuid = `-- name: GetRatedGamesByPuuid :many
+SELECT
+    h.id, h.match_id, h.puuid, h.tier, h.tier_name, h.ranking_in_tier,
+    h.mmr_change, h.elo, h.date, h.source, h.created_at, h.updated_at,
+    mp.has_won,
+    CAST(m.team_red_score = m.team_blue_score AS BOOLEAN) AS drawn
+FROM mmr_histories h
+LEFT JOIN match_players mp ON mp.match_id = h.match_id AND mp.puuid = h.puuid
+LEFT JOIN matches m ON m.match_id = h.match_id
+WHERE h.puuid = ?
+ORDER BY h.date DESC
+LIMIT ?
+`
+
+type GetRatedGamesByPuuidParams struct {
+	Puuid string `json:"puuid"`
+	Limit int64  `json:"limit"`
+}
+
+type GetRatedGamesByPuuidRow struct {
+	ID            string    `json:"id"`
+	MatchID       string    `json:"match_id"`
+	Puuid         string    `json:"puuid"`
+	Tier          int64     `json:"tier"`
+	TierName      string    `json:"tier_name"`
+	RankingInTier int64     `json:"ranking_in_tier"`
+	MmrChange     int64     `json:"mmr_change"`
+	Elo           int64     `json:"elo"`
+	Date          time.Time `json:"date"`
+	Source        string    `json:"source"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	HasWon        *bool     `json:"has_won"`
+	Drawn         *bool     `json:"drawn"`
+}
+
+func (q *Queries) GetRatedGamesByPuuid(ctx context.Context, arg GetRatedGamesByPuuidParams) ([]GetRatedGamesByPuuidRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRatedGamesByPuuid, arg.Puuid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetRatedGamesByPuuidRow{}
+	for rows.Next() {
+		var i GetRatedGamesByPuuidRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchID,
+			&i.Puuid,
+			&i.Tier,
+			&i.TierName,
+			&i.RankingInTier,
+			&i.MmrChange,
+			&i.Elo,
+			&i.Date,
+			&i.Source,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.HasWon,
+			&i.Drawn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertMMRHistory = `-- name: UpsertMMRHistory :exec
 INSERT INTO mmr_histories (
     id, match_id, puuid, tier, tier_name, ranking_in_tier,
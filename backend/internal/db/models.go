@@ -8,6 +8,29 @@ import (
 	"time"
 )
 
+type Follow struct {
+	FollowerID string    `json:"follower_id"`
+	Puuid      string    `json:"puuid"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type LeaderboardEntry struct {
+	SnapshotID      string `json:"snapshot_id"`
+	LeaderboardRank int64  `json:"leaderboard_rank"`
+	Puuid           string `json:"puuid"`
+	Name            string `json:"name"`
+	Tag             string `json:"tag"`
+	Tier            int64  `json:"tier"`
+	Rr              int64  `json:"rr"`
+	Wins            int64  `json:"wins"`
+}
+
+type LeaderboardSnapshot struct {
+	ID        string    `json:"id"`
+	Region    string    `json:"region"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
 type Match struct {
 	MatchID       string    `json:"match_id"`
 	MapName       string    `json:"map_name"`
@@ -23,6 +46,27 @@ type Match struct {
 	Source        string    `json:"source"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	Patch         string    `json:"patch"`
+}
+
+type MatchAward struct {
+	MatchID string  `json:"match_id"`
+	Award   string  `json:"award"`
+	Puuid   string  `json:"puuid"`
+	Value   float64 `json:"value"`
+}
+
+type MatchKill struct {
+	MatchID       string `json:"match_id"`
+	RoundNumber   int64  `json:"round_number"`
+	TimeInRoundMs int64  `json:"time_in_round_ms"`
+	KillerPuuid   string `json:"killer_puuid"`
+	KillerTeam    string `json:"killer_team"`
+	VictimPuuid   string `json:"victim_puuid"`
+	VictimTeam    string `json:"victim_team"`
+	Assistants    string `json:"assistants"`
+	WeaponID      string `json:"weapon_id"`
+	WeaponName    string `json:"weapon_name"`
 }
 
 type MatchPlayer struct {
@@ -43,6 +87,37 @@ type MatchPlayer struct {
 	DamageDealt int64     `json:"damage_dealt"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	Acs         float64   `json:"acs"`
+	Adr         float64   `json:"adr"`
+	Kast        *float64  `json:"kast"`
+	FirstBloods *int64    `json:"first_bloods"`
+	FirstDeaths *int64    `json:"first_deaths"`
+	PlusMinus   int64     `json:"plus_minus"`
+	Rating      *float64  `json:"rating"`
+	PartyID     string    `json:"party_id"`
+}
+
+type MatchPlayerRound struct {
+	MatchID     string `json:"match_id"`
+	RoundNumber int64  `json:"round_number"`
+	Puuid       string `json:"puuid"`
+	WeaponID    string `json:"weapon_id"`
+	WeaponName  string `json:"weapon_name"`
+	Headshots   int64  `json:"headshots"`
+	Bodyshots   int64  `json:"bodyshots"`
+	Legshots    int64  `json:"legshots"`
+	Damage      int64  `json:"damage"`
+}
+
+type MatchRound struct {
+	MatchID       string `json:"match_id"`
+	RoundNumber   int64  `json:"round_number"`
+	WinningTeam   string `json:"winning_team"`
+	AttackingTeam string `json:"attacking_team"`
+	EndType       string `json:"end_type"`
+	BombPlanted   bool   `json:"bomb_planted"`
+	BombDefused   bool   `json:"bomb_defused"`
+	PlantSite     string `json:"plant_site"`
 }
 
 type MmrHistory struct {
@@ -76,3 +151,46 @@ type Player struct {
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 }
+
+type PlayerGroup struct {
+	GroupName string    `json:"group_name"`
+	Puuid     string    `json:"puuid"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TrackedPlayer struct {
+	Puuid                  string     `json:"puuid"`
+	Source                 string     `json:"source"`
+	RefreshIntervalSeconds int64      `json:"refresh_interval_seconds"`
+	NextRefreshAt          time.Time  `json:"next_refresh_at"`
+	LastRefreshAt          *time.Time `json:"last_refresh_at"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int64      `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastStatusCode *int64     `json:"last_status_code"`
+	LastError      *string    `json:"last_error"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type WebhookSubscription struct {
+	ID          string    `json:"id"`
+	Url         string    `json:"url"`
+	Secret      string    `json:"secret"`
+	EventTypes  string    `json:"event_types"`
+	Puuid       *string   `json:"puuid"`
+	RrThreshold *int64    `json:"rr_threshold"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
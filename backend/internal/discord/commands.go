@@ -0,0 +1,262 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+	"valorant-tracker/internal/service"
+)
+
+const (
+	colorDefault = 0xff4655
+	colorWin     = 0x2ecc71
+	colorLoss    = 0xe74c3c
+)
+
+type command struct {
+	Command
+	render func(ids []riotID, profiles []*profile) *MessageData
+}
+
+func playerOption(name, description string) CommandOption {
+	return CommandOption{Type: OptionTypeString, Name: name, Description: description, Required: true}
+}
+
+var commandOrder = []string{"rank", "last", "compare"}
+
+var commands = map[string]command{
+	"rank": {
+		Command: Command{
+			Name:        "rank",
+			Description: "Show a player's current rank and recent form",
+			Options:     []CommandOption{playerOption("player", "Riot ID, e.g. name#tag")},
+		},
+		render: renderRank,
+	},
+	"last": {
+		Command: Command{
+			Name:        "last",
+			Description: "Show a player's last match",
+			Options:     []CommandOption{playerOption("player", "Riot ID, e.g. name#tag")},
+		},
+		render: renderLast,
+	},
+	"compare": {
+		Command: Command{
+			Name:        "compare",
+			Description: "Compare two players side by side",
+			Options: []CommandOption{
+				playerOption("player1", "First Riot ID"),
+				playerOption("player2", "Second Riot ID"),
+			},
+		},
+		render: renderCompare,
+	},
+}
+
+type riotID struct {
+	Name string
+	Tag  string
+}
+
+func (id riotID) String() string {
+	return id.Name + "#" + id.Tag
+}
+
+func parseRiotID(s string) (riotID, error) {
+	i := strings.LastIndex(s, "#")
+	if i <= 0 || i == len(s)-1 {
+		return riotID{}, fmt.Errorf("%q is not a Riot ID, use name#tag", s)
+	}
+	return riotID{Name: strings.TrimSpace(s[:i]), Tag: strings.TrimSpace(s[i+1:])}, nil
+}
+
+type profile struct {
+	player  *domain.Player
+	matches []repository.MatchWithPlayers
+}
+
+// cachedProfiles loads every player from the database only, ok is false as soon as one of
+// them would need HDev.
+func (h *Handler) cachedProfiles(ctx context.Context, ids []riotID) ([]*profile, bool) {
+	profiles := make([]*profile, len(ids))
+	for i, id := range ids {
+		player := h.playerSvc.GetCachedPlayer(ctx, id.Name, id.Tag)
+		if player == nil {
+			return nil, false
+		}
+		matches, err := h.matchSvc.GetCachedMatches(ctx, player.Puuid)
+		if err != nil {
+			return nil, false
+		}
+		profiles[i] = &profile{player: player, matches: matches}
+	}
+	return profiles, true
+}
+
+// loadProfiles loads every player through the services, refreshing from HDev where needed.
+// On failure it returns the message to answer with instead.
+func (h *Handler) loadProfiles(ctx context.Context, ids []riotID) ([]*profile, *MessageData) {
+	profiles := make([]*profile, len(ids))
+	for i, id := range ids {
+		player, err := h.playerSvc.GetPlayerByRiotID(ctx, id.Name, id.Tag, false)
+		if err != nil {
+			return nil, h.failure(id, err)
+		}
+		matches, err := h.matchSvc.GetMatchesFor(ctx, player.Puuid, false)
+		if err != nil {
+			return nil, h.failure(id, err)
+		}
+		profiles[i] = &profile{player: player, matches: matches}
+	}
+	return profiles, nil
+}
+
+func (h *Handler) failure(id riotID, err error) *MessageData {
+	h.logger.Warn().Err(err).Str("riot_id", id.String()).Msg("command lookup failed")
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &MessageData{Content: "The tracker took too long to answer, try again in a moment."}
+	}
+	return &MessageData{Content: fmt.Sprintf("Couldn't load **%s**.", id)}
+}
+
+func renderRank(ids []riotID, profiles []*profile) *MessageData {
+	p := profiles[0]
+
+	embed := Embed{
+		Title:     p.player.Name + "#" + p.player.Tag,
+		Color:     colorDefault,
+		Thumbnail: cardThumbnail(p.player.Card),
+		Fields:    profileFields(p, true),
+		Footer:    &EmbedFooter{Text: fmt.Sprintf("Level %d · %s", p.player.AccountLevel, strings.ToUpper(p.player.Region))},
+	}
+	return &MessageData{Embeds: []Embed{embed}}
+}
+
+func renderLast(ids []riotID, profiles []*profile) *MessageData {
+	p := profiles[0]
+	if len(p.matches) == 0 {
+		return &MessageData{Content: fmt.Sprintf("No matches stored for **%s** yet.", ids[0])}
+	}
+
+	m := p.matches[0]
+	stats := m.PlayerStats
+
+	result, color := "Defeat", colorLoss
+	if stats.HasWon {
+		result, color = "Victory", colorWin
+	}
+	own, other := m.Match.TeamRedScore, m.Match.TeamBlueScore
+	if stats.Team == "Blue" {
+		own, other = other, own
+	}
+
+	fields := []EmbedField{
+		{Name: "Agent", Value: orDash(service.AgentName(stats.CharacterID)), Inline: true},
+		{Name: "K / D / A", Value: fmt.Sprintf("%d / %d / %d", stats.Kills, stats.Deaths, stats.Assists), Inline: true},
+		{Name: "Score", Value: fmt.Sprintf("%d", stats.Score), Inline: true},
+		{Name: "Damage", Value: fmt.Sprintf("%d dealt · %d taken", stats.DamageDealt, stats.DamageTaken), Inline: true},
+	}
+	if m.MMRData != nil {
+		fields = append(fields, EmbedField{Name: "RR", Value: fmt.Sprintf("%+d → %s %d", m.MMRData.MMRChange, m.MMRData.TierName, m.MMRData.RankingInTier), Inline: true})
+	}
+
+	embed := Embed{
+		Title:       fmt.Sprintf("%s %d-%d on %s", result, own, other, m.Match.MapName),
+		Description: fmt.Sprintf("**%s#%s** · %s", p.player.Name, p.player.Tag, m.Match.Mode),
+		Color:       color,
+		Thumbnail:   agentThumbnail(stats.CharacterID),
+		Fields:      fields,
+		Timestamp:   m.Match.StartedAt.Format(time.RFC3339),
+	}
+	return &MessageData{Embeds: []Embed{embed}}
+}
+
+func renderCompare(ids []riotID, profiles []*profile) *MessageData {
+	embed := Embed{
+		Title: fmt.Sprintf("%s vs %s", ids[0], ids[1]),
+		Color: colorDefault,
+	}
+
+	for _, p := range profiles {
+		var lines []string
+		for _, f := range profileFields(p, false) {
+			lines = append(lines, fmt.Sprintf("**%s:** %s", f.Name, f.Value))
+		}
+		embed.Fields = append(embed.Fields, EmbedField{
+			Name:   p.player.Name + "#" + p.player.Tag,
+			Value:  strings.Join(lines, "\n"),
+			Inline: true,
+		})
+	}
+	return &MessageData{Embeds: []Embed{embed}}
+}
+
+func profileFields(p *profile, inline bool) []EmbedField {
+	var kills, deaths, wins int
+	for _, m := range p.matches {
+		kills += m.PlayerStats.Kills
+		deaths += m.PlayerStats.Deaths
+		if m.PlayerStats.HasWon {
+			wins++
+		}
+	}
+
+	kd := float64(kills)
+	if deaths > 0 {
+		kd = float64(kills) / float64(deaths)
+	}
+	winRate := 0.0
+	if len(p.matches) > 0 {
+		winRate = float64(wins) / float64(len(p.matches)) * 100
+	}
+
+	return []EmbedField{
+		{Name: "Rank", Value: fmt.Sprintf("%s · %d RR", orDash(p.player.CurrentTierName), p.player.CurrentRR), Inline: inline},
+		{Name: "K/D", Value: fmt.Sprintf("%.2f", kd), Inline: inline},
+		{Name: "Win rate", Value: fmt.Sprintf("%.0f%% of %d", winRate, len(p.matches)), Inline: inline},
+		{Name: "Form", Value: recentForm(p.matches), Inline: inline},
+	}
+}
+
+// recentForm renders the latest results as W/L, newest first.
+func recentForm(matches []repository.MatchWithPlayers) string {
+	var b strings.Builder
+	for i, m := range matches {
+		if i == constants.DiscordRecentForm {
+			break
+		}
+		if m.PlayerStats.HasWon {
+			b.WriteString("W ")
+		} else {
+			b.WriteString("L ")
+		}
+	}
+	return orDash(strings.TrimSpace(b.String()))
+}
+
+func cardThumbnail(cardID string) *EmbedThumbnail {
+	if cardID == "" {
+		return nil
+	}
+	return &EmbedThumbnail{URL: "https://media.valorant-api.com/playercards/" + cardID + "/smallart.png"}
+}
+
+func agentThumbnail(characterID string) *EmbedThumbnail {
+	if characterID == "" {
+		return nil
+	}
+	return &EmbedThumbnail{URL: "https://media.valorant-api.com/agents/" + characterID + "/displayicon.png"}
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,270 @@
+package discord
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+	"valorant-tracker/internal/config"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+	"valorant-tracker/internal/service"
+
+	"github.com/rs/zerolog"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/fx"
+)
+
+const defaultAPIBase = "https://discord.com/api/v10"
+
+// PlayerService is the part of service.PlayerService the commands use.
+type PlayerService interface {
+	GetCachedPlayer(ctx context.Context, name, tag string) *domain.Player
+	GetPlayerByRiotID(ctx context.Context, name, tag string, refresh bool) (*domain.Player, error)
+}
+
+// MatchService is the part of service.MatchService the commands use.
+type MatchService interface {
+	GetCachedMatches(ctx context.Context, puuid string) ([]repository.MatchWithPlayers, error)
+	GetMatchesFor(ctx context.Context, puuid string, refresh bool) ([]repository.MatchWithPlayers, error)
+}
+
+// Handler serves Discord's HTTP interactions endpoint. Commands whose players are all cached
+// reply inline; anything that could need HDev is deferred and finished with a follow-up edit.
+type Handler struct {
+	cfg       *config.Config
+	publicKey ed25519.PublicKey
+	playerSvc PlayerService
+	matchSvc  MatchService
+	client    *fasthttp.Client
+	apiBase   string
+	logger    zerolog.Logger
+
+	// deferred commands outlive their request, shutdown waits for them
+	baseCtx context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewHandler(lc fx.Lifecycle, cfg *config.Config, playerSvc *service.PlayerService, matchSvc *service.MatchService, logger zerolog.Logger) (*Handler, error) {
+	h, err := newHandler(cfg, playerSvc, matchSvc, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			if cfg.DiscordApplicationID != "" && cfg.DiscordBotToken != "" {
+				h.wg.Add(1)
+				go func() {
+					defer h.wg.Done()
+					h.registerCommands(h.baseCtx)
+				}()
+			}
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			h.cancel()
+			done := make(chan struct{})
+			go func() {
+				h.wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return h, nil
+}
+
+func newHandler(cfg *config.Config, playerSvc PlayerService, matchSvc MatchService, logger zerolog.Logger) (*Handler, error) {
+	var publicKey ed25519.PublicKey
+	if cfg.DiscordPublicKey != "" {
+		key, err := hex.DecodeString(cfg.DiscordPublicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("DISCORD_PUBLIC_KEY must be a hex encoded ed25519 public key")
+		}
+		publicKey = key
+	}
+
+	h := &Handler{
+		cfg:       cfg,
+		publicKey: publicKey,
+		playerSvc: playerSvc,
+		matchSvc:  matchSvc,
+		client: &fasthttp.Client{
+			ReadTimeout:         constants.DiscordFollowupTimeout,
+			WriteTimeout:        constants.DiscordFollowupTimeout,
+			MaxIdleConnDuration: 1 * time.Minute,
+		},
+		apiBase: defaultAPIBase,
+		logger:  logger.With().Str("component", "discord").Logger(),
+	}
+	h.baseCtx, h.cancel = context.WithCancel(context.Background())
+	return h, nil
+}
+
+// Enabled reports whether a public key is configured, without one no request can be verified.
+func (h *Handler) Enabled() bool {
+	return h.publicKey != nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, constants.DiscordMaxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if !h.Enabled() || !Verify(h.publicKey, r.Header.Get("X-Signature-Ed25519"), r.Header.Get("X-Signature-Timestamp"), body) {
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+		return
+	}
+
+	var interaction Interaction
+	if err := json.Unmarshal(body, &interaction); err != nil {
+		http.Error(w, "invalid interaction", http.StatusBadRequest)
+		return
+	}
+
+	switch interaction.Type {
+	case InteractionTypePing:
+		h.respond(w, InteractionResponse{Type: ResponseTypePong})
+	case InteractionTypeApplicationCommand:
+		h.handleCommand(r.Context(), w, interaction)
+	default:
+		http.Error(w, "unsupported interaction type", http.StatusBadRequest)
+	}
+}
+
+func (h *Handler) handleCommand(ctx context.Context, w http.ResponseWriter, interaction Interaction) {
+	cmd, ok := commands[interaction.Data.Name]
+	if !ok {
+		h.respond(w, ephemeral("Unknown command."))
+		return
+	}
+
+	var ids []riotID
+	for _, opt := range cmd.Options {
+		id, err := parseRiotID(interaction.Data.StringOption(opt.Name))
+		if err != nil {
+			h.respond(w, ephemeral(err.Error()))
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	log := h.logger.With().Str("command", cmd.Name).Str("interaction_id", interaction.ID).Logger()
+
+	// only answer inline from the database, a lookup that could reach HDev risks Discord's 3s window
+	cacheCtx, cancel := context.WithTimeout(ctx, constants.DiscordResponseDeadline)
+	profiles, ok := h.cachedProfiles(cacheCtx, ids)
+	cancel()
+	if ok {
+		h.respond(w, InteractionResponse{Type: ResponseTypeChannelMessageWithSource, Data: cmd.render(ids, profiles)})
+		log.Debug().Msg("command answered inline")
+		return
+	}
+
+	h.respond(w, InteractionResponse{Type: ResponseTypeDeferredChannelMessageWithSource})
+
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+
+		runCtx, cancel := context.WithTimeout(h.baseCtx, constants.RequestTimeout)
+		defer cancel()
+
+		var msg *MessageData
+		if profiles, failure := h.loadProfiles(runCtx, ids); failure != nil {
+			msg = failure
+		} else {
+			msg = cmd.render(ids, profiles)
+		}
+		if err := h.editOriginal(runCtx, interaction, msg); err != nil {
+			log.Error().Err(err).Msg("failed to send deferred command response")
+			return
+		}
+		log.Debug().Msg("deferred command answered")
+	}()
+}
+
+func (h *Handler) respond(w http.ResponseWriter, resp InteractionResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error().Err(err).Msg("failed to write interaction response")
+	}
+}
+
+func ephemeral(content string) InteractionResponse {
+	return InteractionResponse{
+		Type: ResponseTypeChannelMessageWithSource,
+		Data: &MessageData{Content: content, Flags: MessageFlagEphemeral},
+	}
+}
+
+func (h *Handler) editOriginal(ctx context.Context, interaction Interaction, msg *MessageData) error {
+	url := fmt.Sprintf("%s/webhooks/%s/%s/messages/@original", h.apiBase, interaction.ApplicationID, interaction.Token)
+	return h.send(ctx, fasthttp.MethodPatch, url, "", msg)
+}
+
+func (h *Handler) registerCommands(ctx context.Context) {
+	defs := make([]Command, 0, len(commands))
+	for _, cmd := range commandOrder {
+		defs = append(defs, commands[cmd].Command)
+	}
+
+	url := fmt.Sprintf("%s/applications/%s/commands", h.apiBase, h.cfg.DiscordApplicationID)
+	if err := h.send(ctx, fasthttp.MethodPut, url, "Bot "+h.cfg.DiscordBotToken, defs); err != nil {
+		h.logger.Error().Err(err).Msg("failed to register slash commands")
+		return
+	}
+	h.logger.Info().Int("count", len(defs)).Msg("slash commands registered")
+}
+
+func (h *Handler) send(ctx context.Context, method, url, authorization string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode body: %w", err)
+	}
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(url)
+	req.Header.SetMethod(method)
+	req.Header.SetContentType("application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	req.SetBody(payload)
+
+	timeout := constants.DiscordFollowupTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = min(timeout, time.Until(deadline))
+	}
+	if err := h.client.DoTimeout(req, resp, timeout); err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	if code := resp.StatusCode(); code < 200 || code >= 300 {
+		return fmt.Errorf("unexpected status %d: %s", code, resp.Body())
+	}
+	return nil
+}
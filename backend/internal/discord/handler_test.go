@@ -0,0 +1,228 @@
+package discord
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"valorant-tracker/internal/config"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+const fixtureTimestamp = "1700000000"
+
+type fakePlayers struct {
+	cached  map[string]*domain.Player
+	players map[string]*domain.Player
+
+	mu      sync.Mutex
+	fetched []string
+}
+
+func (f *fakePlayers) GetCachedPlayer(_ context.Context, name, tag string) *domain.Player {
+	return f.cached[name+"#"+tag]
+}
+
+func (f *fakePlayers) GetPlayerByRiotID(_ context.Context, name, tag string, _ bool) (*domain.Player, error) {
+	f.mu.Lock()
+	f.fetched = append(f.fetched, name+"#"+tag)
+	f.mu.Unlock()
+
+	if p, ok := f.players[name+"#"+tag]; ok {
+		return p, nil
+	}
+	return nil, errors.New("not found")
+}
+
+type fakeMatches struct {
+	matches map[string][]repository.MatchWithPlayers
+}
+
+func (f *fakeMatches) GetCachedMatches(_ context.Context, puuid string) ([]repository.MatchWithPlayers, error) {
+	return f.matches[puuid], nil
+}
+
+func (f *fakeMatches) GetMatchesFor(_ context.Context, puuid string, _ bool) ([]repository.MatchWithPlayers, error) {
+	return f.matches[puuid], nil
+}
+
+func newTestHandler(t *testing.T, players *fakePlayers, matches *fakeMatches) (*Handler, ed25519.PrivateKey) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	cfg := &config.Config{DiscordPublicKey: hex.EncodeToString(pub)}
+
+	h, err := newHandler(cfg, players, matches, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("failed to create handler: %v", err)
+	}
+	t.Cleanup(h.cancel)
+	return h, priv
+}
+
+func signedRequest(priv ed25519.PrivateKey, timestamp, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/discord/interactions", strings.NewReader(body))
+	req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(priv, []byte(timestamp+body))))
+	req.Header.Set("X-Signature-Timestamp", timestamp)
+	return req
+}
+
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder) InteractionResponse {
+	t.Helper()
+
+	var resp InteractionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response %q: %v", rec.Body.String(), err)
+	}
+	return resp
+}
+
+func rankCommand(player string) string {
+	return `{"id":"1","application_id":"app","type":2,"token":"tok","data":{"name":"rank","options":[{"name":"player","type":3,"value":"` + player + `"}]}}`
+}
+
+func TestServeHTTPPing(t *testing.T) {
+	h, priv := newTestHandler(t, &fakePlayers{}, &fakeMatches{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(priv, fixtureTimestamp, `{"id":"1","type":1}`))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if resp := decodeResponse(t, rec); resp.Type != ResponseTypePong {
+		t.Errorf("response type = %d, want %d", resp.Type, ResponseTypePong)
+	}
+}
+
+func TestServeHTTPRejectsBadSignature(t *testing.T) {
+	h, priv := newTestHandler(t, &fakePlayers{}, &fakeMatches{})
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	body := `{"id":"1","type":1}`
+	tests := []struct {
+		name string
+		req  func() *http.Request
+	}{
+		{"wrong key", func() *http.Request {
+			return signedRequest(otherKey, fixtureTimestamp, body)
+		}},
+		{"tampered body", func() *http.Request {
+			req := signedRequest(priv, fixtureTimestamp, body)
+			req.Body = io.NopCloser(strings.NewReader(`{"id":"2","type":1}`))
+			return req
+		}},
+		{"tampered timestamp", func() *http.Request {
+			req := signedRequest(priv, fixtureTimestamp, body)
+			req.Header.Set("X-Signature-Timestamp", "1700000001")
+			return req
+		}},
+		{"malformed signature", func() *http.Request {
+			req := signedRequest(priv, fixtureTimestamp, body)
+			req.Header.Set("X-Signature-Ed25519", "not-hex")
+			return req
+		}},
+		{"missing headers", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/discord/interactions", strings.NewReader(body))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.req())
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
+
+func TestServeHTTPCommandAnsweredInlineFromCache(t *testing.T) {
+	alice := &domain.Player{Puuid: "p1", Name: "Alice", Tag: "EUW", CurrentTierName: "Gold 2", CurrentRR: 40}
+	players := &fakePlayers{cached: map[string]*domain.Player{"Alice#EUW": alice}}
+	matches := &fakeMatches{matches: map[string][]repository.MatchWithPlayers{
+		"p1": {{PlayerStats: domain.MatchPlayer{Kills: 20, Deaths: 10, HasWon: true}}},
+	}}
+	h, priv := newTestHandler(t, players, matches)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(priv, fixtureTimestamp, rankCommand("Alice#EUW")))
+
+	resp := decodeResponse(t, rec)
+	if resp.Type != ResponseTypeChannelMessageWithSource {
+		t.Fatalf("response type = %d, want %d", resp.Type, ResponseTypeChannelMessageWithSource)
+	}
+	if resp.Data == nil || len(resp.Data.Embeds) != 1 || resp.Data.Embeds[0].Title != "Alice#EUW" {
+		t.Fatalf("unexpected response data: %+v", resp.Data)
+	}
+	if len(players.fetched) != 0 {
+		t.Errorf("inline answer went through the services: %v", players.fetched)
+	}
+}
+
+func TestServeHTTPCommandDeferredWhenNotCached(t *testing.T) {
+	var mu sync.Mutex
+	var method, path string
+	var edit MessageData
+	discordAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		method, path = r.Method, r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&edit); err != nil {
+			t.Errorf("failed to decode follow-up: %v", err)
+		}
+	}))
+	defer discordAPI.Close()
+
+	bob := &domain.Player{Puuid: "p2", Name: "Bob", Tag: "NA1"}
+	players := &fakePlayers{players: map[string]*domain.Player{"Bob#NA1": bob}}
+	h, priv := newTestHandler(t, players, &fakeMatches{})
+	h.apiBase = discordAPI.URL
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(priv, fixtureTimestamp, rankCommand("Bob#NA1")))
+
+	if resp := decodeResponse(t, rec); resp.Type != ResponseTypeDeferredChannelMessageWithSource {
+		t.Fatalf("response type = %d, want %d", resp.Type, ResponseTypeDeferredChannelMessageWithSource)
+	}
+
+	h.wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if method != http.MethodPatch || path != "/webhooks/app/tok/messages/@original" {
+		t.Errorf("follow-up went to %s %s", method, path)
+	}
+	if len(edit.Embeds) != 1 || edit.Embeds[0].Title != "Bob#NA1" {
+		t.Errorf("unexpected follow-up: %+v", edit)
+	}
+}
+
+func TestServeHTTPInvalidRiotID(t *testing.T) {
+	h, priv := newTestHandler(t, &fakePlayers{}, &fakeMatches{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(priv, fixtureTimestamp, rankCommand("no-tag")))
+
+	resp := decodeResponse(t, rec)
+	if resp.Type != ResponseTypeChannelMessageWithSource || resp.Data == nil || resp.Data.Flags != MessageFlagEphemeral {
+		t.Errorf("expected an ephemeral error, got %+v", resp)
+	}
+}
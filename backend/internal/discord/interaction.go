@@ -0,0 +1,112 @@
+package discord
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+)
+
+// https://discord.com/developers/docs/interactions/receiving-and-responding
+const (
+	InteractionTypePing               = 1
+	InteractionTypeApplicationCommand = 2
+
+	ResponseTypePong                             = 1
+	ResponseTypeChannelMessageWithSource         = 4
+	ResponseTypeDeferredChannelMessageWithSource = 5
+
+	MessageFlagEphemeral = 1 << 6
+
+	OptionTypeString = 3
+)
+
+type Interaction struct {
+	ID            string          `json:"id"`
+	ApplicationID string          `json:"application_id"`
+	Type          int             `json:"type"`
+	Token         string          `json:"token"`
+	Data          InteractionData `json:"data"`
+}
+
+type InteractionData struct {
+	Name    string              `json:"name"`
+	Options []InteractionOption `json:"options"`
+}
+
+type InteractionOption struct {
+	Name  string `json:"name"`
+	Type  int    `json:"type"`
+	Value any    `json:"value"`
+}
+
+func (d InteractionData) StringOption(name string) string {
+	for _, o := range d.Options {
+		if o.Name == name {
+			if v, ok := o.Value.(string); ok {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+type InteractionResponse struct {
+	Type int          `json:"type"`
+	Data *MessageData `json:"data,omitempty"`
+}
+
+type MessageData struct {
+	Content string  `json:"content,omitempty"`
+	Embeds  []Embed `json:"embeds,omitempty"`
+	Flags   int     `json:"flags,omitempty"`
+}
+
+type Embed struct {
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Color       int             `json:"color,omitempty"`
+	Fields      []EmbedField    `json:"fields,omitempty"`
+	Thumbnail   *EmbedThumbnail `json:"thumbnail,omitempty"`
+	Footer      *EmbedFooter    `json:"footer,omitempty"`
+	Timestamp   string          `json:"timestamp,omitempty"`
+}
+
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+type EmbedThumbnail struct {
+	URL string `json:"url"`
+}
+
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type Command struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Options     []CommandOption `json:"options,omitempty"`
+}
+
+type CommandOption struct {
+	Type        int    `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// Verify checks Discord's ed25519 signature over timestamp+body. signature is the hex
+// X-Signature-Ed25519 header and timestamp the X-Signature-Timestamp header.
+func Verify(publicKey ed25519.PublicKey, signature, timestamp string, body []byte) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return false
+	}
+
+	msg := make([]byte, 0, len(timestamp)+len(body))
+	msg = append(msg, timestamp...)
+	msg = append(msg, body...)
+	return ed25519.Verify(publicKey, msg, sig)
+}
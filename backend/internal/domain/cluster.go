@@ -0,0 +1,77 @@
+package domain
+
+// ClusterStats aggregates a player's games on one server cluster.
+type ClusterStats struct {
+	Cluster     string
+	Games       int
+	Wins        int
+	Draws       int
+	Kills       int
+	Deaths      int
+	DamageDealt int
+	Score       int
+	Rounds      int
+	RRChange    int // summed over RatedGames
+	RatedGames  int
+	RatingSum   float64
+	Rated       int
+}
+
+func (s ClusterStats) Losses() int {
+	return s.Games - s.Wins - s.Draws
+}
+
+func (s ClusterStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s ClusterStats) KD() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills)
+	}
+	return float64(s.Kills) / float64(s.Deaths)
+}
+
+func (s ClusterStats) AvgRRChange() (float64, bool) {
+	if s.RatedGames == 0 {
+		return 0, false
+	}
+	return float64(s.RRChange) / float64(s.RatedGames), true
+}
+
+func (s ClusterStats) AvgRating() (float64, bool) {
+	if s.Rated == 0 {
+		return 0, false
+	}
+	return s.RatingSum / float64(s.Rated), true
+}
+
+// ClusterShare is how many of every stored match were played on a cluster.
+type ClusterShare struct {
+	Cluster      string
+	Region       string
+	Matches      int
+	Players      int // distinct stored players
+	PlayerGames  int
+	PlayerRounds int // rounds summed over PlayerGames
+	RatingSum    float64
+	Rated        int
+}
+
+// AvgRounds is the average match length in rounds.
+func (s ClusterShare) AvgRounds() float64 {
+	if s.PlayerGames == 0 {
+		return 0
+	}
+	return float64(s.PlayerRounds) / float64(s.PlayerGames)
+}
+
+func (s ClusterShare) AvgRating() (float64, bool) {
+	if s.Rated == 0 {
+		return 0, false
+	}
+	return s.RatingSum / float64(s.Rated), true
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// PlayerRef names a player either by puuid or by Riot ID.
+type PlayerRef struct {
+	Puuid string
+	Name  string
+	Tag   string
+}
+
+type RankPoint struct {
+	MatchID       string
+	Date          time.Time
+	Tier          int
+	TierName      string
+	RankingInTier int
+	MMRChange     int
+}
+
+// UsageOverlap is an agent or map played by more than one compared player. Games and Wins
+// are indexed like the compared players.
+type UsageOverlap struct {
+	ID    string
+	Name  string
+	Games []int
+	Wins  []int
+}
+
+func (o UsageOverlap) TotalGames() int {
+	var total int
+	for _, g := range o.Games {
+		total += g
+	}
+	return total
+}
+
+// HeadToHead is the shared-match record of two compared players, wins counted for A.
+type HeadToHead struct {
+	PuuidA       string
+	PuuidB       string
+	GamesWith    int
+	WinsWith     int
+	GamesAgainst int
+	WinsAgainst  int
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// Encounter sums up the matches a player shared with someone else. Wins are from the player's side.
+type Encounter struct {
+	Puuid          string
+	Name           string
+	Tag            string
+	GamesWith      int
+	WinsWith       int
+	GamesAgainst   int
+	WinsAgainst    int
+	LastMatchID    string
+	LastPlayedAt   time.Time
+	LastAsTeammate bool
+}
+
+func (e Encounter) Games() int {
+	return e.GamesWith + e.GamesAgainst
+}
+
+func (e Encounter) WinRateWith() float64 {
+	if e.GamesWith == 0 {
+		return 0
+	}
+	return float64(e.WinsWith) / float64(e.GamesWith)
+}
+
+func (e Encounter) WinRateAgainst() float64 {
+	if e.GamesAgainst == 0 {
+		return 0
+	}
+	return float64(e.WinsAgainst) / float64(e.GamesAgainst)
+}
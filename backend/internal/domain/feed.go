@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type FeedItemKind string
+
+const (
+	FeedItemMatch      FeedItemKind = "match"
+	FeedItemRankChange FeedItemKind = "rank_change"
+	FeedItemMilestone  FeedItemKind = "milestone"
+)
+
+type FeedItem struct {
+	ID         string // stable across pages
+	Kind       FeedItemKind
+	OccurredAt time.Time
+	Player     Player
+	Match      *Match
+	Stats      *MatchPlayer
+	MMR        *MMRHistory
+
+	// rank changes
+	PreviousTier     int
+	PreviousTierName string
+
+	// milestones
+	Milestone string
+}
@@ -0,0 +1,98 @@
+package domain
+
+import "time"
+
+// MatchTime is one of the player's matches with what the heatmap aggregates.
+type MatchTime struct {
+	StartedAt time.Time
+	Outcome   Outcome
+	Kills     int
+	Deaths    int
+	Score     int
+	Rounds    int
+	Rating    *float64
+	RRChange  *int // nil when the match has no RR record
+}
+
+type HeatmapBucket struct {
+	Games     int
+	Wins      int
+	Kills     int
+	Deaths    int
+	Score     int
+	Rounds    int
+	RRSum     int
+	RRGames   int
+	RatingSum float64
+	Rated     int
+}
+
+func (b *HeatmapBucket) Add(m MatchTime) {
+	b.Games++
+	if m.Outcome == OutcomeWin {
+		b.Wins++
+	}
+	b.Kills += m.Kills
+	b.Deaths += m.Deaths
+	b.Score += m.Score
+	b.Rounds += m.Rounds
+	if m.RRChange != nil {
+		b.RRSum += *m.RRChange
+		b.RRGames++
+	}
+	if m.Rating != nil {
+		b.RatingSum += *m.Rating
+		b.Rated++
+	}
+}
+
+func (b HeatmapBucket) WinRate() float64 {
+	if b.Games == 0 {
+		return 0
+	}
+	return float64(b.Wins) / float64(b.Games)
+}
+
+func (b HeatmapBucket) KD() float64 {
+	if b.Deaths == 0 {
+		return float64(b.Kills)
+	}
+	return float64(b.Kills) / float64(b.Deaths)
+}
+
+func (b HeatmapBucket) AvgRRChange() (float64, bool) {
+	if b.RRGames == 0 {
+		return 0, false
+	}
+	return float64(b.RRSum) / float64(b.RRGames), true
+}
+
+func (b HeatmapBucket) AvgRating() (float64, bool) {
+	if b.Rated == 0 {
+		return 0, false
+	}
+	return b.RatingSum / float64(b.Rated), true
+}
+
+// Heatmap buckets matches by their local start time. Weekdays are indexed like time.Weekday,
+// Sunday first.
+type Heatmap struct {
+	Location *time.Location
+	Overall  HeatmapBucket
+	Hours    [24]HeatmapBucket
+	Weekdays [7]HeatmapBucket
+	Cells    [7][24]HeatmapBucket
+}
+
+func NewHeatmap(loc *time.Location, matches []MatchTime) Heatmap {
+	heatmap := Heatmap{Location: loc}
+	for _, m := range matches {
+		local := m.StartedAt.In(loc)
+		hour, day := local.Hour(), local.Weekday()
+		heatmap.Overall.Add(m)
+		heatmap.Hours[hour].Add(m)
+		heatmap.Weekdays[day].Add(m)
+		heatmap.Cells[day][hour].Add(m)
+	}
+	return heatmap
+}
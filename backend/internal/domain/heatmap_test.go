@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestNewHeatmapAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	at := func(value string) time.Time {
+		ts, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", value, err)
+		}
+		return ts
+	}
+
+	rr := 18
+	matches := []MatchTime{
+		// CET, UTC+1
+		{StartedAt: at("2024-03-30T20:30:00Z"), Outcome: OutcomeWin, RRChange: &rr},
+		// CEST from 2024-03-31 01:00 UTC, the same UTC hour is an hour later locally
+		{StartedAt: at("2024-03-31T20:30:00Z"), Outcome: OutcomeLoss},
+		// back to CET on 2024-10-27, late UTC evening is already Monday
+		{StartedAt: at("2024-10-27T23:30:00Z"), Outcome: OutcomeWin},
+	}
+
+	heatmap := NewHeatmap(berlin, matches)
+
+	tests := []struct {
+		name   string
+		bucket HeatmapBucket
+		games  int
+		wins   int
+	}{
+		{"saturday 21h", heatmap.Cells[time.Saturday][21], 1, 1},
+		{"sunday 22h", heatmap.Cells[time.Sunday][22], 1, 0},
+		{"sunday 21h", heatmap.Cells[time.Sunday][21], 0, 0},
+		{"monday 0h", heatmap.Cells[time.Monday][0], 1, 1},
+		{"hour 20", heatmap.Hours[20], 0, 0},
+		{"hour 21", heatmap.Hours[21], 1, 1},
+		{"sunday", heatmap.Weekdays[time.Sunday], 1, 0},
+		{"overall", heatmap.Overall, 3, 2},
+	}
+
+	for _, tt := range tests {
+		if tt.bucket.Games != tt.games || tt.bucket.Wins != tt.wins {
+			t.Errorf("%s: %d games, %d wins, want %d, %d", tt.name, tt.bucket.Games, tt.bucket.Wins, tt.games, tt.wins)
+		}
+	}
+
+	if avg, ok := heatmap.Overall.AvgRRChange(); !ok || avg != 18 {
+		t.Errorf("overall rr change = %v (%v), want 18", avg, ok)
+	}
+}
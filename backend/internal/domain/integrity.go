@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type IntegrityIssueKind string
+
+const (
+	IssueIncompleteLobby    IntegrityIssueKind = "incomplete_lobby"
+	IssueOversizedLobby     IntegrityIssueKind = "oversized_lobby"
+	IssueOrphanedMMRHistory IntegrityIssueKind = "orphaned_mmr_history"
+	IssueStalePartialPlayer IntegrityIssueKind = "stale_partial_player"
+	IssueOutcomeMismatch    IntegrityIssueKind = "outcome_mismatch"
+)
+
+type IntegrityIssue struct {
+	Kind     IntegrityIssueKind
+	MatchID  string
+	Puuid    string
+	Detail   string
+	Repaired bool
+	Deferred bool // skipped because the HDev budget ran out
+}
+
+type IntegrityReport struct {
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	MatchesScanned int
+	Issues         []IntegrityIssue
+}
+
+func (r *IntegrityReport) Repaired() int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Repaired {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *IntegrityReport) Deferred() int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Deferred {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,57 @@
+package domain
+
+type LeaderboardMetric string
+
+const (
+	LeaderboardRR      LeaderboardMetric = "rr" // current tier, then RR within it
+	LeaderboardWinRate LeaderboardMetric = "win_rate"
+	LeaderboardKD      LeaderboardMetric = "kd"
+	LeaderboardRating  LeaderboardMetric = "rating"
+	LeaderboardGames   LeaderboardMetric = "games"
+)
+
+func (m LeaderboardMetric) Valid() bool {
+	switch m {
+	case LeaderboardRR, LeaderboardWinRate, LeaderboardKD, LeaderboardRating, LeaderboardGames:
+		return true
+	}
+	return false
+}
+
+// LeaderboardFilter narrows the matches and players ranked. Empty fields don't filter.
+type LeaderboardFilter struct {
+	Region   string
+	SeasonID string
+	Group    string
+	MinGames int
+}
+
+type LeaderboardEntry struct {
+	Rank      int
+	Puuid     string
+	Name      string
+	Tag       string
+	Region    string
+	Tier      int
+	TierName  string
+	RR        int
+	Games     int
+	Wins      int
+	Kills     int
+	Deaths    int
+	AvgRating *float64 // nil without rated matches
+}
+
+func (e LeaderboardEntry) WinRate() float64 {
+	if e.Games == 0 {
+		return 0
+	}
+	return float64(e.Wins) / float64(e.Games)
+}
+
+func (e LeaderboardEntry) KD() float64 {
+	if e.Deaths == 0 {
+		return float64(e.Kills)
+	}
+	return float64(e.Kills) / float64(e.Deaths)
+}
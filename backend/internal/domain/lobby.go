@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// MinRankedTier is Iron 1. Tiers below it are unranked or unused.
+const MinRankedTier = 3
+
+func IsRankedTier(tier int) bool {
+	return tier >= MinRankedTier
+}
+
+// ExpectedOpponents is how many enemy seats a lobby of mode has, 0 when unknown.
+func ExpectedOpponents(mode string) int {
+	size := ExpectedLobbySize(mode)
+	if strings.EqualFold(mode, "deathmatch") {
+		return max(size-1, 0)
+	}
+	return size / 2
+}
+
+// TeamRank sums the ranks stored for one team, or the whole lobby when Team is empty.
+// Unranked players and rows that were never stored don't count toward the average.
+type TeamRank struct {
+	Team          string
+	TierSum       int
+	RankedPlayers int
+	StoredPlayers int
+}
+
+func (t TeamRank) AvgTier() (float64, bool) {
+	if t.RankedPlayers == 0 {
+		return 0, false
+	}
+	return float64(t.TierSum) / float64(t.RankedPlayers), true
+}
+
+func (t *TeamRank) Add(other TeamRank) {
+	t.TierSum += other.TierSum
+	t.RankedPlayers += other.RankedPlayers
+	t.StoredPlayers += other.StoredPlayers
+}
+
+type LobbyRank struct {
+	Teams           []TeamRank // sorted by team name
+	ExpectedPlayers int        // 0 when the mode's lobby size is unknown
+}
+
+func NewLobbyRank(mode string, players []MatchPlayer) LobbyRank {
+	byTeam := make(map[string]*TeamRank)
+	for _, p := range players {
+		t, ok := byTeam[p.Team]
+		if !ok {
+			t = &TeamRank{Team: p.Team}
+			byTeam[p.Team] = t
+		}
+		t.StoredPlayers++
+		if IsRankedTier(p.Tier) {
+			t.TierSum += p.Tier
+			t.RankedPlayers++
+		}
+	}
+
+	lobby := LobbyRank{ExpectedPlayers: ExpectedLobbySize(mode)}
+	for _, t := range byTeam {
+		lobby.Teams = append(lobby.Teams, *t)
+	}
+	lobby.Sort()
+	return lobby
+}
+
+func (l *LobbyRank) Sort() {
+	slices.SortFunc(l.Teams, func(a, b TeamRank) int { return strings.Compare(a.Team, b.Team) })
+}
+
+func (l LobbyRank) Lobby() TeamRank {
+	var total TeamRank
+	for _, t := range l.Teams {
+		total.Add(t)
+	}
+	return total
+}
+
+// Opponents merges every team other than team.
+func (l LobbyRank) Opponents(team string) TeamRank {
+	var total TeamRank
+	for _, t := range l.Teams {
+		if t.Team != team {
+			total.Add(t)
+		}
+	}
+	return total
+}
+
+func (l LobbyRank) Team(team string) TeamRank {
+	for _, t := range l.Teams {
+		if t.Team == team {
+			return t
+		}
+	}
+	return TeamRank{Team: team}
+}
+
+// OpponentStrength is one match of a player with the ranks of both sides.
+type OpponentStrength struct {
+	MatchID           string
+	StartedAt         time.Time
+	Mode              string
+	Team              TeamRank
+	Opponents         TeamRank
+	ExpectedOpponents int
+}
+
+// OpponentStrengthPeriod aggregates the matches that started in [Start, Start+bucket).
+type OpponentStrengthPeriod struct {
+	Start             time.Time
+	Matches           int
+	Team              TeamRank
+	Opponents         TeamRank
+	ExpectedOpponents int // over matches whose lobby size is known
+}
+
+// OpponentCoverage is the share of expected enemy seats with a known rank.
+func (p OpponentStrengthPeriod) OpponentCoverage() float64 {
+	if p.ExpectedOpponents == 0 {
+		return 0
+	}
+	return min(float64(p.Opponents.RankedPlayers)/float64(p.ExpectedOpponents), 1)
+}
+
+type OpponentStrengthTrend struct {
+	Periods []OpponentStrengthPeriod // oldest first
+	Matches int
+	// matches with at least one ranked opponent, the only ones in the trend
+	RatedMatches int
+	// least-squares change of the average opponent tier per week, nil with fewer than two matches
+	TierChangePerWeek *float64
+	Overall           OpponentStrengthPeriod
+}
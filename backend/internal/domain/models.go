@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -34,6 +35,7 @@ type Match struct {
 	Cluster       string
 	Version       string
 	Source        string // "stored", "v4", "v2"
+	Patch         string // parsed from Version, empty when it doesn't parse
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -54,6 +56,14 @@ type MatchPlayer struct {
 	DamageTaken int
 	Tag         string
 	DamageDealt int
+	ACS         float64  // combat score per round
+	ADR         float64  // damage per round
+	KAST        *float64 // share of rounds with a kill, assist, survival or trade; nil without round data
+	FirstBloods *int
+	FirstDeaths *int
+	PlusMinus   int      // kills - deaths
+	Rating      *float64 // 0-1000 relative to the lobby; nil when the lobby wasn't known
+	PartyID     string   // "" when the source had no party data
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -72,3 +82,52 @@ type MMRHistory struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// lobby sizes per queue, keyed by lowercased mode name. 0 means unknown.
+var lobbySizeByMode = map[string]int{
+	"competitive":     10,
+	"unrated":         10,
+	"swiftplay":       10,
+	"spike rush":      10,
+	"premier":         10,
+	"escalation":      10,
+	"replication":     10,
+	"team deathmatch": 10,
+	"deathmatch":      14,
+}
+
+// NormalizeMode is how modes are stored and filtered on. v2 reports them capitalised, v4 and
+// the stored matches endpoint lowercase.
+func NormalizeMode(mode string) string {
+	return strings.ToLower(mode)
+}
+
+func ExpectedLobbySize(mode string) int {
+	return lobbySizeByMode[strings.ToLower(mode)]
+}
+
+// IsCompleteLobby reports whether a match has exactly as many stored players as its mode seats.
+// For unknown modes anything beyond a single player-centric row is accepted.
+func IsCompleteLobby(mode string, players int) bool {
+	expected := ExpectedLobbySize(mode)
+	if expected == 0 {
+		return players > 1
+	}
+	return players == expected
+}
+
+// IsSinglePlayerSource reports whether matches from this ingestion source only carry the row
+// of the player they were fetched for, so a short lobby is expected rather than broken.
+func IsSinglePlayerSource(source string) bool {
+	return source == "stored" || source == "v4"
+}
+
+type TrackedPlayer struct {
+	Puuid           string
+	Source          string // "config", "follow"
+	RefreshInterval time.Duration
+	NextRefreshAt   time.Time
+	LastRefreshAt   *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// LeaderboardSnapshot is one ingestion of a region's official ranked leaderboard.
+type LeaderboardSnapshot struct {
+	ID        string
+	Region    string
+	FetchedAt time.Time
+}
+
+type OfficialLeaderboardEntry struct {
+	Rank         int
+	Puuid        string // "" for anonymized players, like Name and Tag
+	Name         string
+	Tag          string
+	Tier         int
+	RR           int
+	Wins         int
+	PreviousRank *int // rank in the snapshot before, nil when unranked there or anonymized
+	Tracked      bool // the player is stored in players
+}
+
+type LeaderboardPlacement struct {
+	Region string
+	Rank   int
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var (
+	versionPattern = regexp.MustCompile(`^release-(\d+)\.(\d+)-`)
+	patchPattern   = regexp.MustCompile(`^(\d+)\.(\d+)$`)
+)
+
+type Patch struct {
+	Name        string
+	Major       int
+	Minor       int
+	FirstSeenAt time.Time
+	Matches     int
+}
+
+// ParsePatch normalizes a game version such as release-08.11-shipping-6-2554587 to patch 8.11.
+func ParsePatch(version string) (Patch, bool) {
+	return parsePatch(versionPattern, version)
+}
+
+func parsePatch(pattern *regexp.Regexp, s string) (Patch, bool) {
+	m := pattern.FindStringSubmatch(s)
+	if m == nil {
+		return Patch{}, false
+	}
+	major, err := strconv.Atoi(m[1])
+	if err != nil {
+		return Patch{}, false
+	}
+	minor, err := strconv.Atoi(m[2])
+	if err != nil {
+		return Patch{}, false
+	}
+	return Patch{Name: fmt.Sprintf("%d.%02d", major, minor), Major: major, Minor: minor}, true
+}
+
+// PatchBefore orders patch names by release, unparsable ones first.
+func PatchBefore(a, b string) bool {
+	pa, _ := parsePatch(patchPattern, a)
+	pb, _ := parsePatch(patchPattern, b)
+	if pa.Major != pb.Major {
+		return pa.Major < pb.Major
+	}
+	return pa.Minor < pb.Minor
+}
+
+// PatchMeta is how an agent did across every stored player on a patch.
+type PatchMeta struct {
+	CharacterID string
+	Games       int // player slots, an agent can be on both teams
+	Matches     int
+	Wins        int
+	Kills       int
+	Deaths      int
+}
+
+func (m PatchMeta) WinRate() float64 {
+	if m.Games == 0 {
+		return 0
+	}
+	return float64(m.Wins) / float64(m.Games)
+}
+
+func (m PatchMeta) KD() float64 {
+	if m.Deaths == 0 {
+		return float64(m.Kills)
+	}
+	return float64(m.Kills) / float64(m.Deaths)
+}
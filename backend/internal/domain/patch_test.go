@@ -0,0 +1,49 @@
+package domain
+
+import "testing"
+
+func TestParsePatch(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		ok      bool
+	}{
+		{"release-08.11-shipping-6-2554587", "8.11", true},
+		{"release-10.01-shipping-14-3018423", "10.01", true},
+		{"release-09.0-shipping-1-1", "9.00", true},
+		{"release-9.5-shipping", "9.05", true},
+		{"release-08.11", "", false},
+		{"8.11", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePatch(tt.version)
+		if ok != tt.ok || got.Name != tt.want {
+			t.Errorf("ParsePatch(%q) = (%q, %v), want (%q, %v)", tt.version, got.Name, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPatchBefore(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"8.11", "9.00", true},
+		{"9.00", "8.11", false},
+		// minor versions compare as numbers, not strings
+		{"9.05", "9.10", true},
+		{"9.10", "10.01", true},
+		{"10.01", "9.10", false},
+		{"9.05", "9.05", false},
+		{"unknown", "8.11", true},
+		{"8.11", "unknown", false},
+	}
+
+	for _, tt := range tests {
+		if got := PatchBefore(tt.a, tt.b); got != tt.want {
+			t.Errorf("PatchBefore(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package domain
+
+// RankProjection models the next promotion from the player's latest rated games. Low and High
+// bounds come from the win rate's confidence interval; for expected games Low is the
+// optimistic end.
+type RankProjection struct {
+	Tier     int
+	TierName string
+	RR       int
+	Elo      int
+
+	SampleGames int
+	Wins        int
+	Losses      int
+	Draws       int
+	WinRate     float64
+	WinRateLow  float64
+	WinRateHigh float64
+	AvgGain     float64
+	AvgLoss     float64
+
+	Games               int // horizon of the promotion chances
+	PromotionChance     float64
+	PromotionChanceLow  float64
+	PromotionChanceHigh float64
+
+	// nil when promotion isn't near certain within constants.ProjectionHorizon games
+	ExpectedGames     *float64
+	ExpectedGamesLow  *float64
+	ExpectedGamesHigh *float64
+
+	// why nothing was projected, empty when the projection is set
+	Unavailable string
+}
+
+func (p RankProjection) ExpectedRRPerGame() float64 {
+	if p.SampleGames == 0 {
+		return 0
+	}
+	return (float64(p.Wins)*p.AvgGain - float64(p.Losses)*p.AvgLoss) / float64(p.SampleGames)
+}
@@ -0,0 +1,54 @@
+package domain
+
+type MatchRound struct {
+	MatchID       string
+	RoundNumber   int // 0-based
+	WinningTeam   string
+	AttackingTeam string // "" when the mode has no sides
+	EndType       string
+	BombPlanted   bool
+	BombDefused   bool
+	PlantSite     string
+}
+
+type MatchKill struct {
+	MatchID       string
+	RoundNumber   int
+	TimeInRoundMs int
+	KillerPuuid   string
+	KillerTeam    string
+	VictimPuuid   string
+	VictimTeam    string
+	Assistants    []string
+	WeaponID      string
+	WeaponName    string
+}
+
+// MatchPlayerRound is one player's round. Shots and damage cover the whole round, whatever
+// weapon or ability dealt them.
+type MatchPlayerRound struct {
+	MatchID     string
+	RoundNumber int
+	Puuid       string
+	WeaponID    string // weapon bought or kept for the round, "" when there was none
+	WeaponName  string
+	Headshots   int
+	Bodyshots   int
+	Legshots    int
+	Damage      int
+}
+
+const (
+	AwardMatchMVP        = "match_mvp" // best player on the winning team
+	AwardTeamMVP         = "team_mvp"  // best player on the losing team
+	AwardMostFirstBloods = "most_first_bloods"
+	AwardHighestDamage   = "highest_damage"
+	AwardBestClutch      = "best_clutch" // value is the number of enemies alive when the clutch started
+)
+
+type MatchAward struct {
+	MatchID string
+	Award   string
+	Puuid   string
+	Value   float64
+}
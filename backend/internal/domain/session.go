@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type SessionAgent struct {
+	CharacterID string
+	Matches     int
+}
+
+// Session is a run of matches with no gap between starts longer than the configured gap.
+type Session struct {
+	StartedAt time.Time
+	EndedAt   time.Time // estimated, see constants.SessionMatchLength
+	MatchIDs  []string  // newest first
+	Wins      int
+	Losses    int
+	Draws     int
+	Kills     int
+	Deaths    int
+	Assists   int
+	NetRR     int
+	Agents    []SessionAgent // most played first
+	Streaks   Streaks
+	TiltGames int // games queued after constants.TiltLossStreak straight losses
+
+	StartTier     int
+	StartTierName string
+	EndTier       int
+	EndTierName   string
+}
+
+func (s Session) Duration() time.Duration {
+	return s.EndedAt.Sub(s.StartedAt)
+}
@@ -0,0 +1,139 @@
+package domain
+
+import "time"
+
+// StatsFilter narrows aggregate queries. Zero values mean no restriction.
+type StatsFilter struct {
+	SeasonID      string
+	Mode          string
+	StartedAfter  *time.Time
+	StartedBefore *time.Time
+	CharacterID   string
+	Patch         string // normalized, see ParsePatch
+}
+
+type AgentStats struct {
+	Patch       string // empty unless grouped by patch
+	CharacterID string
+	Games       int
+	Wins        int
+	Kills       int
+	Deaths      int
+	Assists     int
+	DamageDealt int
+	Rounds      int
+	AvgScore    float64
+	AvgRRChange float64 // over RatedGames only
+	RatedGames  int
+}
+
+func (s AgentStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s AgentStats) KD() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills)
+	}
+	return float64(s.Kills) / float64(s.Deaths)
+}
+
+// ADR is average damage per round.
+func (s AgentStats) ADR() float64 {
+	if s.Rounds == 0 {
+		return 0
+	}
+	return float64(s.DamageDealt) / float64(s.Rounds)
+}
+
+// MapStats aggregates a player's games on one map. The attack/defense split is
+// only known for matches whose rounds have been stored.
+type MapStats struct {
+	Patch            string // empty unless grouped by patch
+	MapID            string
+	MapName          string
+	Games            int
+	Wins             int
+	RoundsWon        int
+	Rounds           int
+	Kills            int
+	Deaths           int
+	Score            int
+	AttackRoundsWon  int
+	AttackRounds     int
+	DefenseRoundsWon int
+	DefenseRounds    int
+}
+
+func (s MapStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s MapStats) RoundWinRate() float64 {
+	if s.Rounds == 0 {
+		return 0
+	}
+	return float64(s.RoundsWon) / float64(s.Rounds)
+}
+
+func (s MapStats) KD() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills)
+	}
+	return float64(s.Kills) / float64(s.Deaths)
+}
+
+// ACS is average combat score: total score over rounds played.
+func (s MapStats) ACS() float64 {
+	if s.Rounds == 0 {
+		return 0
+	}
+	return float64(s.Score) / float64(s.Rounds)
+}
+
+// OverallStats aggregates every game a player has left after filtering.
+type OverallStats struct {
+	Patch       string // empty unless grouped by patch
+	Games       int
+	Wins        int
+	Draws       int
+	Kills       int
+	Deaths      int
+	Assists     int
+	DamageDealt int
+	Score       int
+	Rounds      int
+	RRChange    int // summed over RatedGames
+	RatedGames  int
+}
+
+func (s OverallStats) Losses() int {
+	return s.Games - s.Wins - s.Draws
+}
+
+func (s OverallStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s OverallStats) KD() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills)
+	}
+	return float64(s.Kills) / float64(s.Deaths)
+}
+
+func (s OverallStats) AvgRRChange() float64 {
+	if s.RatedGames == 0 {
+		return 0
+	}
+	return float64(s.RRChange) / float64(s.RatedGames)
+}
@@ -0,0 +1,123 @@
+package domain
+
+type Outcome int
+
+const (
+	OutcomeWin Outcome = iota
+	OutcomeLoss
+	OutcomeDraw
+)
+
+func MatchOutcome(match Match, stats MatchPlayer) Outcome {
+	switch {
+	case stats.HasWon:
+		return OutcomeWin
+	case match.TeamRedScore == match.TeamBlueScore:
+		return OutcomeDraw
+	default:
+		return OutcomeLoss
+	}
+}
+
+// Streaks counts consecutive wins and losses; a draw ends both. Outcomes are added oldest first.
+type Streaks struct {
+	CurrentWins   int
+	CurrentLosses int
+	LongestWins   int
+	LongestLosses int
+}
+
+func (s *Streaks) Add(o Outcome) {
+	switch o {
+	case OutcomeWin:
+		s.CurrentWins++
+		s.CurrentLosses = 0
+	case OutcomeLoss:
+		s.CurrentLosses++
+		s.CurrentWins = 0
+	default:
+		s.CurrentWins, s.CurrentLosses = 0, 0
+	}
+	s.LongestWins = max(s.LongestWins, s.CurrentWins)
+	s.LongestLosses = max(s.LongestLosses, s.CurrentLosses)
+}
+
+type SeasonStreaks struct {
+	SeasonID string
+	Streaks
+}
+
+// TiltSplit aggregates outcome and performance over a set of matches.
+type TiltSplit struct {
+	Games     int
+	Wins      int
+	Kills     int
+	Deaths    int
+	RatingSum float64
+	Rated     int
+}
+
+func (t *TiltSplit) Add(match Match, stats MatchPlayer) {
+	t.Games++
+	if MatchOutcome(match, stats) == OutcomeWin {
+		t.Wins++
+	}
+	t.Kills += stats.Kills
+	t.Deaths += stats.Deaths
+	if stats.Rating != nil {
+		t.RatingSum += *stats.Rating
+		t.Rated++
+	}
+}
+
+func (t TiltSplit) WinRate() float64 {
+	if t.Games == 0 {
+		return 0
+	}
+	return float64(t.Wins) / float64(t.Games)
+}
+
+func (t TiltSplit) KD() float64 {
+	if t.Deaths == 0 {
+		return float64(t.Kills)
+	}
+	return float64(t.Kills) / float64(t.Deaths)
+}
+
+func (t TiltSplit) AvgRating() (float64, bool) {
+	if t.Rated == 0 {
+		return 0, false
+	}
+	return t.RatingSum / float64(t.Rated), true
+}
+
+// Tilt compares the games a player queued right after a loss streak, within the same session,
+// against the rest of their games.
+type Tilt struct {
+	AfterLosses int // consecutive losses before a game counts as after the streak
+	Baseline    TiltSplit
+	AfterStreak TiltSplit
+	Tilted      bool
+	Insight     string // empty when there aren't enough games on either side
+}
+
+func (t Tilt) WinRateDrop() float64 {
+	return t.Baseline.WinRate() - t.AfterStreak.WinRate()
+}
+
+func (t Tilt) KDDrop() float64 {
+	return t.Baseline.KD() - t.AfterStreak.KD()
+}
+
+// RatingDrop is false when either side has no rated games.
+func (t Tilt) RatingDrop() (float64, bool) {
+	baseline, ok := t.Baseline.AvgRating()
+	if !ok {
+		return 0, false
+	}
+	after, ok := t.AfterStreak.AvgRating()
+	if !ok {
+		return 0, false
+	}
+	return baseline - after, true
+}
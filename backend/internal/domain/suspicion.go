@@ -0,0 +1,58 @@
+package domain
+
+type SuspicionSignalKind string
+
+const (
+	SignalLowLevelHighRank SuspicionSignalKind = "low_level_high_rank"
+	SignalRatingAboveLobby SuspicionSignalKind = "rating_above_lobby"
+	SignalRRPerWin         SuspicionSignalKind = "rr_per_win"
+	SignalSessionStatShift SuspicionSignalKind = "session_stat_shift"
+)
+
+// SuspicionSignal is one explainable input of the suspicion score. Strength grows linearly from
+// 0 at Threshold to 1 at Saturation of Value.
+type SuspicionSignal struct {
+	Kind       SuspicionSignalKind
+	Available  bool // false when there wasn't enough data, Strength is then 0
+	Value      float64
+	Threshold  float64
+	Saturation float64
+	Strength   float64
+	Weight     float64
+	Detail     string
+}
+
+// Contribution is the signal's share of the 0-100 score.
+func (s SuspicionSignal) Contribution() float64 {
+	return s.Strength * s.Weight * 100
+}
+
+type SuspicionReport struct {
+	Puuid   string
+	Signals []SuspicionSignal
+}
+
+// Score is 0-100. Unavailable signals count as clean, so a thin history keeps the score low
+// rather than letting one signal carry it; Coverage says how much of it could be checked.
+func (r SuspicionReport) Score() float64 {
+	var score float64
+	for _, s := range r.Signals {
+		score += s.Contribution()
+	}
+	return score
+}
+
+// Coverage is the share of the total signal weight that had enough data.
+func (r SuspicionReport) Coverage() float64 {
+	var available, total float64
+	for _, s := range r.Signals {
+		total += s.Weight
+		if s.Available {
+			available += s.Weight
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return available / total
+}
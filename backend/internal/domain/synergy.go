@@ -0,0 +1,70 @@
+package domain
+
+type Teammate struct {
+	Puuid   string
+	Name    string
+	Tag     string
+	PartyID string
+}
+
+// TeamMatch is one of a player's matches with whichever teammates are stored for it.
+type TeamMatch struct {
+	MatchID   string
+	PartyID   string
+	HasWon    bool
+	Kills     int
+	Deaths    int
+	MMRChange *int // nil for unrated matches
+	Teammates []Teammate
+}
+
+type QueueStats struct {
+	Games    int
+	Wins     int
+	Kills    int
+	Deaths   int
+	RRChange int
+}
+
+func (s *QueueStats) Add(m TeamMatch) {
+	s.Games++
+	if m.HasWon {
+		s.Wins++
+	}
+	s.Kills += m.Kills
+	s.Deaths += m.Deaths
+	if m.MMRChange != nil {
+		s.RRChange += *m.MMRChange
+	}
+}
+
+func (s QueueStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s QueueStats) KD() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills)
+	}
+	return float64(s.Kills) / float64(s.Deaths)
+}
+
+// TeammateSynergy is the player's own results in the games shared with one teammate.
+type TeammateSynergy struct {
+	Puuid        string
+	Name         string
+	Tag          string
+	PremadeGames int
+	QueueStats
+}
+
+type Synergy struct {
+	Teammates []TeammateSynergy
+	Solo      QueueStats
+	Party     QueueStats
+	// matches without any stored teammate, so neither solo nor party can be told
+	Unclassified int
+}
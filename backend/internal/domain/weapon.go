@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// WeaponStats aggregates kills with a weapon and the rounds it was bought or kept. Shots and
+// damage cover those whole rounds, so abilities and picked up weapons count towards them too.
+type WeaponStats struct {
+	Kills      int // every kill with the weapon, picked up ones included
+	Rounds     int
+	RoundKills int // kills with the weapon in Rounds
+	Headshots  int
+	Bodyshots  int
+	Legshots   int
+	Damage     int
+}
+
+func (s *WeaponStats) Add(o WeaponStats) {
+	s.Kills += o.Kills
+	s.Rounds += o.Rounds
+	s.RoundKills += o.RoundKills
+	s.Headshots += o.Headshots
+	s.Bodyshots += o.Bodyshots
+	s.Legshots += o.Legshots
+	s.Damage += o.Damage
+}
+
+func (s WeaponStats) HeadshotRate() (float64, bool) {
+	shots := s.Headshots + s.Bodyshots + s.Legshots
+	if shots == 0 {
+		return 0, false
+	}
+	return float64(s.Headshots) / float64(shots), true
+}
+
+func (s WeaponStats) KillsPerRound() (float64, bool) {
+	if s.Rounds == 0 {
+		return 0, false
+	}
+	return float64(s.RoundKills) / float64(s.Rounds), true
+}
+
+func (s WeaponStats) DamagePerRound() (float64, bool) {
+	if s.Rounds == 0 {
+		return 0, false
+	}
+	return float64(s.Damage) / float64(s.Rounds), true
+}
+
+// WeaponSlice is a player's use of one weapon on one map in one season.
+type WeaponSlice struct {
+	WeaponID      string // lower case, kill feed and loadout ids differ in case
+	WeaponName    string
+	MapID         string
+	MapName       string
+	SeasonID      string
+	FirstPlayedAt time.Time
+	WeaponStats
+}
+
+type WeaponMapStats struct {
+	MapID   string
+	MapName string
+	WeaponStats
+}
+
+type WeaponSeasonStats struct {
+	SeasonID      string
+	FirstPlayedAt time.Time
+	WeaponStats
+}
+
+type WeaponBreakdown struct {
+	WeaponID   string
+	WeaponName string
+	WeaponStats
+	Maps    []WeaponMapStats    // most kills first
+	Seasons []WeaponSeasonStats // oldest first
+}
+
+// WeaponMeta is a weapon across every stored match. RoundKills stays zero, kills aren't matched
+// to loadouts over the whole database.
+type WeaponMeta struct {
+	WeaponID   string
+	WeaponName string
+	WeaponStats
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // gave up after the last attempt
+)
+
+type WebhookSubscription struct {
+	ID          string
+	URL         string
+	Secret      string
+	EventTypes  []string
+	Puuid       string // empty means every player
+	RRThreshold *int   // rr.changed only fires when RR crosses this value
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      *string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,130 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog"
+)
+
+type Type string
+
+const (
+	MatchNew  Type = "match.new"
+	RankUp    Type = "rank.up"
+	RankDown  Type = "rank.down"
+	RRChanged Type = "rr.changed"
+	WinStreak Type = "streak.win"
+)
+
+var Types = []Type{MatchNew, RankUp, RankDown, RRChanged, WinStreak}
+
+func (t Type) Valid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Event struct {
+	ID         string    `json:"id"`
+	Type       Type      `json:"type"`
+	Puuid      string    `json:"puuid"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+type MatchData struct {
+	MatchID       string    `json:"match_id"`
+	MapName       string    `json:"map_name"`
+	MapID         string    `json:"map_id"`
+	Mode          string    `json:"mode"`
+	StartedAt     time.Time `json:"started_at"`
+	CharacterID   string    `json:"character_id"`
+	Team          string    `json:"team"`
+	HasWon        bool      `json:"has_won"`
+	Kills         int       `json:"kills"`
+	Deaths        int       `json:"deaths"`
+	Assists       int       `json:"assists"`
+	Score         int       `json:"score"`
+	DamageDealt   int       `json:"damage_dealt"`
+	DamageTaken   int       `json:"damage_taken"`
+	TeamRedScore  int       `json:"team_red_score"`
+	TeamBlueScore int       `json:"team_blue_score"`
+	Tier          int       `json:"tier"`
+	TierName      string    `json:"tier_name"`
+	RankingInTier int       `json:"ranking_in_tier"`
+	MMRChange     int       `json:"mmr_change"`
+}
+
+type RankData struct {
+	PreviousTier     int    `json:"previous_tier"`
+	PreviousTierName string `json:"previous_tier_name"`
+	PreviousRR       int    `json:"previous_rr"`
+	Tier             int    `json:"tier"`
+	TierName         string `json:"tier_name"`
+	RR               int    `json:"rr"`
+}
+
+type RRData struct {
+	PreviousRR int    `json:"previous_rr"`
+	RR         int    `json:"rr"`
+	Tier       int    `json:"tier"`
+	TierName   string `json:"tier_name"`
+}
+
+type StreakData struct {
+	Length  int    `json:"length"`
+	MatchID string `json:"match_id"`
+}
+
+// Handler is called synchronously from the publishing goroutine, so it must not block.
+type Handler func(Event)
+
+// Bus fans events out from the ingestion paths to whoever is interested.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[int]Handler
+	nextID   int
+	logger   zerolog.Logger
+}
+
+func NewBus(logger zerolog.Logger) *Bus {
+	return &Bus{handlers: make(map[int]Handler), logger: logger}
+}
+
+// Subscribe registers h and returns a func that removes it again.
+func (b *Bus) Subscribe(h Handler) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = h
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.handlers, id)
+	}
+}
+
+func (b *Bus) Publish(t Type, puuid string, occurredAt time.Time, data any) {
+	id, err := gonanoid.New()
+	if err != nil {
+		b.logger.Error().Err(err).Str("type", string(t)).Msg("failed to generate event id")
+		return
+	}
+	e := Event{ID: id, Type: t, Puuid: puuid, OccurredAt: occurredAt, Data: data}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	b.logger.Debug().Str("type", string(t)).Str("puuid", puuid).Int("handlers", len(b.handlers)).Msg("publishing event")
+	for _, h := range b.handlers {
+		h(e)
+	}
+}
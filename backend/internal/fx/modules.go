@@ -6,6 +6,8 @@ import (
 	"valorant-tracker/internal/config"
 	"valorant-tracker/internal/database"
 	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/discord"
+	"valorant-tracker/internal/events"
 	"valorant-tracker/internal/logger"
 	"valorant-tracker/internal/repository"
 	"valorant-tracker/internal/server"
@@ -23,16 +25,44 @@ var Module = fx.Options(
 	fx.Provide(config.Load),
 	fx.Provide(database.New),
 	fx.Provide(ProvideQueries),
+	fx.Provide(events.NewBus),
 	// repos
 	fx.Provide(repository.NewPlayerRepository),
 	fx.Provide(repository.NewMatchRepository),
 	fx.Provide(repository.NewMMRHistoryRepository),
+	fx.Provide(repository.NewIntegrityRepository),
+	fx.Provide(repository.NewTrackedPlayerRepository),
+	fx.Provide(repository.NewFollowRepository),
+	fx.Provide(repository.NewWebhookRepository),
+	fx.Provide(repository.NewStatsRepository),
+	fx.Provide(repository.NewWeaponRepository),
+	fx.Provide(repository.NewEncounterRepository),
+	fx.Provide(repository.NewLeaderboardRepository),
+	fx.Provide(repository.NewOfficialLeaderboardRepository),
 	// api client
 	fx.Provide(api.NewHDevClient),
 	// svc
 	fx.Provide(service.NewPlayerService),
 	fx.Provide(service.NewMatchService),
 	fx.Provide(service.NewMatchDetailService),
+	fx.Provide(service.NewFeedService),
+	fx.Provide(service.NewWatchHub),
+	fx.Provide(service.NewSessionService),
+	fx.Provide(service.NewStatsService),
+	fx.Provide(service.NewEncounterService),
+	fx.Provide(service.NewSynergyService),
+	fx.Provide(service.NewCompareService),
+	fx.Provide(service.NewLeaderboardService),
+	fx.Provide(service.NewSuspicionService),
+	fx.Provide(service.NewProjectionService),
+	fx.Provide(service.NewWeaponService),
+	// background jobs
+	fx.Provide(service.NewReconciler),
+	fx.Provide(service.NewRefreshScheduler),
+	fx.Provide(service.NewWebhookService),
+	fx.Provide(service.NewOfficialLeaderboardService),
+	fx.Invoke(func(*service.RefreshScheduler) {}),
 	// server
 	fx.Provide(server.NewTrackerServer),
+	fx.Provide(discord.NewHandler),
 )
@@ -0,0 +1,160 @@
+package metrics
+
+import (
+	"cmp"
+	"slices"
+	"valorant-tracker/internal/domain"
+)
+
+// Awards picks the highlights of a match from its full lobby. Players must have had Apply and
+// ApplyRatings run; rounds and kills may be nil, in which case the round based awards are skipped.
+func Awards(matchID string, players []domain.MatchPlayer, rounds []domain.MatchRound, kills []domain.MatchKill) []domain.MatchAward {
+	if len(players) == 0 {
+		return nil
+	}
+
+	var awards []domain.MatchAward
+	award := func(kind string, p domain.MatchPlayer, value float64) {
+		awards = append(awards, domain.MatchAward{MatchID: matchID, Award: kind, Puuid: p.Puuid, Value: value})
+	}
+
+	winner, loser := winningTeam(players)
+	if best, ok := bestOf(players, winner); ok {
+		award(domain.AwardMatchMVP, best, mvpScore(best))
+	}
+	if best, ok := bestOf(players, loser); ok {
+		award(domain.AwardTeamMVP, best, mvpScore(best))
+	}
+
+	top := slices.MaxFunc(players, func(a, b domain.MatchPlayer) int {
+		return cmp.Or(cmp.Compare(a.DamageDealt, b.DamageDealt), cmp.Compare(b.Puuid, a.Puuid))
+	})
+	award(domain.AwardHighestDamage, top, float64(top.DamageDealt))
+
+	if kills == nil {
+		return awards
+	}
+
+	top = slices.MaxFunc(players, func(a, b domain.MatchPlayer) int {
+		return cmp.Or(cmp.Compare(derefInt(a.FirstBloods), derefInt(b.FirstBloods)), cmp.Compare(b.Puuid, a.Puuid))
+	})
+	if fb := derefInt(top.FirstBloods); fb > 0 {
+		award(domain.AwardMostFirstBloods, top, float64(fb))
+	}
+
+	if puuid, enemies := bestClutch(players, rounds, kills); enemies > 0 {
+		awards = append(awards, domain.MatchAward{MatchID: matchID, Award: domain.AwardBestClutch, Puuid: puuid, Value: float64(enemies)})
+	}
+	return awards
+}
+
+// winningTeam returns the winning and losing team. On a draw, or when nobody won,
+// the team of the top player counts as the winner.
+func winningTeam(players []domain.MatchPlayer) (string, string) {
+	var winner string
+	for _, p := range players {
+		if p.HasWon {
+			winner = p.Team
+			break
+		}
+	}
+	if winner == "" {
+		winner = slices.MaxFunc(players, compareMVP).Team
+	}
+
+	for _, p := range players {
+		if p.Team != winner {
+			return winner, p.Team
+		}
+	}
+	return winner, ""
+}
+
+func bestOf(players []domain.MatchPlayer, team string) (domain.MatchPlayer, bool) {
+	var best domain.MatchPlayer
+	found := false
+	for _, p := range players {
+		if team == "" || p.Team != team {
+			continue
+		}
+		if !found || compareMVP(p, best) > 0 {
+			best = p
+			found = true
+		}
+	}
+	return best, found
+}
+
+// compareMVP ranks by rating when there is one, falling back to combat score.
+func compareMVP(a, b domain.MatchPlayer) int {
+	return cmp.Or(cmp.Compare(mvpScore(a), mvpScore(b)), cmp.Compare(a.Score, b.Score), cmp.Compare(b.Puuid, a.Puuid))
+}
+
+func mvpScore(p domain.MatchPlayer) float64 {
+	if p.Rating != nil {
+		return *p.Rating
+	}
+	return p.ACS
+}
+
+// bestClutch finds the round won by a lone survivor against the most enemies. Ties go to the earlier round.
+func bestClutch(players []domain.MatchPlayer, rounds []domain.MatchRound, kills []domain.MatchKill) (string, int) {
+	roster := make(map[string][]string)
+	for _, p := range players {
+		roster[p.Team] = append(roster[p.Team], p.Puuid)
+	}
+
+	byRound := groupByRound(kills)
+	var bestPuuid string
+	var bestEnemies int
+	for _, round := range rounds {
+		puuid, enemies := clutchInRound(roster, round, byRound[round.RoundNumber])
+		if enemies > bestEnemies {
+			bestPuuid, bestEnemies = puuid, enemies
+		}
+	}
+	return bestPuuid, bestEnemies
+}
+
+func clutchInRound(roster map[string][]string, round domain.MatchRound, kills []domain.MatchKill) (string, int) {
+	alive := make(map[string]map[string]bool, len(roster))
+	for team, members := range roster {
+		alive[team] = make(map[string]bool, len(members))
+		for _, puuid := range members {
+			alive[team][puuid] = true
+		}
+	}
+
+	slices.SortStableFunc(kills, func(a, b domain.MatchKill) int {
+		return cmp.Compare(a.TimeInRoundMs, b.TimeInRoundMs)
+	})
+
+	var clutcher string
+	var enemies int
+	for _, k := range kills {
+		delete(alive[k.VictimTeam], k.VictimPuuid)
+		if clutcher != "" || k.VictimTeam != round.WinningTeam || len(alive[k.VictimTeam]) != 1 {
+			continue
+		}
+		for puuid := range alive[k.VictimTeam] {
+			clutcher = puuid
+		}
+		for team, members := range alive {
+			if team != k.VictimTeam {
+				enemies += len(members)
+			}
+		}
+	}
+
+	if clutcher == "" || !alive[round.WinningTeam][clutcher] {
+		return "", 0
+	}
+	return clutcher, enemies
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,142 @@
+// Package metrics derives per-player performance numbers from match totals and the kill feed.
+package metrics
+
+import (
+	"slices"
+	"strings"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+)
+
+// rounds per half for modes that don't use the regulation length
+var halfLengthByMode = map[string]int{
+	"swiftplay":  4,
+	"spike rush": 3,
+}
+
+// modes without attack and defense
+var sidelessModes = map[string]bool{
+	"deathmatch":      true,
+	"team deathmatch": true,
+}
+
+// AttackingTeam returns which team attacks in a 0-based round. Red attacks the first half,
+// sides swap at half time and alternate every round in overtime. Returns "" for modes without sides.
+func AttackingTeam(mode string, round int) string {
+	mode = strings.ToLower(mode)
+	if sidelessModes[mode] {
+		return ""
+	}
+	half, ok := halfLengthByMode[mode]
+	if !ok {
+		half = constants.RegulationHalf
+	}
+
+	redAttacks := round < half
+	if round >= 2*half {
+		// overtime, or the decider round of shorter modes
+		redAttacks = (round-2*half)%2 == 0
+	}
+	if redAttacks {
+		return "Red"
+	}
+	return "Blue"
+}
+
+func ACS(score, rounds int) float64 {
+	if rounds == 0 {
+		return 0
+	}
+	return float64(score) / float64(rounds)
+}
+
+func ADR(damage, rounds int) float64 {
+	if rounds == 0 {
+		return 0
+	}
+	return float64(damage) / float64(rounds)
+}
+
+// KAST returns the share of rounds in which puuid got a kill or assist, survived, or was traded.
+func KAST(puuid string, rounds int, kills []domain.MatchKill) float64 {
+	if rounds == 0 {
+		return 0
+	}
+
+	byRound := groupByRound(kills)
+	counted := 0
+	for round := 0; round < rounds; round++ {
+		if kastRound(puuid, byRound[round]) {
+			counted++
+		}
+	}
+	return float64(counted) / float64(rounds)
+}
+
+func kastRound(puuid string, kills []domain.MatchKill) bool {
+	var death *domain.MatchKill
+	for i, k := range kills {
+		if k.KillerPuuid == puuid || slices.Contains(k.Assistants, puuid) {
+			return true
+		}
+		if k.VictimPuuid == puuid {
+			death = &kills[i]
+		}
+	}
+	if death == nil {
+		return true
+	}
+
+	window := int(constants.KASTTradeWindow.Milliseconds())
+	for _, k := range kills {
+		if k.VictimPuuid == death.KillerPuuid && k.KillerTeam == death.VictimTeam &&
+			k.TimeInRoundMs >= death.TimeInRoundMs && k.TimeInRoundMs-death.TimeInRoundMs <= window {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstKills counts the rounds puuid opened with a kill and the rounds they died first in.
+func FirstKills(puuid string, kills []domain.MatchKill) (firstBloods, firstDeaths int) {
+	for _, roundKills := range groupByRound(kills) {
+		first := roundKills[0]
+		for _, k := range roundKills[1:] {
+			if k.TimeInRoundMs < first.TimeInRoundMs {
+				first = k
+			}
+		}
+		if first.KillerPuuid == puuid {
+			firstBloods++
+		}
+		if first.VictimPuuid == puuid {
+			firstDeaths++
+		}
+	}
+	return firstBloods, firstDeaths
+}
+
+// Apply fills in the derived metrics of p. kills is the whole match's kill feed;
+// pass nil when it isn't known so the round-based metrics stay unset.
+func Apply(p *domain.MatchPlayer, rounds int, kills []domain.MatchKill) {
+	p.ACS = ACS(p.Score, rounds)
+	p.ADR = ADR(p.DamageDealt, rounds)
+	p.PlusMinus = p.Kills - p.Deaths
+	if kills == nil {
+		return
+	}
+
+	kast := KAST(p.Puuid, rounds, kills)
+	fb, fd := FirstKills(p.Puuid, kills)
+	p.KAST = &kast
+	p.FirstBloods = &fb
+	p.FirstDeaths = &fd
+}
+
+func groupByRound(kills []domain.MatchKill) map[int][]domain.MatchKill {
+	byRound := make(map[int][]domain.MatchKill)
+	for _, k := range kills {
+		byRound[k.RoundNumber] = append(byRound[k.RoundNumber], k)
+	}
+	return byRound
+}
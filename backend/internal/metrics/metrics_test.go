@@ -0,0 +1,176 @@
+package metrics
+
+import (
+	"testing"
+	"valorant-tracker/internal/domain"
+)
+
+func TestAttackingTeam(t *testing.T) {
+	tests := []struct {
+		mode  string
+		round int
+		want  string
+	}{
+		{"competitive", 0, "Red"},
+		{"competitive", 11, "Red"},
+		{"competitive", 12, "Blue"},
+		{"competitive", 23, "Blue"},
+		// overtime alternates every round, starting with the original attackers
+		{"competitive", 24, "Red"},
+		{"competitive", 25, "Blue"},
+		{"competitive", 26, "Red"},
+		{"competitive", 31, "Blue"},
+		{"Competitive", 12, "Blue"},
+		{"unknown queue", 12, "Blue"},
+		{"swiftplay", 3, "Red"},
+		{"swiftplay", 4, "Blue"},
+		{"swiftplay", 8, "Red"},
+		{"spike rush", 2, "Red"},
+		{"spike rush", 3, "Blue"},
+		{"spike rush", 6, "Red"},
+		{"deathmatch", 0, ""},
+		{"Team Deathmatch", 5, ""},
+	}
+
+	for _, tt := range tests {
+		if got := AttackingTeam(tt.mode, tt.round); got != tt.want {
+			t.Errorf("AttackingTeam(%q, %d) = %q, want %q", tt.mode, tt.round, got, tt.want)
+		}
+	}
+}
+
+func TestPerRound(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+		round int
+		want  float64
+	}{
+		{"regular", 5200, 20, 260},
+		{"no rounds", 300, 0, 0},
+		{"nothing done", 0, 13, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ACS(tt.total, tt.round); got != tt.want {
+				t.Errorf("ACS(%d, %d) = %v, want %v", tt.total, tt.round, got, tt.want)
+			}
+			if got := ADR(tt.total, tt.round); got != tt.want {
+				t.Errorf("ADR(%d, %d) = %v, want %v", tt.total, tt.round, got, tt.want)
+			}
+		})
+	}
+}
+
+func kill(round, ms int, killer, killerTeam, victim, victimTeam string, assistants ...string) domain.MatchKill {
+	return domain.MatchKill{
+		RoundNumber:   round,
+		TimeInRoundMs: ms,
+		KillerPuuid:   killer,
+		KillerTeam:    killerTeam,
+		VictimPuuid:   victim,
+		VictimTeam:    victimTeam,
+		Assistants:    assistants,
+	}
+}
+
+func TestKAST(t *testing.T) {
+	tests := []struct {
+		name   string
+		rounds int
+		kills  []domain.MatchKill
+		want   float64
+	}{
+		{"no rounds", 0, nil, 0},
+		{"survived every round", 2, nil, 1},
+		{"kill", 1, []domain.MatchKill{
+			kill(0, 1000, "me", "Red", "e1", "Blue"),
+			kill(0, 2000, "e2", "Blue", "me", "Red"),
+		}, 1},
+		{"assist", 1, []domain.MatchKill{
+			kill(0, 1000, "mate", "Red", "e1", "Blue", "me"),
+			kill(0, 2000, "e2", "Blue", "me", "Red"),
+		}, 1},
+		{"died untraded", 1, []domain.MatchKill{
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+		}, 0},
+		{"traded on the window edge", 1, []domain.MatchKill{
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+			kill(0, 6000, "mate", "Red", "e1", "Blue"),
+		}, 1},
+		{"traded too late", 1, []domain.MatchKill{
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+			kill(0, 6001, "mate", "Red", "e1", "Blue"),
+		}, 0},
+		{"teammate killed someone else", 1, []domain.MatchKill{
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+			kill(0, 2000, "mate", "Red", "e2", "Blue"),
+		}, 0},
+		{"killer died before the death", 1, []domain.MatchKill{
+			kill(0, 500, "mate", "Red", "e1", "Blue"),
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+		}, 0},
+		{"one of four rounds", 4, []domain.MatchKill{
+			kill(0, 1000, "e1", "Blue", "me", "Red"),
+			kill(1, 1000, "e1", "Blue", "me", "Red"),
+			kill(2, 1000, "e1", "Blue", "me", "Red"),
+		}, 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KAST("me", tt.rounds, tt.kills); got != tt.want {
+				t.Errorf("KAST = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstKills(t *testing.T) {
+	kills := []domain.MatchKill{
+		// the feed isn't ordered, the earliest kill of a round opens it
+		kill(0, 9000, "me", "Red", "e1", "Blue"),
+		kill(0, 3000, "e2", "Blue", "mate", "Red"),
+		kill(1, 1500, "me", "Red", "e2", "Blue"),
+		kill(2, 800, "e1", "Blue", "me", "Red"),
+		kill(2, 400, "me", "Red", "e3", "Blue"),
+		kill(3, 200, "e3", "Blue", "me", "Red"),
+	}
+
+	fb, fd := FirstKills("me", kills)
+	if fb != 2 || fd != 1 {
+		t.Errorf("FirstKills = (%d, %d), want (2, 1)", fb, fd)
+	}
+
+	fb, fd = FirstKills("me", nil)
+	if fb != 0 || fd != 0 {
+		t.Errorf("FirstKills without a feed = (%d, %d), want (0, 0)", fb, fd)
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Run("without kill feed", func(t *testing.T) {
+		p := domain.MatchPlayer{Puuid: "me", Score: 4000, DamageDealt: 2600, Kills: 15, Deaths: 18}
+		Apply(&p, 20, nil)
+
+		if p.ACS != 200 || p.ADR != 130 || p.PlusMinus != -3 {
+			t.Errorf("got ACS %v, ADR %v, +/- %d", p.ACS, p.ADR, p.PlusMinus)
+		}
+		if p.KAST != nil || p.FirstBloods != nil || p.FirstDeaths != nil {
+			t.Error("round-based metrics set without a kill feed")
+		}
+	})
+
+	t.Run("with kill feed", func(t *testing.T) {
+		p := domain.MatchPlayer{Puuid: "me"}
+		Apply(&p, 2, []domain.MatchKill{kill(0, 1000, "me", "Red", "e1", "Blue")})
+
+		if p.KAST == nil || *p.KAST != 1 {
+			t.Errorf("KAST = %v, want 1", p.KAST)
+		}
+		if p.FirstBloods == nil || *p.FirstBloods != 1 || p.FirstDeaths == nil || *p.FirstDeaths != 0 {
+			t.Errorf("first kills = %v / %v, want 1 / 0", p.FirstBloods, p.FirstDeaths)
+		}
+	})
+}
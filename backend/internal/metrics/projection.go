@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"math"
+	"valorant-tracker/internal/constants"
+)
+
+// Wilson returns the Wilson score interval of successes out of n at the z-score z.
+func Wilson(successes, n int, z float64) (low, high float64) {
+	if n == 0 {
+		return 0, 1
+	}
+	p := float64(successes) / float64(n)
+	nf := float64(n)
+	denom := 1 + z*z/nf
+	center := (p + z*z/(2*nf)) / denom
+	margin := z * math.Sqrt(p*(1-p)/nf+z*z/(4*nf*nf)) / denom
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
+
+// PromotionCurve returns, for t = 1..horizon, the chance of having reached 100 RR within t games
+// when starting at rr and every game gains gain RR with probability win, loses loss RR with
+// probability lose and changes nothing otherwise. Dropping below 0 keeps counting down into the
+// tiers below, floored at constants.ProjectionMinRR; a start at or above 100 counts as 99.
+func PromotionCurve(rr, gain, loss int, win, lose float64, horizon int) []float64 {
+	offset := -constants.ProjectionMinRR
+	states := make([]float64, offset+constants.RRPerTier)
+	states[clampRR(rr)+offset] = 1
+
+	curve := make([]float64, horizon)
+	var promoted float64
+	for t := range horizon {
+		next := make([]float64, len(states))
+		stay := 1 - win - lose
+		for i, mass := range states {
+			if mass == 0 {
+				continue
+			}
+			x := i - offset
+			if up := x + gain; up >= constants.RRPerTier {
+				promoted += mass * win
+			} else {
+				next[clampRR(up)+offset] += mass * win
+			}
+			next[clampRR(x-loss)+offset] += mass * lose
+			next[i] += mass * stay
+		}
+		states = next
+		curve[t] = promoted
+	}
+	return curve
+}
+
+// ExpectedGames sums the survival function of a promotion curve. ok is false when too much
+// probability is left beyond the horizon for the sum to mean anything.
+func ExpectedGames(curve []float64) (games float64, ok bool) {
+	if len(curve) == 0 || 1-curve[len(curve)-1] > constants.ProjectionTailTolerance {
+		return 0, false
+	}
+	games = 1 // the first game is always played
+	for _, promoted := range curve[:len(curve)-1] {
+		games += 1 - promoted
+	}
+	return games, true
+}
+
+func clampRR(rr int) int {
+	return min(max(rr, constants.ProjectionMinRR), constants.RRPerTier-1)
+}
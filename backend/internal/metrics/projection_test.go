@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPromotionCurve(t *testing.T) {
+	tests := []struct {
+		name      string
+		rr        int
+		gain      int
+		loss      int
+		win, lose float64
+		horizon   int
+		want      []float64
+	}{
+		{"certain win", 90, 20, 15, 1, 0, 3, []float64{1, 1, 1}},
+		{"two wins needed", 70, 20, 15, 1, 0, 3, []float64{0, 1, 1}},
+		{"coin flip", 90, 20, 5, 0.5, 0.5, 2, []float64{0.5, 0.75}},
+		{"never wins", 90, 20, 15, 0, 1, 3, []float64{0, 0, 0}},
+		// above 100 RR starts one win away instead of indexing past the states
+		{"start at 100", 100, 20, 15, 0.5, 0.5, 1, []float64{0.5}},
+		{"start far above 100", 250, 20, 15, 0.5, 0.5, 1, []float64{0.5}},
+		{"start below the floor", -1000, 100, 15, 1, 0, 4, []float64{0, 0, 0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PromotionCurve(tt.rr, tt.gain, tt.loss, tt.win, tt.lose, tt.horizon)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("curve = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"math"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+)
+
+// component weights of the rating; kast and impact drop out without a kill feed
+const (
+	weightACS    = 0.35
+	weightADR    = 0.25
+	weightKAST   = 0.20
+	weightImpact = 0.20
+)
+
+type component struct {
+	weight float64
+	values []float64
+}
+
+// ApplyRatings sets the 0-1000 rating of every player in a lobby. Each component is scored
+// in standard deviations from the lobby mean, so 500 is an average game for that lobby.
+// The result is shifted by the lobby's average rank. Apply must have run on the players already;
+// kills is nil when the kill feed isn't known.
+func ApplyRatings(lobby []domain.MatchPlayer, rounds int, kills []domain.MatchKill) {
+	if len(lobby) < constants.RatingMinLobby || rounds == 0 {
+		return
+	}
+
+	multiKills := multiKillRounds(kills)
+	acs := make([]float64, len(lobby))
+	adr := make([]float64, len(lobby))
+	kast := make([]float64, len(lobby))
+	impact := make([]float64, len(lobby))
+	for i, p := range lobby {
+		acs[i] = p.ACS
+		adr[i] = p.ADR
+		if kills == nil {
+			continue
+		}
+		if p.KAST != nil {
+			kast[i] = *p.KAST
+		}
+		impact[i] = Impact(p, rounds, multiKills[p.Puuid])
+	}
+
+	components := []component{{weightACS, acs}, {weightADR, adr}}
+	if kills != nil {
+		components = append(components, component{weightKAST, kast}, component{weightImpact, impact})
+	}
+
+	var totalWeight float64
+	for _, c := range components {
+		totalWeight += c.weight
+	}
+
+	shift := tierShift(lobby)
+	for i := range lobby {
+		var z float64
+		for _, c := range components {
+			z += c.weight * zScore(c.values, i)
+		}
+		rating := constants.RatingBase + constants.RatingPerStdDev*z/totalWeight + shift
+		rating = math.Max(0, math.Min(1000, rating))
+		lobby[i].Rating = &rating
+	}
+}
+
+// Impact is opening duels won minus lost plus rounds with two or more kills, per round.
+func Impact(p domain.MatchPlayer, rounds, multiKillRounds int) float64 {
+	if rounds == 0 {
+		return 0
+	}
+	var fb, fd int
+	if p.FirstBloods != nil {
+		fb = *p.FirstBloods
+	}
+	if p.FirstDeaths != nil {
+		fd = *p.FirstDeaths
+	}
+	return float64(fb-fd+multiKillRounds) / float64(rounds)
+}
+
+func multiKillRounds(kills []domain.MatchKill) map[string]int {
+	perRound := make(map[string]map[int]int)
+	for _, k := range kills {
+		if k.KillerTeam == k.VictimTeam {
+			continue
+		}
+		if perRound[k.KillerPuuid] == nil {
+			perRound[k.KillerPuuid] = make(map[int]int)
+		}
+		perRound[k.KillerPuuid][k.RoundNumber]++
+	}
+
+	result := make(map[string]int, len(perRound))
+	for puuid, rounds := range perRound {
+		for _, n := range rounds {
+			if n >= 2 {
+				result[puuid]++
+			}
+		}
+	}
+	return result
+}
+
+func zScore(values []float64, i int) float64 {
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(values)))
+	if stdDev == 0 {
+		return 0
+	}
+	return (values[i] - mean) / stdDev
+}
+
+// tierShift rewards games in lobbies ranked above the pivot. Unranked players are ignored.
+func tierShift(lobby []domain.MatchPlayer) float64 {
+	var sum, ranked int
+	for _, p := range lobby {
+		if p.Tier > 0 {
+			sum += p.Tier
+			ranked++
+		}
+	}
+	if ranked == 0 {
+		return 0
+	}
+	avg := float64(sum) / float64(ranked)
+	return (avg - constants.RatingTierPivot) * constants.RatingPerTier
+}
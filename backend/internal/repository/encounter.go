@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type EncounterRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewEncounterRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *EncounterRepository {
+	return &EncounterRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+// List returns everyone puuid shared a match with, most shared matches first. Name, tag and
+// the last encounter come from the most recent shared match.
+func (r *EncounterRepository) List(ctx context.Context, puuid string, limit int) ([]domain.Encounter, error) {
+	rows, err := r.queries.GetEncounters(ctx, db.GetEncountersParams{
+		Puuid: puuid,
+		Limit: int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Encounter, len(rows))
+	for i, row := range rows {
+		result[i] = domain.Encounter{
+			Puuid:          row.Puuid,
+			Name:           row.Name,
+			Tag:            row.Tag,
+			GamesWith:      int(row.GamesWith),
+			WinsWith:       int(row.WinsWith),
+			GamesAgainst:   int(row.GamesAgainst),
+			WinsAgainst:    int(row.WinsAgainst),
+			LastMatchID:    row.LastMatchID,
+			LastPlayedAt:   row.LastPlayedAt,
+			LastAsTeammate: row.LastAsTeammate,
+		}
+	}
+	return result, nil
+}
+
+// Get returns the shared history of one pair, ignoring excludeMatchID when it is set.
+// It returns sql.ErrNoRows when the two never met.
+func (r *EncounterRepository) Get(ctx context.Context, puuid, otherPuuid, excludeMatchID string) (*domain.Encounter, error) {
+	row, err := r.queries.GetEncounter(ctx, db.GetEncounterParams{
+		OtherPuuid:     otherPuuid,
+		Puuid:          puuid,
+		ExcludeMatchID: nullableString(excludeMatchID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Encounter{
+		Puuid:          row.Puuid,
+		Name:           row.Name,
+		Tag:            row.Tag,
+		GamesWith:      int(row.GamesWith),
+		WinsWith:       int(row.WinsWith),
+		GamesAgainst:   int(row.GamesAgainst),
+		WinsAgainst:    int(row.WinsAgainst),
+		LastMatchID:    row.LastMatchID,
+		LastPlayedAt:   row.LastPlayedAt,
+		LastAsTeammate: row.LastAsTeammate,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type FollowRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewFollowRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *FollowRepository {
+	return &FollowRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+func (r *FollowRepository) Follow(ctx context.Context, followerID, puuid string) error {
+	return r.queries.FollowPlayer(ctx, db.FollowPlayerParams{
+		FollowerID: followerID,
+		Puuid:      puuid,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func (r *FollowRepository) Unfollow(ctx context.Context, followerID, puuid string) error {
+	return r.queries.UnfollowPlayer(ctx, db.UnfollowPlayerParams{
+		FollowerID: followerID,
+		Puuid:      puuid,
+	})
+}
+
+func (r *FollowRepository) GetFollowed(ctx context.Context, followerID string) ([]string, error) {
+	return r.queries.GetFollowedPuuids(ctx, followerID)
+}
+
+func (r *FollowRepository) CountFollowers(ctx context.Context, puuid string) (int, error) {
+	count, err := r.queries.CountFollowers(ctx, puuid)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FeedMatch is a followed player's match together with the bits of their earlier history
+// the feed derives rank changes and milestones from.
+type FeedMatch struct {
+	MatchWithPlayers
+	Played           int // stored matches of the player up to and including this one
+	PreviousTier     *int
+	PreviousTierName string
+	PeakTier         int // highest tier before this match, 0 without rated history
+}
+
+// GetFeedMatches returns up to limit matches of the players followerID follows, newest first,
+// starting after the match of beforePuuid identified by beforeMatchID when that is set.
+func (r *FollowRepository) GetFeedMatches(ctx context.Context, followerID string, beforeMatchID *string, beforePuuid string, limit int) ([]FeedMatch, error) {
+	rows, err := r.queries.GetFeedMatches(ctx, db.GetFeedMatchesParams{
+		FollowerID:    followerID,
+		BeforeMatchID: beforeMatchID,
+		BeforePuuid:   beforePuuid,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FeedMatch, len(rows))
+	for i, row := range rows {
+		result := FeedMatch{
+			MatchWithPlayers: MatchWithPlayers{
+				Match: domain.Match{
+					MatchID:       row.MatchID,
+					MapName:       row.MapName,
+					MapID:         row.MapID,
+					Mode:          row.Mode,
+					StartedAt:     row.StartedAt,
+					SeasonID:      row.SeasonID,
+					TeamRedScore:  int(row.TeamRedScore),
+					TeamBlueScore: int(row.TeamBlueScore),
+					Region:        row.Region,
+					Cluster:       row.Cluster,
+					Version:       row.Version,
+					Source:        row.Source,
+					Patch:         row.Patch,
+					CreatedAt:     row.MatchCreatedAt,
+					UpdatedAt:     row.MatchUpdatedAt,
+				},
+				PlayerStats: domain.MatchPlayer{
+					MatchID:     row.MatchID,
+					Puuid:       row.Puuid,
+					Name:        row.Name,
+					Tag:         row.Tag,
+					Tier:        int(row.Tier),
+					TierName:    row.TierName,
+					Kills:       int(row.Kills),
+					Deaths:      int(row.Deaths),
+					Assists:     int(row.Assists),
+					Score:       int(row.Score),
+					Team:        row.Team,
+					HasWon:      row.HasWon,
+					CharacterID: row.CharacterID,
+					DamageTaken: int(row.DamageTaken),
+					DamageDealt: int(row.DamageDealt),
+					ACS:         row.Acs,
+					ADR:         row.Adr,
+					KAST:        row.Kast,
+					FirstBloods: toIntPtr(row.FirstBloods),
+					FirstDeaths: toIntPtr(row.FirstDeaths),
+					PlusMinus:   int(row.PlusMinus),
+					Rating:      row.Rating,
+					CreatedAt:   row.MpCreatedAt,
+					UpdatedAt:   row.MpUpdatedAt,
+				},
+			},
+			Played:       int(row.Played),
+			PreviousTier: toIntPtr(row.PreviousTier),
+			PeakTier:     int(row.PeakTier),
+		}
+		if row.PreviousTierName != nil {
+			result.PreviousTierName = *row.PreviousTierName
+		}
+
+		if row.MmrID != nil {
+			result.MMRData = &domain.MMRHistory{
+				ID:            *row.MmrID,
+				MatchID:       row.MatchID,
+				Puuid:         row.Puuid,
+				Tier:          int(*row.MmrTier),
+				TierName:      *row.MmrTierName,
+				RankingInTier: int(*row.RankingInTier),
+				MMRChange:     int(*row.MmrChange),
+				Elo:           int(*row.Elo),
+				Date:          *row.MmrDate,
+				Source:        *row.MmrSource,
+				CreatedAt:     *row.MmrCreatedAt,
+				UpdatedAt:     *row.MmrUpdatedAt,
+			}
+		}
+
+		results[i] = result
+	}
+	return results, nil
+}
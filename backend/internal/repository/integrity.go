@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type IntegrityRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewIntegrityRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *IntegrityRepository {
+	return &IntegrityRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+type LobbySize struct {
+	MatchID     string
+	Mode        string
+	Source      string
+	PlayerCount int
+}
+
+type OrphanedMMRHistory struct {
+	ID      string
+	MatchID string
+	Puuid   string
+}
+
+type OutcomeMismatch struct {
+	MatchID       string
+	Puuid         string
+	Team          string
+	HasWon        bool
+	TeamRedScore  int
+	TeamBlueScore int
+}
+
+func (r *IntegrityRepository) GetLobbySizes(ctx context.Context) ([]LobbySize, error) {
+	rows, err := r.queries.GetMatchLobbySizes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]LobbySize, len(rows))
+	for i, row := range rows {
+		result[i] = LobbySize{
+			MatchID:     row.MatchID,
+			Mode:        row.Mode,
+			Source:      row.Source,
+			PlayerCount: int(row.PlayerCount),
+		}
+	}
+	return result, nil
+}
+
+func (r *IntegrityRepository) GetLobbySize(ctx context.Context, matchID string) (LobbySize, error) {
+	row, err := r.queries.GetMatchLobbySize(ctx, matchID)
+	if err != nil {
+		return LobbySize{}, err
+	}
+	return LobbySize{
+		MatchID:     row.MatchID,
+		Mode:        row.Mode,
+		Source:      row.Source,
+		PlayerCount: int(row.PlayerCount),
+	}, nil
+}
+
+func (r *IntegrityRepository) HasMatchPlayer(ctx context.Context, matchID, puuid string) (bool, error) {
+	count, err := r.queries.CountMatchPlayer(ctx, db.CountMatchPlayerParams{MatchID: matchID, Puuid: puuid})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *IntegrityRepository) GetOrphanedMMRHistories(ctx context.Context) ([]OrphanedMMRHistory, error) {
+	rows, err := r.queries.GetOrphanedMMRHistories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]OrphanedMMRHistory, len(rows))
+	for i, row := range rows {
+		result[i] = OrphanedMMRHistory{
+			ID:      row.ID,
+			MatchID: row.MatchID,
+			Puuid:   row.Puuid,
+		}
+	}
+	return result, nil
+}
+
+func (r *IntegrityRepository) GetStalePartialPlayers(ctx context.Context, olderThan time.Time, limit int) ([]domain.Player, error) {
+	players, err := r.queries.GetStalePartialPlayers(ctx, db.GetStalePartialPlayersParams{
+		UpdatedAt: olderThan,
+		Limit:     int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Player, len(players))
+	for i, p := range players {
+		result[i] = domain.Player{
+			Puuid:           p.Puuid,
+			Name:            p.Name,
+			Tag:             p.Tag,
+			Region:          p.Region,
+			AccountLevel:    int(p.AccountLevel),
+			Card:            p.Card,
+			Title:           p.Title,
+			CurrentTier:     int(p.CurrentTier),
+			CurrentTierName: p.CurrentTierName,
+			CurrentRR:       int(p.CurrentRr),
+			IsPartialFetch:  p.IsPartialFetch,
+			LastFetchAt:     p.LastFetchAt,
+			CreatedAt:       p.CreatedAt,
+			UpdatedAt:       p.UpdatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *IntegrityRepository) GetOutcomeMismatches(ctx context.Context) ([]OutcomeMismatch, error) {
+	rows, err := r.queries.GetOutcomeMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]OutcomeMismatch, len(rows))
+	for i, row := range rows {
+		result[i] = OutcomeMismatch{
+			MatchID:       row.MatchID,
+			Puuid:         row.Puuid,
+			Team:          row.Team,
+			HasWon:        row.HasWon,
+			TeamRedScore:  int(row.TeamRedScore),
+			TeamBlueScore: int(row.TeamBlueScore),
+		}
+	}
+	return result, nil
+}
+
+func (r *IntegrityRepository) SetOutcome(ctx context.Context, matchID, puuid string, hasWon bool) error {
+	return r.queries.UpdateMatchPlayerOutcome(ctx, db.UpdateMatchPlayerOutcomeParams{
+		HasWon:    hasWon,
+		UpdatedAt: time.Now(),
+		MatchID:   matchID,
+		Puuid:     puuid,
+	})
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type LeaderboardRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewLeaderboardRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *LeaderboardRepository {
+	return &LeaderboardRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+// GetEntries returns every player with at least filter.MinGames matching matches, unranked and unsorted.
+func (r *LeaderboardRepository) GetEntries(ctx context.Context, filter domain.LeaderboardFilter) ([]domain.LeaderboardEntry, error) {
+	rows, err := r.queries.GetLeaderboardRows(ctx, db.GetLeaderboardRowsParams{
+		Region:    nullableString(filter.Region),
+		SeasonID:  nullableString(filter.SeasonID),
+		GroupName: nullableString(filter.Group),
+		MinGames:  int64(filter.MinGames),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.LeaderboardEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := domain.LeaderboardEntry{
+			Puuid:    row.Puuid,
+			Name:     row.Name,
+			Tag:      row.Tag,
+			Region:   row.Region,
+			Tier:     int(row.CurrentTier),
+			TierName: row.CurrentTierName,
+			RR:       int(row.CurrentRr),
+			Games:    int(row.Games),
+			Wins:     int(row.Wins),
+			Kills:    int(row.Kills),
+			Deaths:   int(row.Deaths),
+		}
+		if row.RatedGames > 0 {
+			avg := row.RatingSum / float64(row.RatedGames)
+			entry.AvgRating = &avg
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (r *LeaderboardRepository) AddGroupMember(ctx context.Context, group, puuid string) error {
+	return r.queries.AddGroupMember(ctx, db.AddGroupMemberParams{
+		GroupName: group,
+		Puuid:     puuid,
+		CreatedAt: time.Now(),
+	})
+}
+
+func (r *LeaderboardRepository) RemoveGroupMember(ctx context.Context, group, puuid string) error {
+	return r.queries.RemoveGroupMember(ctx, db.RemoveGroupMemberParams{
+		GroupName: group,
+		Puuid:     puuid,
+	})
+}
+
+func (r *LeaderboardRepository) GetGroupMembers(ctx context.Context, group string) ([]string, error) {
+	return r.queries.GetGroupMembers(ctx, group)
+}
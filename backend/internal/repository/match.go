@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 	"valorant-tracker/internal/constants"
 	"valorant-tracker/internal/db"
@@ -31,6 +32,7 @@ type MatchWithPlayers struct {
 	Match       domain.Match
 	PlayerStats domain.MatchPlayer
 	MMRData     *domain.MMRHistory
+	Lobby       domain.LobbyRank
 }
 
 func (r *MatchRepository) GetByPUUID(ctx context.Context, puuid string) ([]MatchWithPlayers, error) {
@@ -43,9 +45,18 @@ func (r *MatchRepository) GetByPUUID(ctx context.Context, puuid string) ([]Match
 		return []MatchWithPlayers{}, nil
 	}
 
+	lobbies, err := r.getLobbyRanks(ctx, puuid)
+	if err != nil {
+		return nil, err
+	}
+
 	results := make([]MatchWithPlayers, len(rows))
 	for i, row := range rows {
+		lobby := lobbies[row.MatchID]
+		lobby.ExpectedPlayers = domain.ExpectedLobbySize(row.Mode)
+
 		result := MatchWithPlayers{
+			Lobby: lobby,
 			Match: domain.Match{
 				MatchID:       row.MatchID,
 				MapName:       row.MapName,
@@ -59,6 +70,7 @@ func (r *MatchRepository) GetByPUUID(ctx context.Context, puuid string) ([]Match
 				Cluster:       row.Cluster,
 				Version:       row.Version,
 				Source:        row.Source,
+				Patch:         row.Patch,
 				CreatedAt:     row.MatchCreatedAt,
 				UpdatedAt:     row.MatchUpdatedAt,
 			},
@@ -78,6 +90,13 @@ func (r *MatchRepository) GetByPUUID(ctx context.Context, puuid string) ([]Match
 				DamageTaken: int(row.DamageTaken),
 				Tag:         row.Tag,
 				DamageDealt: int(row.DamageDealt),
+				ACS:         row.Acs,
+				ADR:         row.Adr,
+				KAST:        row.Kast,
+				FirstBloods: toIntPtr(row.FirstBloods),
+				FirstDeaths: toIntPtr(row.FirstDeaths),
+				PlusMinus:   int(row.PlusMinus),
+				Rating:      row.Rating,
 				CreatedAt:   row.MpCreatedAt,
 				UpdatedAt:   row.MpUpdatedAt,
 			},
@@ -106,12 +125,43 @@ func (r *MatchRepository) GetByPUUID(ctx context.Context, puuid string) ([]Match
 	return results, nil
 }
 
+// getLobbyRanks sums the stored ranks per team of every match puuid played, keyed by match id.
+func (r *MatchRepository) getLobbyRanks(ctx context.Context, puuid string) (map[string]domain.LobbyRank, error) {
+	rows, err := r.queries.GetLobbyTiersByPuuid(ctx, db.GetLobbyTiersByPuuidParams{
+		MinTier: domain.MinRankedTier,
+		Puuid:   puuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lobbies := make(map[string]domain.LobbyRank)
+	for _, row := range rows {
+		lobby := lobbies[row.MatchID]
+		lobby.Teams = append(lobby.Teams, domain.TeamRank{
+			Team:          row.Team,
+			TierSum:       int(row.TierSum),
+			RankedPlayers: int(row.RankedPlayers),
+			StoredPlayers: int(row.StoredPlayers),
+		})
+		lobbies[row.MatchID] = lobby
+	}
+	for id, lobby := range lobbies {
+		lobby.Sort()
+		lobbies[id] = lobby
+	}
+	return lobbies, nil
+}
+
 func (r *MatchRepository) UpsertMatch(ctx context.Context, match *domain.Match) error {
+	if err := upsertPatch(ctx, r.queries, match); err != nil {
+		return err
+	}
 	return r.queries.UpsertMatch(ctx, db.UpsertMatchParams{
 		MatchID:       match.MatchID,
 		MapName:       match.MapName,
 		MapID:         match.MapID,
-		Mode:          match.Mode,
+		Mode:          domain.NormalizeMode(match.Mode),
 		StartedAt:     match.StartedAt,
 		SeasonID:      match.SeasonID,
 		TeamRedScore:  int64(match.TeamRedScore),
@@ -122,9 +172,30 @@ func (r *MatchRepository) UpsertMatch(ctx context.Context, match *domain.Match)
 		Source:        match.Source,
 		CreatedAt:     match.CreatedAt,
 		UpdatedAt:     match.UpdatedAt,
+		Patch:         match.Patch,
 	})
 }
 
+// upsertPatch sets match.Patch from its version and records when the patch was first seen.
+func upsertPatch(ctx context.Context, queries *db.Queries, match *domain.Match) error {
+	patch, ok := domain.ParsePatch(match.Version)
+	if !ok {
+		match.Patch = ""
+		return nil
+	}
+	match.Patch = patch.Name
+	err := queries.UpsertPatch(ctx, db.UpsertPatchParams{
+		Name:        patch.Name,
+		Major:       int64(patch.Major),
+		Minor:       int64(patch.Minor),
+		FirstSeenAt: match.StartedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert patch %s: %w", patch.Name, err)
+	}
+	return nil
+}
+
 func (r *MatchRepository) UpsertMatchPlayer(ctx context.Context, matchPlayer *domain.MatchPlayer) error {
 	return r.queries.UpsertMatchPlayer(ctx, db.UpsertMatchPlayerParams{
 		MatchID:     matchPlayer.MatchID,
@@ -144,6 +215,14 @@ func (r *MatchRepository) UpsertMatchPlayer(ctx context.Context, matchPlayer *do
 		DamageDealt: int64(matchPlayer.DamageDealt),
 		CreatedAt:   matchPlayer.CreatedAt,
 		UpdatedAt:   matchPlayer.UpdatedAt,
+		Acs:         matchPlayer.ACS,
+		Adr:         matchPlayer.ADR,
+		Kast:        matchPlayer.KAST,
+		FirstBloods: toInt64Ptr(matchPlayer.FirstBloods),
+		FirstDeaths: toInt64Ptr(matchPlayer.FirstDeaths),
+		PlusMinus:   int64(matchPlayer.PlusMinus),
+		Rating:      matchPlayer.Rating,
+		PartyID:     matchPlayer.PartyID,
 	})
 }
 
@@ -164,11 +243,14 @@ func (r *MatchRepository) UpsertBatch(ctx context.Context, matches []domain.Matc
 			}
 
 			for _, match := range matches[i:end] {
+				if err := upsertPatch(ctx, qtx, &match); err != nil {
+					return err
+				}
 				err := qtx.UpsertMatch(ctx, db.UpsertMatchParams{
 					MatchID:       match.MatchID,
 					MapName:       match.MapName,
 					MapID:         match.MapID,
-					Mode:          match.Mode,
+					Mode:          domain.NormalizeMode(match.Mode),
 					StartedAt:     match.StartedAt,
 					SeasonID:      match.SeasonID,
 					TeamRedScore:  int64(match.TeamRedScore),
@@ -179,6 +261,7 @@ func (r *MatchRepository) UpsertBatch(ctx context.Context, matches []domain.Matc
 					Source:        match.Source,
 					CreatedAt:     match.CreatedAt,
 					UpdatedAt:     match.UpdatedAt,
+					Patch:         match.Patch,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to upsert match %s: %w", match.MatchID, err)
@@ -213,6 +296,14 @@ func (r *MatchRepository) UpsertBatch(ctx context.Context, matches []domain.Matc
 					DamageDealt: int64(mp.DamageDealt),
 					CreatedAt:   mp.CreatedAt,
 					UpdatedAt:   mp.UpdatedAt,
+					Acs:         mp.ACS,
+					Adr:         mp.ADR,
+					Kast:        mp.KAST,
+					FirstBloods: toInt64Ptr(mp.FirstBloods),
+					FirstDeaths: toInt64Ptr(mp.FirstDeaths),
+					PlusMinus:   int64(mp.PlusMinus),
+					Rating:      mp.Rating,
+					PartyID:     mp.PartyID,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to upsert match player %s/%s: %w", mp.MatchID, mp.Puuid, err)
@@ -235,6 +326,13 @@ func (r *MatchRepository) GetLatestMatchDate(ctx context.Context, puuid string)
 	return &startedAt, nil
 }
 
+func (r *MatchRepository) GetRecentStartTimes(ctx context.Context, puuid string, limit int) ([]time.Time, error) {
+	return r.queries.GetRecentMatchStartTimes(ctx, db.GetRecentMatchStartTimesParams{
+		Puuid: puuid,
+		Limit: int64(limit),
+	})
+}
+
 func (r *MatchRepository) HasStoredGames(ctx context.Context, puuid string) (bool, error) {
 	count, err := r.queries.CountStoredGames(ctx, puuid)
 	if err != nil {
@@ -267,6 +365,14 @@ func (r *MatchRepository) GetByMatchID(ctx context.Context, matchID string) ([]d
 			DamageTaken: int(p.DamageTaken),
 			Tag:         p.Tag,
 			DamageDealt: int(p.DamageDealt),
+			ACS:         p.Acs,
+			ADR:         p.Adr,
+			KAST:        p.Kast,
+			FirstBloods: toIntPtr(p.FirstBloods),
+			FirstDeaths: toIntPtr(p.FirstDeaths),
+			PlusMinus:   int(p.PlusMinus),
+			Rating:      p.Rating,
+			PartyID:     p.PartyID,
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
 		}
@@ -293,7 +399,220 @@ func (r *MatchRepository) GetMatchMetadata(ctx context.Context, matchID string)
 		Cluster:       match.Cluster,
 		Version:       match.Version,
 		Source:        match.Source,
+		Patch:         match.Patch,
 		CreatedAt:     match.CreatedAt,
 		UpdatedAt:     match.UpdatedAt,
 	}, nil
 }
+
+// GetStoredMatchIDs returns which of matchIDs already have a row for puuid.
+func (r *MatchRepository) GetStoredMatchIDs(ctx context.Context, puuid string, matchIDs []string) (map[string]bool, error) {
+	ids, err := r.queries.GetStoredMatchIDs(ctx, db.GetStoredMatchIDsParams{
+		Puuid:    puuid,
+		MatchIds: matchIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		stored[id] = true
+	}
+	return stored, nil
+}
+
+// GetRecentOutcomes returns has_won for the player's latest matches, newest first.
+func (r *MatchRepository) GetRecentOutcomes(ctx context.Context, puuid string, limit int) ([]bool, error) {
+	return r.queries.GetRecentMatchOutcomes(ctx, db.GetRecentMatchOutcomesParams{
+		Puuid: puuid,
+		Limit: int64(limit),
+	})
+}
+
+// ReplaceRounds overwrites the stored rounds, kill feed and per-player rounds of a match.
+func (r *MatchRepository) ReplaceRounds(ctx context.Context, matchID string, rounds []domain.MatchRound, kills []domain.MatchKill, playerRounds []domain.MatchPlayerRound) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteMatchRounds(ctx, matchID); err != nil {
+		return fmt.Errorf("failed to delete rounds of %s: %w", matchID, err)
+	}
+	if err := qtx.DeleteMatchKills(ctx, matchID); err != nil {
+		return fmt.Errorf("failed to delete kills of %s: %w", matchID, err)
+	}
+	if err := qtx.DeleteMatchPlayerRounds(ctx, matchID); err != nil {
+		return fmt.Errorf("failed to delete player rounds of %s: %w", matchID, err)
+	}
+
+	for _, round := range rounds {
+		err := qtx.InsertMatchRound(ctx, db.InsertMatchRoundParams{
+			MatchID:       matchID,
+			RoundNumber:   int64(round.RoundNumber),
+			WinningTeam:   round.WinningTeam,
+			AttackingTeam: round.AttackingTeam,
+			EndType:       round.EndType,
+			BombPlanted:   round.BombPlanted,
+			BombDefused:   round.BombDefused,
+			PlantSite:     round.PlantSite,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert round %d of %s: %w", round.RoundNumber, matchID, err)
+		}
+	}
+
+	for _, kill := range kills {
+		err := qtx.InsertMatchKill(ctx, db.InsertMatchKillParams{
+			MatchID:       matchID,
+			RoundNumber:   int64(kill.RoundNumber),
+			TimeInRoundMs: int64(kill.TimeInRoundMs),
+			KillerPuuid:   kill.KillerPuuid,
+			KillerTeam:    kill.KillerTeam,
+			VictimPuuid:   kill.VictimPuuid,
+			VictimTeam:    kill.VictimTeam,
+			Assistants:    strings.Join(kill.Assistants, ","),
+			WeaponID:      kill.WeaponID,
+			WeaponName:    kill.WeaponName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert kill in round %d of %s: %w", kill.RoundNumber, matchID, err)
+		}
+	}
+
+	for _, pr := range playerRounds {
+		err := qtx.InsertMatchPlayerRound(ctx, db.InsertMatchPlayerRoundParams{
+			MatchID:     matchID,
+			RoundNumber: int64(pr.RoundNumber),
+			Puuid:       pr.Puuid,
+			WeaponID:    pr.WeaponID,
+			WeaponName:  pr.WeaponName,
+			Headshots:   int64(pr.Headshots),
+			Bodyshots:   int64(pr.Bodyshots),
+			Legshots:    int64(pr.Legshots),
+			Damage:      int64(pr.Damage),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert round %d of %s for %s: %w", pr.RoundNumber, matchID, pr.Puuid, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetRounds returns the stored rounds and kill feed of a match. Both are nil when the match
+// was stored without rounds.
+func (r *MatchRepository) GetRounds(ctx context.Context, matchID string) ([]domain.MatchRound, []domain.MatchKill, error) {
+	roundRows, err := r.queries.GetMatchRounds(ctx, matchID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get rounds of %s: %w", matchID, err)
+	}
+	if len(roundRows) == 0 {
+		return nil, nil, nil
+	}
+	killRows, err := r.queries.GetMatchKills(ctx, matchID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get kills of %s: %w", matchID, err)
+	}
+
+	rounds := make([]domain.MatchRound, len(roundRows))
+	for i, row := range roundRows {
+		rounds[i] = domain.MatchRound{
+			MatchID:       row.MatchID,
+			RoundNumber:   int(row.RoundNumber),
+			WinningTeam:   row.WinningTeam,
+			AttackingTeam: row.AttackingTeam,
+			EndType:       row.EndType,
+			BombPlanted:   row.BombPlanted,
+			BombDefused:   row.BombDefused,
+			PlantSite:     row.PlantSite,
+		}
+	}
+
+	kills := make([]domain.MatchKill, len(killRows))
+	for i, row := range killRows {
+		var assistants []string
+		if row.Assistants != "" {
+			assistants = strings.Split(row.Assistants, ",")
+		}
+		kills[i] = domain.MatchKill{
+			MatchID:       row.MatchID,
+			RoundNumber:   int(row.RoundNumber),
+			TimeInRoundMs: int(row.TimeInRoundMs),
+			KillerPuuid:   row.KillerPuuid,
+			KillerTeam:    row.KillerTeam,
+			VictimPuuid:   row.VictimPuuid,
+			VictimTeam:    row.VictimTeam,
+			Assistants:    assistants,
+			WeaponID:      row.WeaponID,
+			WeaponName:    row.WeaponName,
+		}
+	}
+	return rounds, kills, nil
+}
+
+// ReplaceAwards overwrites the awards of a match.
+func (r *MatchRepository) ReplaceAwards(ctx context.Context, matchID string, awards []domain.MatchAward) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteMatchAwards(ctx, matchID); err != nil {
+		return fmt.Errorf("failed to delete awards of %s: %w", matchID, err)
+	}
+	for _, a := range awards {
+		err := qtx.InsertMatchAward(ctx, db.InsertMatchAwardParams{
+			MatchID: matchID,
+			Award:   a.Award,
+			Puuid:   a.Puuid,
+			Value:   a.Value,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to insert award %s of %s: %w", a.Award, matchID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *MatchRepository) GetAwards(ctx context.Context, matchID string) ([]domain.MatchAward, error) {
+	rows, err := r.queries.GetMatchAwards(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MatchAward, len(rows))
+	for i, row := range rows {
+		result[i] = domain.MatchAward{
+			MatchID: row.MatchID,
+			Award:   row.Award,
+			Puuid:   row.Puuid,
+			Value:   row.Value,
+		}
+	}
+	return result, nil
+}
+
+func toIntPtr(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func toInt64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
@@ -17,6 +17,13 @@ type MMRHistoryRepository struct {
 	logger  zerolog.Logger
 }
 
+// RatedGame is an RR change with the outcome of its match. Outcome is nil when the match
+// isn't stored.
+type RatedGame struct {
+	MMRHistory domain.MMRHistory
+	Outcome    *domain.Outcome
+}
+
 func NewMMRHistoryRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *MMRHistoryRepository {
 	return &MMRHistoryRepository{
 		queries: queries,
@@ -97,3 +104,43 @@ func (r *MMRHistoryRepository) GetByPuuid(ctx context.Context, puuid string, lim
 	}
 	return result, nil
 }
+
+// GetRatedGames returns the latest RR changes of a player newest first.
+func (r *MMRHistoryRepository) GetRatedGames(ctx context.Context, puuid string, limit int) ([]RatedGame, error) {
+	rows, err := r.queries.GetRatedGamesByPuuid(ctx, db.GetRatedGamesByPuuidParams{
+		Puuid: puuid,
+		Limit: int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]RatedGame, len(rows))
+	for i, row := range rows {
+		result[i].MMRHistory = domain.MMRHistory{
+			ID:            row.ID,
+			MatchID:       row.MatchID,
+			Puuid:         row.Puuid,
+			Tier:          int(row.Tier),
+			TierName:      row.TierName,
+			RankingInTier: int(row.RankingInTier),
+			MMRChange:     int(row.MmrChange),
+			Elo:           int(row.Elo),
+			Date:          row.Date,
+			Source:        row.Source,
+			CreatedAt:     row.CreatedAt,
+			UpdatedAt:     row.UpdatedAt,
+		}
+		if row.HasWon != nil && row.Drawn != nil {
+			outcome := domain.OutcomeLoss
+			switch {
+			case *row.HasWon:
+				outcome = domain.OutcomeWin
+			case *row.Drawn:
+				outcome = domain.OutcomeDraw
+			}
+			result[i].Outcome = &outcome
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog"
+)
+
+type OfficialLeaderboardRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewOfficialLeaderboardRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *OfficialLeaderboardRepository {
+	return &OfficialLeaderboardRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+// SaveSnapshot stores a full leaderboard for region in one transaction, so readers never see
+// a half-written snapshot.
+func (r *OfficialLeaderboardRepository) SaveSnapshot(ctx context.Context, region string, fetchedAt time.Time, entries []domain.OfficialLeaderboardEntry) (*domain.LeaderboardSnapshot, error) {
+	id, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.queries.WithTx(tx)
+	if err := qtx.InsertLeaderboardSnapshot(ctx, db.InsertLeaderboardSnapshotParams{
+		ID:        id,
+		Region:    region,
+		FetchedAt: fetchedAt,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to insert snapshot: %w", err)
+	}
+
+	for _, e := range entries {
+		if err := qtx.InsertLeaderboardEntry(ctx, db.InsertLeaderboardEntryParams{
+			SnapshotID:      id,
+			LeaderboardRank: int64(e.Rank),
+			Puuid:           e.Puuid,
+			Name:            e.Name,
+			Tag:             e.Tag,
+			Tier:            int64(e.Tier),
+			Rr:              int64(e.RR),
+			Wins:            int64(e.Wins),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to insert leaderboard rank %d: %w", e.Rank, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &domain.LeaderboardSnapshot{ID: id, Region: region, FetchedAt: fetchedAt}, nil
+}
+
+// GetRecentSnapshots returns up to limit snapshots of region, newest first.
+func (r *OfficialLeaderboardRepository) GetRecentSnapshots(ctx context.Context, region string, limit int) ([]domain.LeaderboardSnapshot, error) {
+	rows, err := r.queries.GetRecentLeaderboardSnapshots(ctx, db.GetRecentLeaderboardSnapshotsParams{
+		Region: region,
+		Limit:  int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]domain.LeaderboardSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, domain.LeaderboardSnapshot{ID: row.ID, Region: row.Region, FetchedAt: row.FetchedAt})
+	}
+	return snapshots, nil
+}
+
+// likeEscaper makes a search match literally under ESCAPE '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ListEntries pages through a snapshot by rank. previousID may be empty; search matches
+// anywhere in "name#tag".
+func (r *OfficialLeaderboardRepository) ListEntries(ctx context.Context, snapshotID, previousID, search string, afterRank, limit int) ([]domain.OfficialLeaderboardEntry, error) {
+	var pattern *string
+	if search != "" {
+		p := "%" + likeEscaper.Replace(search) + "%"
+		pattern = &p
+	}
+
+	rows, err := r.queries.ListLeaderboardEntries(ctx, db.ListLeaderboardEntriesParams{
+		PreviousSnapshotID: nullableString(previousID),
+		SnapshotID:         snapshotID,
+		AfterRank:          int64(afterRank),
+		Search:             pattern,
+		Limit:              int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.OfficialLeaderboardEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, domain.OfficialLeaderboardEntry{
+			Rank:         int(row.LeaderboardRank),
+			Puuid:        row.Puuid,
+			Name:         row.Name,
+			Tag:          row.Tag,
+			Tier:         int(row.Tier),
+			RR:           int(row.Rr),
+			Wins:         int(row.Wins),
+			PreviousRank: toIntPtr(row.PreviousRank),
+			Tracked:      row.Tracked,
+		})
+	}
+	return entries, nil
+}
+
+// GetPlacement returns the player's best rank across the latest snapshot of every region.
+func (r *OfficialLeaderboardRepository) GetPlacement(ctx context.Context, puuid string) (*domain.LeaderboardPlacement, error) {
+	row, err := r.queries.GetPlayerLeaderboardRank(ctx, puuid)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LeaderboardPlacement{Region: row.Region, Rank: int(row.LeaderboardRank)}, nil
+}
+
+// DeleteSnapshotsBefore prunes old snapshots together with their entries.
+func (r *OfficialLeaderboardRepository) DeleteSnapshotsBefore(ctx context.Context, before time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteLeaderboardEntriesBefore(ctx, before); err != nil {
+		return fmt.Errorf("failed to delete leaderboard entries: %w", err)
+	}
+	if err := qtx.DeleteLeaderboardSnapshotsBefore(ctx, before); err != nil {
+		return fmt.Errorf("failed to delete leaderboard snapshots: %w", err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,405 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type StatsRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewStatsRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *StatsRepository {
+	return &StatsRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+// GetAgentStats returns one row per agent, or per patch and agent when groupByPatch is set.
+func (r *StatsRepository) GetAgentStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.AgentStats, error) {
+	rows, err := r.queries.GetAgentStats(ctx, db.GetAgentStatsParams{
+		GroupByPatch:  groupByPatch,
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.AgentStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.AgentStats{
+			Patch:       row.Patch,
+			CharacterID: row.CharacterID,
+			Games:       int(row.Games),
+			Wins:        int(row.Wins),
+			Kills:       int(row.Kills),
+			Deaths:      int(row.Deaths),
+			Assists:     int(row.Assists),
+			DamageDealt: int(row.DamageDealt),
+			Rounds:      int(row.Rounds),
+			AvgScore:    row.AvgScore,
+			AvgRRChange: row.AvgRrChange,
+			RatedGames:  int(row.RatedGames),
+		}
+	}
+	return result, nil
+}
+
+// GetMapStats returns one row per map, or per patch and map when groupByPatch is set.
+func (r *StatsRepository) GetMapStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.MapStats, error) {
+	rows, err := r.queries.GetMapStats(ctx, db.GetMapStatsParams{
+		GroupByPatch:  groupByPatch,
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sides, err := r.queries.GetMapSideStats(ctx, db.GetMapSideStatsParams{
+		GroupByPatch:  groupByPatch,
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+	type sideKey struct{ patch, mapID string }
+	sidesByMap := make(map[sideKey]db.GetMapSideStatsRow, len(sides))
+	for _, side := range sides {
+		sidesByMap[sideKey{side.Patch, side.MapID}] = side
+	}
+
+	result := make([]domain.MapStats, len(rows))
+	for i, row := range rows {
+		side := sidesByMap[sideKey{row.Patch, row.MapID}]
+		result[i] = domain.MapStats{
+			Patch:     row.Patch,
+			MapID:     row.MapID,
+			MapName:   row.MapName,
+			Games:     int(row.Games),
+			Wins:      int(row.Wins),
+			RoundsWon: int(row.RoundsWon),
+			Rounds:    int(row.Rounds),
+			Kills:     int(row.Kills),
+			Deaths:    int(row.Deaths),
+			Score:     int(row.Score),
+
+			AttackRoundsWon:  int(side.AttackRoundsWon),
+			AttackRounds:     int(side.AttackRounds),
+			DefenseRoundsWon: int(side.DefenseRoundsWon),
+			DefenseRounds:    int(side.DefenseRounds),
+		}
+	}
+	return result, nil
+}
+
+// GetOverallStats returns a single row, or one per patch when groupByPatch is set. It's empty
+// when no match is left after filtering.
+func (r *StatsRepository) GetOverallStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.OverallStats, error) {
+	rows, err := r.queries.GetOverallStats(ctx, db.GetOverallStatsParams{
+		GroupByPatch:  groupByPatch,
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []domain.OverallStats
+	for _, row := range rows {
+		if row.Games == 0 {
+			continue
+		}
+		result = append(result, domain.OverallStats{
+			Patch:       row.Patch,
+			Games:       int(row.Games),
+			Wins:        int(row.Wins),
+			Draws:       int(row.Draws),
+			Kills:       int(row.Kills),
+			Deaths:      int(row.Deaths),
+			Assists:     int(row.Assists),
+			DamageDealt: int(row.DamageDealt),
+			Score:       int(row.Score),
+			Rounds:      int(row.Rounds),
+			RRChange:    int(row.RrChange),
+			RatedGames:  int(row.RatedGames),
+		})
+	}
+	return result, nil
+}
+
+// GetOpponentStrength returns the player's matches oldest first with the stored ranks of both sides.
+func (r *StatsRepository) GetOpponentStrength(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.OpponentStrength, error) {
+	rows, err := r.queries.GetOpponentTiers(ctx, db.GetOpponentTiersParams{
+		MinTier:       domain.MinRankedTier,
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.OpponentStrength, len(rows))
+	for i, row := range rows {
+		result[i] = domain.OpponentStrength{
+			MatchID:   row.MatchID,
+			StartedAt: row.StartedAt,
+			Mode:      row.Mode,
+			Team: domain.TeamRank{
+				TierSum:       int(row.TeamTierSum),
+				RankedPlayers: int(row.TeamRanked),
+				StoredPlayers: int(row.TeamStored),
+			},
+			Opponents: domain.TeamRank{
+				TierSum:       int(row.OpponentTierSum),
+				RankedPlayers: int(row.OpponentRanked),
+				StoredPlayers: int(row.OpponentStored),
+			},
+			ExpectedOpponents: domain.ExpectedOpponents(row.Mode),
+		}
+	}
+	return result, nil
+}
+
+// GetTeamMatches returns the player's matches newest first, each with the teammates stored for it.
+func (r *StatsRepository) GetTeamMatches(ctx context.Context, puuid string) ([]domain.TeamMatch, error) {
+	rows, err := r.queries.GetTeammateRows(ctx, puuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []domain.TeamMatch
+	for _, row := range rows {
+		if len(result) == 0 || result[len(result)-1].MatchID != row.MatchID {
+			match := domain.TeamMatch{
+				MatchID: row.MatchID,
+				PartyID: row.PartyID,
+				HasWon:  row.HasWon,
+				Kills:   int(row.Kills),
+				Deaths:  int(row.Deaths),
+			}
+			if row.MmrChange != nil {
+				change := int(*row.MmrChange)
+				match.MMRChange = &change
+			}
+			result = append(result, match)
+		}
+
+		if row.TeammatePuuid != nil {
+			last := &result[len(result)-1]
+			last.Teammates = append(last.Teammates, domain.Teammate{
+				Puuid:   *row.TeammatePuuid,
+				Name:    *row.TeammateName,
+				Tag:     *row.TeammateTag,
+				PartyID: *row.TeammatePartyID,
+			})
+		}
+	}
+	return result, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullableMode(mode string) *string {
+	return nullableString(domain.NormalizeMode(mode))
+}
+
+// GetMatchTimes returns the player's matches oldest first with the fields the heatmap buckets.
+func (r *StatsRepository) GetMatchTimes(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.MatchTime, error) {
+	rows, err := r.queries.GetMatchTimes(ctx, db.GetMatchTimesParams{
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.MatchTime, len(rows))
+	for i, row := range rows {
+		outcome := domain.OutcomeLoss
+		switch {
+		case row.HasWon:
+			outcome = domain.OutcomeWin
+		case row.TeamRedScore == row.TeamBlueScore:
+			outcome = domain.OutcomeDraw
+		}
+		result[i] = domain.MatchTime{
+			StartedAt: row.StartedAt,
+			Outcome:   outcome,
+			Kills:     int(row.Kills),
+			Deaths:    int(row.Deaths),
+			Score:     int(row.Score),
+			Rounds:    int(row.TeamRedScore + row.TeamBlueScore),
+			Rating:    row.Rating,
+		}
+		if row.MmrChange != nil {
+			change := int(*row.MmrChange)
+			result[i].RRChange = &change
+		}
+	}
+	return result, nil
+}
+
+// ListPatches returns every recorded patch newest first, with how many stored matches were
+// played on it.
+func (r *StatsRepository) ListPatches(ctx context.Context) ([]domain.Patch, error) {
+	rows, err := r.queries.ListPatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Patch, len(rows))
+	for i, row := range rows {
+		result[i] = domain.Patch{
+			Name:        row.Name,
+			Major:       int(row.Major),
+			Minor:       int(row.Minor),
+			FirstSeenAt: row.FirstSeenAt,
+			Matches:     int(row.Matches),
+		}
+	}
+	return result, nil
+}
+
+// GetPatchMeta returns how every agent did across all stored players on the patch, most
+// picked first, and how many stored matches were played on it.
+func (r *StatsRepository) GetPatchMeta(ctx context.Context, patch, mode string) ([]domain.PatchMeta, int, error) {
+	total, err := r.queries.CountPatchMatches(ctx, db.CountPatchMatchesParams{
+		Patch: patch,
+		Mode:  nullableMode(mode),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.queries.GetPatchMeta(ctx, db.GetPatchMetaParams{
+		Patch: patch,
+		Mode:  nullableMode(mode),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]domain.PatchMeta, len(rows))
+	for i, row := range rows {
+		result[i] = domain.PatchMeta{
+			CharacterID: row.CharacterID,
+			Games:       int(row.Games),
+			Matches:     int(row.Matches),
+			Wins:        int(row.Wins),
+			Kills:       int(row.Kills),
+			Deaths:      int(row.Deaths),
+		}
+	}
+	return result, int(total), nil
+}
+
+// GetClusterStats returns the player's games per server cluster, most played first. Matches
+// without a cluster are left out.
+func (r *StatsRepository) GetClusterStats(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.ClusterStats, error) {
+	rows, err := r.queries.GetClusterStats(ctx, db.GetClusterStatsParams{
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.ClusterStats, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ClusterStats{
+			Cluster:     row.Cluster,
+			Games:       int(row.Games),
+			Wins:        int(row.Wins),
+			Draws:       int(row.Draws),
+			Kills:       int(row.Kills),
+			Deaths:      int(row.Deaths),
+			DamageDealt: int(row.DamageDealt),
+			Score:       int(row.Score),
+			Rounds:      int(row.Rounds),
+			RRChange:    int(row.RrChange),
+			RatedGames:  int(row.RatedGames),
+			RatingSum:   row.RatingSum,
+			Rated:       int(row.Rated),
+		}
+	}
+	return result, nil
+}
+
+// GetClusterDistribution returns every stored match per cluster and region, most matches first.
+// Empty arguments don't filter.
+func (r *StatsRepository) GetClusterDistribution(ctx context.Context, region, mode, patch string) ([]domain.ClusterShare, error) {
+	rows, err := r.queries.GetClusterDistribution(ctx, db.GetClusterDistributionParams{
+		Region: nullableString(region),
+		Mode:   nullableMode(mode),
+		Patch:  nullableString(patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.ClusterShare, len(rows))
+	for i, row := range rows {
+		result[i] = domain.ClusterShare{
+			Cluster:      row.Cluster,
+			Region:       row.Region,
+			Matches:      int(row.Matches),
+			Players:      int(row.Players),
+			PlayerGames:  int(row.PlayerGames),
+			PlayerRounds: int(row.PlayerRounds),
+			RatingSum:    row.RatingSum,
+			Rated:        int(row.Rated),
+		}
+	}
+	return result, nil
+}
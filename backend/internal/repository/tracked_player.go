@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type TrackedPlayerRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewTrackedPlayerRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *TrackedPlayerRepository {
+	return &TrackedPlayerRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+// Track adds a player to the refresh set for one source. A source that already tracks the
+// player keeps its schedule.
+func (r *TrackedPlayerRepository) Track(ctx context.Context, puuid, source string, interval time.Duration) error {
+	now := time.Now()
+	return r.queries.TrackPlayer(ctx, db.TrackPlayerParams{
+		Puuid:                  puuid,
+		Source:                 source,
+		RefreshIntervalSeconds: int64(interval.Seconds()),
+		NextRefreshAt:          now,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	})
+}
+
+func (r *TrackedPlayerRepository) Untrack(ctx context.Context, puuid, source string) error {
+	return r.queries.UntrackPlayer(ctx, db.UntrackPlayerParams{
+		Puuid:  puuid,
+		Source: source,
+	})
+}
+
+// GetDue returns one row per due player, the earliest due of its sources.
+func (r *TrackedPlayerRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]domain.TrackedPlayer, error) {
+	rows, err := r.queries.GetDueTrackedPlayers(ctx, db.GetDueTrackedPlayersParams{
+		NextRefreshAt: now,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.TrackedPlayer, len(rows))
+	for i, row := range rows {
+		result[i] = domain.TrackedPlayer{
+			Puuid:           row.Puuid,
+			Source:          row.Source,
+			RefreshInterval: time.Duration(row.RefreshIntervalSeconds) * time.Second,
+			NextRefreshAt:   row.NextRefreshAt,
+			LastRefreshAt:   row.LastRefreshAt,
+			CreatedAt:       row.CreatedAt,
+			UpdatedAt:       row.UpdatedAt,
+		}
+	}
+	return result, nil
+}
+
+// Reschedule moves every source of the player, they share one schedule.
+func (r *TrackedPlayerRepository) Reschedule(ctx context.Context, puuid string, interval time.Duration, lastRefreshAt *time.Time) error {
+	now := time.Now()
+	return r.queries.UpdateTrackedPlayerSchedule(ctx, db.UpdateTrackedPlayerScheduleParams{
+		RefreshIntervalSeconds: int64(interval.Seconds()),
+		NextRefreshAt:          now.Add(interval),
+		LastRefreshAt:          lastRefreshAt,
+		UpdatedAt:              now,
+		Puuid:                  puuid,
+	})
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	"github.com/rs/zerolog"
+)
+
+type WeaponRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewWeaponRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *WeaponRepository {
+	return &WeaponRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+type weaponSliceKey struct {
+	weaponID, mapID, seasonID string
+}
+
+// GetWeaponSlices returns the player's kills and loadout rounds per weapon, map and season, in
+// no particular order.
+func (r *WeaponRepository) GetWeaponSlices(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.WeaponSlice, error) {
+	kills, err := r.queries.GetWeaponKills(ctx, db.GetWeaponKillsParams{
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rounds, err := r.queries.GetWeaponRounds(ctx, db.GetWeaponRoundsParams{
+		Puuid:         puuid,
+		SeasonID:      nullableString(filter.SeasonID),
+		Mode:          nullableMode(filter.Mode),
+		StartedAfter:  filter.StartedAfter,
+		StartedBefore: filter.StartedBefore,
+		CharacterID:   nullableString(filter.CharacterID),
+		Patch:         nullableString(filter.Patch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var slices []domain.WeaponSlice
+	index := make(map[weaponSliceKey]int)
+	slice := func(weaponID, weaponName, mapID, mapName, seasonID string, firstPlayedAt int64) *domain.WeaponSlice {
+		key := weaponSliceKey{weaponID, mapID, seasonID}
+		started := time.Unix(firstPlayedAt, 0).UTC()
+		i, ok := index[key]
+		if !ok {
+			i = len(slices)
+			index[key] = i
+			slices = append(slices, domain.WeaponSlice{
+				WeaponID:      weaponID,
+				WeaponName:    weaponName,
+				MapID:         mapID,
+				MapName:       mapName,
+				SeasonID:      seasonID,
+				FirstPlayedAt: started,
+			})
+		}
+		if started.Before(slices[i].FirstPlayedAt) {
+			slices[i].FirstPlayedAt = started
+		}
+		return &slices[i]
+	}
+
+	for _, row := range kills {
+		s := slice(row.WeaponID, row.WeaponName, row.MapID, row.MapName, row.SeasonID, row.FirstPlayedAt)
+		s.Kills = int(row.Kills)
+	}
+	for _, row := range rounds {
+		s := slice(row.WeaponID, row.WeaponName, row.MapID, row.MapName, row.SeasonID, row.FirstPlayedAt)
+		s.Rounds = int(row.Rounds)
+		s.RoundKills = int(row.RoundKills)
+		s.Headshots = int(row.Headshots)
+		s.Bodyshots = int(row.Bodyshots)
+		s.Legshots = int(row.Legshots)
+		s.Damage = int(row.Damage)
+	}
+	return slices, nil
+}
+
+// GetWeaponMeta returns every weapon across all stored matches in no particular order, with
+// the player rounds in those matches. Empty arguments don't filter.
+func (r *WeaponRepository) GetWeaponMeta(ctx context.Context, region, mode, patch string) ([]domain.WeaponMeta, int, error) {
+	kills, err := r.queries.GetWeaponMetaKills(ctx, db.GetWeaponMetaKillsParams{
+		Region: nullableString(region),
+		Mode:   nullableMode(mode),
+		Patch:  nullableString(patch),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rounds, err := r.queries.GetWeaponMetaRounds(ctx, db.GetWeaponMetaRoundsParams{
+		Region: nullableString(region),
+		Mode:   nullableMode(mode),
+		Patch:  nullableString(patch),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var metas []domain.WeaponMeta
+	index := make(map[string]int)
+	meta := func(weaponID, weaponName string) *domain.WeaponMeta {
+		i, ok := index[weaponID]
+		if !ok {
+			i = len(metas)
+			index[weaponID] = i
+			metas = append(metas, domain.WeaponMeta{WeaponID: weaponID, WeaponName: weaponName})
+		}
+		return &metas[i]
+	}
+
+	for _, row := range kills {
+		meta(row.WeaponID, row.WeaponName).Kills = int(row.Kills)
+	}
+	// rounds without a weapon only count towards the total
+	var playerRounds int
+	for _, row := range rounds {
+		playerRounds += int(row.Rounds)
+		if row.WeaponID == "" {
+			continue
+		}
+		m := meta(row.WeaponID, row.WeaponName)
+		m.Rounds = int(row.Rounds)
+		m.Headshots = int(row.Headshots)
+		m.Bodyshots = int(row.Bodyshots)
+		m.Legshots = int(row.Legshots)
+		m.Damage = int(row.Damage)
+	}
+	return metas, playerRounds, nil
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"valorant-tracker/internal/db"
+	"valorant-tracker/internal/domain"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog"
+)
+
+type WebhookRepository struct {
+	queries *db.Queries
+	db      *sql.DB
+	logger  zerolog.Logger
+}
+
+func NewWebhookRepository(sqlDB *sql.DB, queries *db.Queries, logger zerolog.Logger) *WebhookRepository {
+	return &WebhookRepository{
+		queries: queries,
+		db:      sqlDB,
+		logger:  logger,
+	}
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	id, err := gonanoid.New()
+	if err != nil {
+		return err
+	}
+
+	var puuid *string
+	if sub.Puuid != "" {
+		puuid = &sub.Puuid
+	}
+	var threshold *int64
+	if sub.RRThreshold != nil {
+		v := int64(*sub.RRThreshold)
+		threshold = &v
+	}
+
+	now := time.Now()
+	if err := r.queries.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		ID:          id,
+		Url:         sub.URL,
+		Secret:      sub.Secret,
+		EventTypes:  strings.Join(sub.EventTypes, ","),
+		Puuid:       puuid,
+		RrThreshold: threshold,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}); err != nil {
+		return err
+	}
+
+	sub.ID = id
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+	return nil
+}
+
+// DeleteSubscription removes the subscription and its delivery log. It returns sql.ErrNoRows if there was none.
+// DeleteSubscription removes a subscription together with its deliveries.
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteWebhookDeliveriesBySubscription(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete deliveries of %s: %w", id, err)
+	}
+	n, err := qtx.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return tx.Commit()
+}
+
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := r.queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.WebhookSubscription, len(rows))
+	for i, row := range rows {
+		sub := domain.WebhookSubscription{
+			ID:         row.ID,
+			URL:        row.Url,
+			Secret:     row.Secret,
+			EventTypes: strings.Split(row.EventTypes, ","),
+			CreatedAt:  row.CreatedAt,
+			UpdatedAt:  row.UpdatedAt,
+		}
+		if row.Puuid != nil {
+			sub.Puuid = *row.Puuid
+		}
+		if row.RrThreshold != nil {
+			v := int(*row.RrThreshold)
+			sub.RRThreshold = &v
+		}
+		result[i] = sub
+	}
+	return result, nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, subscriptionID, eventID, eventType, payload string) error {
+	id, err := gonanoid.New()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return r.queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         string(domain.WebhookDeliveryPending),
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+}
+
+func (r *WebhookRepository) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	rows, err := r.queries.GetDueWebhookDeliveries(ctx, db.GetDueWebhookDeliveriesParams{
+		NextAttemptAt: now,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainDeliveries(rows), nil
+}
+
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	var sub *string
+	if subscriptionID != "" {
+		sub = &subscriptionID
+	}
+
+	rows, err := r.queries.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: sub,
+		Limit:          int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainDeliveries(rows), nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, d *domain.WebhookDelivery) error {
+	var statusCode *int64
+	if d.LastStatusCode != nil {
+		v := int64(*d.LastStatusCode)
+		statusCode = &v
+	}
+
+	d.UpdatedAt = time.Now()
+	return r.queries.UpdateWebhookDeliveryAttempt(ctx, db.UpdateWebhookDeliveryAttemptParams{
+		Status:         string(d.Status),
+		Attempts:       int64(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: statusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		UpdatedAt:      d.UpdatedAt,
+		ID:             d.ID,
+	})
+}
+
+func toDomainDeliveries(rows []db.WebhookDelivery) []domain.WebhookDelivery {
+	result := make([]domain.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		d := domain.WebhookDelivery{
+			ID:             row.ID,
+			SubscriptionID: row.SubscriptionID,
+			EventID:        row.EventID,
+			EventType:      row.EventType,
+			Payload:        row.Payload,
+			Status:         domain.WebhookDeliveryStatus(row.Status),
+			Attempts:       int(row.Attempts),
+			NextAttemptAt:  row.NextAttemptAt,
+			LastError:      row.LastError,
+			DeliveredAt:    row.DeliveredAt,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		}
+		if row.LastStatusCode != nil {
+			v := int(*row.LastStatusCode)
+			d.LastStatusCode = &v
+		}
+		result[i] = d
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) requireAdmin(header http.Header) error {
+	if s.cfg.AdminAPIKey == "" {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("admin API is disabled"))
+	}
+	want := "Bearer " + s.cfg.AdminAPIKey
+	if subtle.ConstantTimeCompare([]byte(header.Get("Authorization")), []byte(want)) != 1 {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid admin credentials"))
+	}
+	return nil
+}
+
+func (s *TrackerServer) GetIntegrityReport(ctx context.Context, req *connect.Request[valorantv1.GetIntegrityReportRequest]) (*connect.Response[valorantv1.GetIntegrityReportResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	report := s.reconciler.LastReport()
+	if req.Msg.Run {
+		var err error
+		report, err = s.reconciler.Run(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if report == nil {
+		return connect.NewResponse(&valorantv1.GetIntegrityReportResponse{}), nil
+	}
+
+	return connect.NewResponse(s.toProtoIntegrityReport(report)), nil
+}
+
+func (s *TrackerServer) toProtoIntegrityReport(report *domain.IntegrityReport) *valorantv1.GetIntegrityReportResponse {
+	issues := make([]*valorantv1.IntegrityIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, &valorantv1.IntegrityIssue{
+			Kind:     string(issue.Kind),
+			MatchId:  issue.MatchID,
+			Puuid:    issue.Puuid,
+			Detail:   issue.Detail,
+			Repaired: issue.Repaired,
+			Deferred: issue.Deferred,
+		})
+	}
+
+	return &valorantv1.GetIntegrityReportResponse{
+		StartedAt:      report.StartedAt.Unix(),
+		FinishedAt:     report.FinishedAt.Unix(),
+		MatchesScanned: int32(report.MatchesScanned),
+		Repaired:       int32(report.Repaired()),
+		Deferred:       int32(report.Deferred()),
+		Issues:         issues,
+	}
+}
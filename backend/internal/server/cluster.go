@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/metrics"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetClusterStats(ctx context.Context, req *connect.Request[valorantv1.GetClusterStatsRequest]) (*connect.Response[valorantv1.GetClusterStatsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	clusters, err := s.statsSvc.GetClusterStats(ctx, req.Msg.Puuid, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	overall, err := s.statsSvc.GetOverallStats(ctx, req.Msg.Puuid, filter, false)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetClusterStatsResponse{}
+	if len(overall) > 0 {
+		resp.Overall = toProtoOverallStats(overall[0])
+	}
+	for _, c := range clusters {
+		stats := &valorantv1.ClusterStats{
+			Cluster:    c.Cluster,
+			Games:      int32(c.Games),
+			Wins:       int32(c.Wins),
+			Losses:     int32(c.Losses()),
+			Draws:      int32(c.Draws),
+			WinRate:    float32(c.WinRate()),
+			KdRatio:    float32(c.KD()),
+			Acs:        float32(metrics.ACS(c.Score, c.Rounds)),
+			Adr:        float32(metrics.ADR(c.DamageDealt, c.Rounds)),
+			RatedGames: int32(c.RatedGames),
+		}
+		if avg, ok := c.AvgRRChange(); ok {
+			stats.AvgRrChange = proto.Float32(float32(avg))
+		}
+		if avg, ok := c.AvgRating(); ok {
+			stats.AvgRating = proto.Float32(float32(avg))
+		}
+		resp.Clusters = append(resp.Clusters, stats)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetClusterDistribution(ctx context.Context, req *connect.Request[valorantv1.GetClusterDistributionRequest]) (*connect.Response[valorantv1.GetClusterDistributionResponse], error) {
+	shares, err := s.statsSvc.GetClusterDistribution(ctx, req.Msg.Region, req.Msg.Mode, req.Msg.Patch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// every match has a single cluster, so the rows add up to the total
+	var total int
+	for _, c := range shares {
+		total += c.Matches
+	}
+
+	resp := &valorantv1.GetClusterDistributionResponse{Matches: int32(total)}
+	for _, c := range shares {
+		share := &valorantv1.ClusterShare{
+			Cluster:   c.Cluster,
+			Region:    c.Region,
+			Matches:   int32(c.Matches),
+			Share:     float32(c.Matches) / float32(total),
+			Players:   int32(c.Players),
+			AvgRounds: float32(c.AvgRounds()),
+		}
+		if avg, ok := c.AvgRating(); ok {
+			share.AvgRating = proto.Float32(float32(avg))
+		}
+		resp.Clusters = append(resp.Clusters, share)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) ComparePlayers(ctx context.Context, req *connect.Request[valorantv1.ComparePlayersRequest]) (*connect.Response[valorantv1.ComparePlayersResponse], error) {
+	if req.Msg.Days < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("days must not be negative"))
+	}
+
+	refs := make([]domain.PlayerRef, len(req.Msg.Players))
+	for i, p := range req.Msg.Players {
+		refs[i] = domain.PlayerRef{Puuid: p.Puuid, Name: p.Name, Tag: p.Tag}
+	}
+	window := time.Duration(req.Msg.Days) * 24 * time.Hour
+
+	comparison, err := s.compareSvc.ComparePlayers(ctx, refs, window, req.Msg.Refresh)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidComparison) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.ComparePlayersResponse{
+		Agents: toProtoUsageOverlaps(comparison.Agents),
+		Maps:   toProtoUsageOverlaps(comparison.Maps),
+	}
+	for _, p := range comparison.Players {
+		compared := &valorantv1.ComparedPlayer{Player: s.toProtoPlayerWithStats(p.Player, p.Matches)}
+		for _, point := range p.RankTimeline {
+			compared.RankTimeline = append(compared.RankTimeline, &valorantv1.RankPoint{
+				MatchId:       point.MatchID,
+				Date:          point.Date.Format(time.RFC3339),
+				Tier:          &valorantv1.Tier{Id: int32(point.Tier), Name: point.TierName},
+				RankingInTier: int32(point.RankingInTier),
+				MmrChange:     int32(point.MMRChange),
+			})
+		}
+		resp.Players = append(resp.Players, compared)
+	}
+	for _, r := range comparison.Records {
+		resp.Records = append(resp.Records, &valorantv1.SharedRecord{
+			PuuidA:       r.PuuidA,
+			PuuidB:       r.PuuidB,
+			GamesWith:    int32(r.GamesWith),
+			WinsWith:     int32(r.WinsWith),
+			GamesAgainst: int32(r.GamesAgainst),
+			WinsAgainst:  int32(r.WinsAgainst),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoUsageOverlaps(overlaps []domain.UsageOverlap) []*valorantv1.UsageOverlap {
+	var result []*valorantv1.UsageOverlap
+	for _, o := range overlaps {
+		overlap := &valorantv1.UsageOverlap{Id: o.ID, Name: o.Name}
+		for i := range o.Games {
+			overlap.Games = append(overlap.Games, int32(o.Games[i]))
+			overlap.Wins = append(overlap.Wins, int32(o.Wins[i]))
+		}
+		result = append(result, overlap)
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) GetEncounters(ctx context.Context, req *connect.Request[valorantv1.GetEncountersRequest]) (*connect.Response[valorantv1.GetEncountersResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	encounters, err := s.encounterSvc.GetEncounters(ctx, req.Msg.Puuid, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetEncountersResponse{}
+	for _, e := range encounters {
+		resp.Encounters = append(resp.Encounters, toProtoEncounter(e))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetEncounter(ctx context.Context, req *connect.Request[valorantv1.GetEncounterRequest]) (*connect.Response[valorantv1.GetEncounterResponse], error) {
+	if req.Msg.Puuid == "" || req.Msg.OtherPuuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid and other_puuid are required"))
+	}
+
+	encounter, err := s.encounterSvc.GetEncounter(ctx, req.Msg.Puuid, req.Msg.OtherPuuid, req.Msg.ExcludeMatchId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetEncounterResponse{}
+	if encounter != nil {
+		resp.Encounter = toProtoEncounter(*encounter)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoEncounter(e domain.Encounter) *valorantv1.Encounter {
+	return &valorantv1.Encounter{
+		Puuid:          e.Puuid,
+		Name:           e.Name,
+		Tag:            e.Tag,
+		GamesWith:      int32(e.GamesWith),
+		WinsWith:       int32(e.WinsWith),
+		WinRateWith:    float32(e.WinRateWith()),
+		GamesAgainst:   int32(e.GamesAgainst),
+		WinsAgainst:    int32(e.WinsAgainst),
+		WinRateAgainst: float32(e.WinRateAgainst()),
+		LastMatchId:    e.LastMatchID,
+		LastPlayedAt:   e.LastPlayedAt.Format(time.RFC3339),
+		LastAsTeammate: e.LastAsTeammate,
+	}
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) FollowPlayer(ctx context.Context, req *connect.Request[valorantv1.FollowPlayerRequest]) (*connect.Response[valorantv1.FollowPlayerResponse], error) {
+	if req.Msg.FollowerId == "" || req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("follower_id and puuid are required"))
+	}
+
+	if err := s.feedSvc.Follow(ctx, req.Msg.FollowerId, req.Msg.Puuid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.FollowPlayerResponse{}), nil
+}
+
+func (s *TrackerServer) UnfollowPlayer(ctx context.Context, req *connect.Request[valorantv1.UnfollowPlayerRequest]) (*connect.Response[valorantv1.UnfollowPlayerResponse], error) {
+	if req.Msg.FollowerId == "" || req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("follower_id and puuid are required"))
+	}
+
+	if err := s.feedSvc.Unfollow(ctx, req.Msg.FollowerId, req.Msg.Puuid); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.UnfollowPlayerResponse{}), nil
+}
+
+func (s *TrackerServer) GetFeed(ctx context.Context, req *connect.Request[valorantv1.GetFeedRequest]) (*connect.Response[valorantv1.GetFeedResponse], error) {
+	if req.Msg.FollowerId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("follower_id is required"))
+	}
+
+	items, next, err := s.feedSvc.GetFeed(ctx, req.Msg.FollowerId, req.Msg.Cursor, int(req.Msg.Limit))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetFeedResponse{NextCursor: next}
+	for _, item := range items {
+		resp.Items = append(resp.Items, s.toProtoFeedItem(item))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) toProtoFeedItem(item domain.FeedItem) *valorantv1.FeedItem {
+	out := &valorantv1.FeedItem{
+		Id:         item.ID,
+		Kind:       string(item.Kind),
+		OccurredAt: item.OccurredAt.Format(time.RFC3339),
+		Player:     s.toProtoPlayer(&item.Player),
+		Milestone:  item.Milestone,
+	}
+	if item.Match != nil && item.Stats != nil {
+		out.Match = s.toProtoMatch(*item.Match, *item.Stats, item.MMR)
+	}
+	if item.Kind == domain.FeedItemRankChange {
+		out.PreviousTier = &valorantv1.Tier{Id: int32(item.PreviousTier), Name: item.PreviousTierName}
+		// the match row carries the tier before the game, the mmr history the one after
+		if item.MMR != nil && out.Match != nil {
+			out.Match.Tier = &valorantv1.Tier{Id: int32(item.MMR.Tier), Name: item.MMR.TierName}
+		}
+	}
+	return out
+}
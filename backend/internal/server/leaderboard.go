@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetLeaderboard(ctx context.Context, req *connect.Request[valorantv1.GetLeaderboardRequest]) (*connect.Response[valorantv1.GetLeaderboardResponse], error) {
+	filter := domain.LeaderboardFilter{
+		Region:   req.Msg.Region,
+		SeasonID: req.Msg.SeasonId,
+		Group:    req.Msg.Group,
+		MinGames: int(req.Msg.MinGames),
+	}
+
+	entries, next, err := s.leaderboardSvc.GetLeaderboard(ctx, domain.LeaderboardMetric(req.Msg.Metric), filter, req.Msg.Cursor, int(req.Msg.Limit))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidLeaderboard) || errors.Is(err, service.ErrInvalidCursor) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetLeaderboardResponse{NextCursor: next}
+	for _, e := range entries {
+		entry := &valorantv1.LeaderboardEntry{
+			Rank:    int32(e.Rank),
+			Puuid:   e.Puuid,
+			Name:    e.Name,
+			Tag:     e.Tag,
+			Region:  e.Region,
+			Tier:    &valorantv1.Tier{Id: int32(e.Tier), Name: e.TierName},
+			Rr:      int32(e.RR),
+			Games:   int32(e.Games),
+			Wins:    int32(e.Wins),
+			WinRate: float32(e.WinRate()),
+			KdRatio: float32(e.KD()),
+		}
+		if e.AvgRating != nil {
+			entry.AvgRating = proto.Float32(float32(*e.AvgRating))
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) AddGroupMember(ctx context.Context, req *connect.Request[valorantv1.AddGroupMemberRequest]) (*connect.Response[valorantv1.AddGroupMemberResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	if err := s.leaderboardSvc.AddGroupMember(ctx, req.Msg.Group, req.Msg.Puuid); err != nil {
+		if errors.Is(err, service.ErrInvalidLeaderboard) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.AddGroupMemberResponse{}), nil
+}
+
+func (s *TrackerServer) RemoveGroupMember(ctx context.Context, req *connect.Request[valorantv1.RemoveGroupMemberRequest]) (*connect.Response[valorantv1.RemoveGroupMemberResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	if err := s.leaderboardSvc.RemoveGroupMember(ctx, req.Msg.Group, req.Msg.Puuid); err != nil {
+		if errors.Is(err, service.ErrInvalidLeaderboard) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.RemoveGroupMemberResponse{}), nil
+}
+
+func (s *TrackerServer) ListGroupMembers(ctx context.Context, req *connect.Request[valorantv1.ListGroupMembersRequest]) (*connect.Response[valorantv1.ListGroupMembersResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+	if req.Msg.Group == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group is required"))
+	}
+
+	puuids, err := s.leaderboardSvc.GetGroupMembers(ctx, req.Msg.Group)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.ListGroupMembersResponse{Puuids: puuids}), nil
+}
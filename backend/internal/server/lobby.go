@@ -0,0 +1,43 @@
+package server
+
+import (
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// matchLobbyRank rebuilds the lobby rank of a match response from its players' teams and tiers.
+func matchLobbyRank(resp *valorantv1.GetMatchResponse) *valorantv1.LobbyRank {
+	if resp.Metadata == nil {
+		return nil
+	}
+	players := make([]domain.MatchPlayer, len(resp.Players))
+	for i, p := range resp.Players {
+		players[i] = domain.MatchPlayer{Team: p.Team, Tier: int(p.GetTier().GetId())}
+	}
+	return toProtoLobbyRank(domain.NewLobbyRank(resp.Metadata.Mode, players))
+}
+
+func toProtoLobbyRank(l domain.LobbyRank) *valorantv1.LobbyRank {
+	lobby := &valorantv1.LobbyRank{
+		Lobby:           toProtoTeamRank(l.Lobby()),
+		ExpectedPlayers: int32(l.ExpectedPlayers),
+	}
+	for _, t := range l.Teams {
+		lobby.Teams = append(lobby.Teams, toProtoTeamRank(t))
+	}
+	return lobby
+}
+
+func toProtoTeamRank(t domain.TeamRank) *valorantv1.TeamRank {
+	rank := &valorantv1.TeamRank{
+		Team:          t.Team,
+		RankedPlayers: int32(t.RankedPlayers),
+		StoredPlayers: int32(t.StoredPlayers),
+	}
+	if avg, ok := t.AvgTier(); ok {
+		rank.AvgTier = proto.Float32(float32(avg))
+	}
+	return rank
+}
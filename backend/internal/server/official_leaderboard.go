@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetOfficialLeaderboard(ctx context.Context, req *connect.Request[valorantv1.GetOfficialLeaderboardRequest]) (*connect.Response[valorantv1.GetOfficialLeaderboardResponse], error) {
+	if req.Msg.Region == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("region is required"))
+	}
+
+	snapshot, entries, err := s.officialLbSvc.GetLeaderboard(ctx, req.Msg.Region, req.Msg.Search, int(req.Msg.AfterRank), int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetOfficialLeaderboardResponse{}
+	if snapshot == nil {
+		return connect.NewResponse(resp), nil
+	}
+
+	resp.FetchedAt = snapshot.FetchedAt.Format(time.RFC3339)
+	for _, e := range entries {
+		entry := &valorantv1.OfficialLeaderboardEntry{
+			Rank:    int32(e.Rank),
+			Puuid:   e.Puuid,
+			Name:    e.Name,
+			Tag:     e.Tag,
+			Tier:    &valorantv1.Tier{Id: int32(e.Tier)},
+			Rr:      int32(e.RR),
+			Wins:    int32(e.Wins),
+			Tracked: e.Tracked,
+		}
+		if e.PreviousRank != nil {
+			entry.PreviousRank = proto.Int32(int32(*e.PreviousRank))
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// setLeaderboardPlacement flags players on an official leaderboard. A failed lookup only
+// leaves the flag unset.
+func (s *TrackerServer) setLeaderboardPlacement(ctx context.Context, resp *valorantv1.PlayerResponse) {
+	placement, err := s.officialLbSvc.GetPlacement(ctx, resp.Puuid)
+	if err != nil || placement == nil {
+		return
+	}
+	resp.LeaderboardRank = proto.Int32(int32(placement.Rank))
+	resp.LeaderboardRegion = placement.Region
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) ListPatches(ctx context.Context, req *connect.Request[valorantv1.ListPatchesRequest]) (*connect.Response[valorantv1.ListPatchesResponse], error) {
+	patches, err := s.statsSvc.ListPatches(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.ListPatchesResponse{}
+	for _, p := range patches {
+		resp.Patches = append(resp.Patches, &valorantv1.Patch{
+			Name:        p.Name,
+			Major:       int32(p.Major),
+			Minor:       int32(p.Minor),
+			FirstSeenAt: p.FirstSeenAt.Format(time.RFC3339),
+			Matches:     int32(p.Matches),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetPatchMeta(ctx context.Context, req *connect.Request[valorantv1.GetPatchMetaRequest]) (*connect.Response[valorantv1.GetPatchMetaResponse], error) {
+	if req.Msg.Patch == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("patch is required"))
+	}
+
+	meta, total, err := s.statsSvc.GetPatchMeta(ctx, req.Msg.Patch, req.Msg.Mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetPatchMetaResponse{Patch: req.Msg.Patch, Matches: int32(total)}
+	for _, m := range meta {
+		agent := &valorantv1.PatchAgent{
+			CharacterId: m.CharacterID,
+			Name:        service.AgentName(m.CharacterID),
+			Games:       int32(m.Games),
+			Matches:     int32(m.Matches),
+			Wins:        int32(m.Wins),
+			WinRate:     float32(m.WinRate()),
+			KdRatio:     float32(m.KD()),
+		}
+		if total > 0 {
+			agent.PickRate = float32(m.Matches) / float32(total)
+		}
+		resp.Agents = append(resp.Agents, agent)
+	}
+	return connect.NewResponse(resp), nil
+}
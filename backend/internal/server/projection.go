@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetRankProjection(ctx context.Context, req *connect.Request[valorantv1.GetRankProjectionRequest]) (*connect.Response[valorantv1.GetRankProjectionResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	p, err := s.projectionSvc.GetRankProjection(ctx, req.Msg.Puuid, int(req.Msg.Games))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetRankProjectionResponse{
+		Puuid:               req.Msg.Puuid,
+		Tier:                &valorantv1.Tier{Id: int32(p.Tier), Name: p.TierName},
+		RankingInTier:       int32(p.RR),
+		Elo:                 int32(p.Elo),
+		SampleGames:         int32(p.SampleGames),
+		Wins:                int32(p.Wins),
+		Losses:              int32(p.Losses),
+		Draws:               int32(p.Draws),
+		WinRate:             float32(p.WinRate),
+		WinRateLow:          float32(p.WinRateLow),
+		WinRateHigh:         float32(p.WinRateHigh),
+		AvgGain:             float32(p.AvgGain),
+		AvgLoss:             float32(p.AvgLoss),
+		ExpectedRrPerGame:   float32(p.ExpectedRRPerGame()),
+		Games:               int32(p.Games),
+		PromotionChance:     float32(p.PromotionChance),
+		PromotionChanceLow:  float32(p.PromotionChanceLow),
+		PromotionChanceHigh: float32(p.PromotionChanceHigh),
+		UnavailableReason:   p.Unavailable,
+	}
+	if p.ExpectedGames != nil {
+		resp.ExpectedGames = proto.Float32(float32(*p.ExpectedGames))
+	}
+	if p.ExpectedGamesLow != nil {
+		resp.ExpectedGamesLow = proto.Float32(float32(*p.ExpectedGamesLow))
+	}
+	if p.ExpectedGamesHigh != nil {
+		resp.ExpectedGamesHigh = proto.Float32(float32(*p.ExpectedGamesHigh))
+	}
+	return connect.NewResponse(resp), nil
+}
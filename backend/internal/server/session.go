@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) GetSessions(ctx context.Context, req *connect.Request[valorantv1.GetSessionsRequest]) (*connect.Response[valorantv1.GetSessionsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	gap := time.Duration(req.Msg.GapMinutes) * time.Minute
+	sessions, tilt, err := s.sessionSvc.GetSessions(ctx, req.Msg.Puuid, gap, req.Msg.Today, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetSessionsResponse{Tilt: toProtoTilt(tilt)}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, s.toProtoSession(session))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) toProtoSession(session domain.Session) *valorantv1.Session {
+	agents := make([]*valorantv1.SessionAgent, 0, len(session.Agents))
+	for _, a := range session.Agents {
+		agents = append(agents, &valorantv1.SessionAgent{
+			CharacterId: a.CharacterID,
+			Name:        service.AgentName(a.CharacterID),
+			Matches:     int32(a.Matches),
+		})
+	}
+
+	return &valorantv1.Session{
+		StartedAt:       session.StartedAt.Format(time.RFC3339),
+		EndedAt:         session.EndedAt.Format(time.RFC3339),
+		DurationSeconds: int64(session.Duration().Seconds()),
+		Matches:         int32(len(session.MatchIDs)),
+		Wins:            int32(session.Wins),
+		Losses:          int32(session.Losses),
+		Draws:           int32(session.Draws),
+		Kills:           int32(session.Kills),
+		Deaths:          int32(session.Deaths),
+		Assists:         int32(session.Assists),
+		KdRatio:         s.calculateKD(session.Kills, session.Deaths),
+		NetRr:           int32(session.NetRR),
+		Agents:          agents,
+		StartTier:       &valorantv1.Tier{Id: int32(session.StartTier), Name: session.StartTierName},
+		EndTier:         &valorantv1.Tier{Id: int32(session.EndTier), Name: session.EndTierName},
+		MatchIds:        session.MatchIDs,
+		Streaks:         toProtoStreaks(session.Streaks),
+		TiltGames:       int32(session.TiltGames),
+	}
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/metrics"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetAgentStats(ctx context.Context, req *connect.Request[valorantv1.GetAgentStatsRequest]) (*connect.Response[valorantv1.GetAgentStatsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stats, err := s.statsSvc.GetAgentStats(ctx, req.Msg.Puuid, filter, req.Msg.GroupByPatch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetAgentStatsResponse{}
+	for _, a := range stats {
+		resp.Agents = append(resp.Agents, &valorantv1.AgentStats{
+			CharacterId: a.CharacterID,
+			Name:        service.AgentName(a.CharacterID),
+			Games:       int32(a.Games),
+			Wins:        int32(a.Wins),
+			WinRate:     float32(a.WinRate()),
+			Kills:       int32(a.Kills),
+			Deaths:      int32(a.Deaths),
+			Assists:     int32(a.Assists),
+			KdRatio:     float32(a.KD()),
+			Adr:         float32(a.ADR()),
+			AvgScore:    float32(a.AvgScore),
+			AvgRrChange: float32(a.AvgRRChange),
+			Patch:       a.Patch,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetMapStats(ctx context.Context, req *connect.Request[valorantv1.GetMapStatsRequest]) (*connect.Response[valorantv1.GetMapStatsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stats, err := s.statsSvc.GetMapStats(ctx, req.Msg.Puuid, filter, req.Msg.GroupByPatch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetMapStatsResponse{}
+	for _, m := range stats {
+		resp.Maps = append(resp.Maps, toProtoMapStats(m))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetOverallStats(ctx context.Context, req *connect.Request[valorantv1.GetOverallStatsRequest]) (*connect.Response[valorantv1.GetOverallStatsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stats, err := s.statsSvc.GetOverallStats(ctx, req.Msg.Puuid, filter, req.Msg.GroupByPatch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetOverallStatsResponse{}
+	for _, o := range stats {
+		resp.Stats = append(resp.Stats, toProtoOverallStats(o))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoOverallStats(o domain.OverallStats) *valorantv1.OverallStats {
+	return &valorantv1.OverallStats{
+		Patch:       o.Patch,
+		Games:       int32(o.Games),
+		Wins:        int32(o.Wins),
+		Losses:      int32(o.Losses()),
+		Draws:       int32(o.Draws),
+		WinRate:     float32(o.WinRate()),
+		Kills:       int32(o.Kills),
+		Deaths:      int32(o.Deaths),
+		Assists:     int32(o.Assists),
+		KdRatio:     float32(o.KD()),
+		Acs:         float32(metrics.ACS(o.Score, o.Rounds)),
+		Adr:         float32(metrics.ADR(o.DamageDealt, o.Rounds)),
+		RrChange:    int32(o.RRChange),
+		AvgRrChange: float32(o.AvgRRChange()),
+		RatedGames:  int32(o.RatedGames),
+	}
+}
+
+func (s *TrackerServer) GetOpponentStrength(ctx context.Context, req *connect.Request[valorantv1.GetOpponentStrengthRequest]) (*connect.Response[valorantv1.GetOpponentStrengthResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	bucket := time.Duration(req.Msg.BucketDays) * 24 * time.Hour
+	trend, err := s.statsSvc.GetOpponentStrength(ctx, req.Msg.Puuid, filter, bucket)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetOpponentStrengthResponse{
+		Overall:      toProtoOpponentStrengthPeriod(trend.Overall),
+		RatedMatches: int32(trend.RatedMatches),
+	}
+	for _, p := range trend.Periods {
+		resp.Periods = append(resp.Periods, toProtoOpponentStrengthPeriod(p))
+	}
+	if trend.TierChangePerWeek != nil {
+		resp.TierChangePerWeek = proto.Float32(float32(*trend.TierChangePerWeek))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetHeatmap(ctx context.Context, req *connect.Request[valorantv1.GetHeatmapRequest]) (*connect.Response[valorantv1.GetHeatmapResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	heatmap, err := s.statsSvc.GetHeatmap(ctx, req.Msg.Puuid, filter, req.Msg.Timezone)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTimezone) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetHeatmapResponse{
+		Timezone: heatmap.Location.String(),
+		Overall:  toProtoHeatmapBucket(heatmap.Overall),
+	}
+	for hour, b := range heatmap.Hours {
+		bucket := toProtoHeatmapBucket(b)
+		bucket.Hour = proto.Int32(int32(hour))
+		resp.Hours = append(resp.Hours, bucket)
+	}
+	for day, b := range heatmap.Weekdays {
+		bucket := toProtoHeatmapBucket(b)
+		bucket.Weekday = proto.Int32(int32(day))
+		resp.Weekdays = append(resp.Weekdays, bucket)
+	}
+	for day, hours := range heatmap.Cells {
+		for hour, b := range hours {
+			if b.Games == 0 {
+				continue
+			}
+			bucket := toProtoHeatmapBucket(b)
+			bucket.Weekday = proto.Int32(int32(day))
+			bucket.Hour = proto.Int32(int32(hour))
+			resp.Cells = append(resp.Cells, bucket)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoHeatmapBucket(b domain.HeatmapBucket) *valorantv1.HeatmapBucket {
+	bucket := &valorantv1.HeatmapBucket{
+		Games:   int32(b.Games),
+		Wins:    int32(b.Wins),
+		WinRate: float32(b.WinRate()),
+		KdRatio: float32(b.KD()),
+		Acs:     float32(metrics.ACS(b.Score, b.Rounds)),
+		RrGames: int32(b.RRGames),
+	}
+	if avg, ok := b.AvgRRChange(); ok {
+		bucket.AvgRrChange = proto.Float32(float32(avg))
+	}
+	if avg, ok := b.AvgRating(); ok {
+		bucket.AvgRating = proto.Float32(float32(avg))
+	}
+	return bucket
+}
+
+func toProtoOpponentStrengthPeriod(p domain.OpponentStrengthPeriod) *valorantv1.OpponentStrengthPeriod {
+	return &valorantv1.OpponentStrengthPeriod{
+		Start:            p.Start.Format(time.RFC3339),
+		Matches:          int32(p.Matches),
+		Team:             toProtoTeamRank(p.Team),
+		Opponents:        toProtoTeamRank(p.Opponents),
+		OpponentCoverage: float32(p.OpponentCoverage()),
+	}
+}
+
+func toProtoMapStats(m domain.MapStats) *valorantv1.MapStats {
+	stats := &valorantv1.MapStats{
+		MapId:        m.MapID,
+		MapName:      m.MapName,
+		Games:        int32(m.Games),
+		Wins:         int32(m.Wins),
+		WinRate:      float32(m.WinRate()),
+		RoundsWon:    int32(m.RoundsWon),
+		Rounds:       int32(m.Rounds),
+		RoundWinRate: float32(m.RoundWinRate()),
+		KdRatio:      float32(m.KD()),
+		Acs:          float32(m.ACS()),
+		Patch:        m.Patch,
+	}
+	if m.AttackRounds > 0 {
+		rate := float32(m.AttackRoundsWon) / float32(m.AttackRounds)
+		stats.AttackRoundWinRate = &rate
+	}
+	if m.DefenseRounds > 0 {
+		rate := float32(m.DefenseRoundsWon) / float32(m.DefenseRounds)
+		stats.DefenseRoundWinRate = &rate
+	}
+	return stats
+}
+
+func toDomainStatsFilter(f *valorantv1.StatsFilter) (domain.StatsFilter, error) {
+	if f == nil {
+		return domain.StatsFilter{}, nil
+	}
+
+	filter := domain.StatsFilter{SeasonID: f.SeasonId, Mode: f.Mode, CharacterID: f.CharacterId, Patch: f.Patch}
+	var err error
+	if filter.StartedAfter, err = parseOptionalTime("started_after", f.StartedAfter); err != nil {
+		return filter, err
+	}
+	if filter.StartedBefore, err = parseOptionalTime("started_before", f.StartedBefore); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC3339 timestamp", field)
+	}
+	// stored times are UTC and compared as text
+	t = t.UTC()
+	return &t, nil
+}
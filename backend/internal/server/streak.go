@@ -0,0 +1,39 @@
+package server
+
+import (
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func toProtoStreaks(s domain.Streaks) *valorantv1.Streaks {
+	return &valorantv1.Streaks{
+		CurrentWins:   int32(s.CurrentWins),
+		CurrentLosses: int32(s.CurrentLosses),
+		LongestWins:   int32(s.LongestWins),
+		LongestLosses: int32(s.LongestLosses),
+	}
+}
+
+func toProtoTilt(t domain.Tilt) *valorantv1.Tilt {
+	return &valorantv1.Tilt{
+		AfterLosses: int32(t.AfterLosses),
+		Baseline:    toProtoTiltSplit(t.Baseline),
+		AfterStreak: toProtoTiltSplit(t.AfterStreak),
+		Tilted:      t.Tilted,
+		Insight:     t.Insight,
+	}
+}
+
+func toProtoTiltSplit(t domain.TiltSplit) *valorantv1.TiltSplit {
+	split := &valorantv1.TiltSplit{
+		Games:   int32(t.Games),
+		WinRate: float32(t.WinRate()),
+		KdRatio: float32(t.KD()),
+	}
+	if avg, ok := t.AvgRating(); ok {
+		split.AvgRating = proto.Float32(float32(avg))
+	}
+	return split
+}
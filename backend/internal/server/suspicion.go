@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/constants"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetSuspicion(ctx context.Context, req *connect.Request[valorantv1.GetSuspicionRequest]) (*connect.Response[valorantv1.GetSuspicionResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	report, err := s.suspicionSvc.GetReport(ctx, req.Msg.Puuid)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetSuspicionResponse{
+		Puuid:    report.Puuid,
+		Score:    float32(report.Score()),
+		Coverage: float32(report.Coverage()),
+	}
+	for _, sig := range report.Signals {
+		resp.Signals = append(resp.Signals, &valorantv1.SuspicionSignal{
+			Kind:         string(sig.Kind),
+			Available:    sig.Available,
+			Value:        float32(sig.Value),
+			Threshold:    float32(sig.Threshold),
+			Saturation:   float32(sig.Saturation),
+			Strength:     float32(sig.Strength),
+			Weight:       float32(sig.Weight),
+			Contribution: float32(sig.Contribution()),
+			Detail:       sig.Detail,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// setSuspicionScores fills in the score of every player with enough data behind it.
+func (s *TrackerServer) setSuspicionScores(ctx context.Context, players []*valorantv1.PlayerMatch) {
+	puuids := make([]string, 0, len(players))
+	for _, p := range players {
+		puuids = append(puuids, p.Puuid)
+	}
+
+	reports := s.suspicionSvc.GetReports(ctx, puuids)
+	for _, p := range players {
+		report, ok := reports[p.Puuid]
+		if ok && report.Coverage() >= constants.SuspicionDisplayMinimum {
+			p.SuspicionScore = proto.Float32(float32(report.Score()))
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) GetSynergy(ctx context.Context, req *connect.Request[valorantv1.GetSynergyRequest]) (*connect.Response[valorantv1.GetSynergyResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	synergy, err := s.synergySvc.GetSynergy(ctx, req.Msg.Puuid, int(req.Msg.MinGames), int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetSynergyResponse{
+		Solo:              toProtoQueueSplit(synergy.Solo),
+		Party:             toProtoQueueSplit(synergy.Party),
+		UnclassifiedGames: int32(synergy.Unclassified),
+	}
+	for _, t := range synergy.Teammates {
+		resp.Teammates = append(resp.Teammates, &valorantv1.TeammateSynergy{
+			Puuid:        t.Puuid,
+			Name:         t.Name,
+			Tag:          t.Tag,
+			Stats:        toProtoQueueSplit(t.QueueStats),
+			PremadeGames: int32(t.PremadeGames),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoQueueSplit(s domain.QueueStats) *valorantv1.QueueSplit {
+	return &valorantv1.QueueSplit{
+		Games:    int32(s.Games),
+		Wins:     int32(s.Wins),
+		WinRate:  float32(s.WinRate()),
+		RrChange: int32(s.RRChange),
+		KdRatio:  float32(s.KD()),
+	}
+}
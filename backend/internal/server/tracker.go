@@ -5,21 +5,40 @@ import (
 	"fmt"
 	"time"
 	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/config"
+	"valorant-tracker/internal/constants"
 	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/metrics"
 	"valorant-tracker/internal/repository"
 	"valorant-tracker/internal/service"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 )
 
 type TrackerServer struct {
+	cfg            *config.Config
 	playerSvc      *service.PlayerService
 	matchSvc       *service.MatchService
 	matchDetailSvc *service.MatchDetailService
+	feedSvc        *service.FeedService
+	webhookSvc     *service.WebhookService
+	watchHub       *service.WatchHub
+	sessionSvc     *service.SessionService
+	statsSvc       *service.StatsService
+	encounterSvc   *service.EncounterService
+	synergySvc     *service.SynergyService
+	compareSvc     *service.CompareService
+	leaderboardSvc *service.LeaderboardService
+	officialLbSvc  *service.OfficialLeaderboardService
+	suspicionSvc   *service.SuspicionService
+	projectionSvc  *service.ProjectionService
+	weaponSvc      *service.WeaponService
+	reconciler     *service.Reconciler
 }
 
-func NewTrackerServer(playerSvc *service.PlayerService, matchSvc *service.MatchService, matchDetailSvc *service.MatchDetailService) *TrackerServer {
-	return &TrackerServer{playerSvc: playerSvc, matchSvc: matchSvc, matchDetailSvc: matchDetailSvc}
+func NewTrackerServer(cfg *config.Config, playerSvc *service.PlayerService, matchSvc *service.MatchService, matchDetailSvc *service.MatchDetailService, feedSvc *service.FeedService, webhookSvc *service.WebhookService, watchHub *service.WatchHub, sessionSvc *service.SessionService, statsSvc *service.StatsService, encounterSvc *service.EncounterService, synergySvc *service.SynergyService, compareSvc *service.CompareService, leaderboardSvc *service.LeaderboardService, officialLbSvc *service.OfficialLeaderboardService, suspicionSvc *service.SuspicionService, projectionSvc *service.ProjectionService, weaponSvc *service.WeaponService, reconciler *service.Reconciler) *TrackerServer {
+	return &TrackerServer{cfg: cfg, playerSvc: playerSvc, matchSvc: matchSvc, matchDetailSvc: matchDetailSvc, feedSvc: feedSvc, webhookSvc: webhookSvc, watchHub: watchHub, sessionSvc: sessionSvc, statsSvc: statsSvc, encounterSvc: encounterSvc, synergySvc: synergySvc, compareSvc: compareSvc, leaderboardSvc: leaderboardSvc, officialLbSvc: officialLbSvc, suspicionSvc: suspicionSvc, projectionSvc: projectionSvc, weaponSvc: weaponSvc, reconciler: reconciler}
 }
 
 func (s *TrackerServer) GetPlayer(ctx context.Context, req *connect.Request[valorantv1.PlayerRequest]) (*connect.Response[valorantv1.PlayerResponse], error) {
@@ -39,29 +58,8 @@ func (s *TrackerServer) GetPlayer(ctx context.Context, req *connect.Request[valo
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	var totalKills, totalDeaths int
-	for _, m := range matches {
-		totalKills += m.PlayerStats.Kills
-		totalDeaths += m.PlayerStats.Deaths
-	}
-
-	resp := &valorantv1.PlayerResponse{
-		Puuid:        player.Puuid,
-		Name:         player.Name,
-		Tag:          player.Tag,
-		Region:       player.Region,
-		AccountLevel: int32(player.AccountLevel),
-		Card:         player.Card,
-		Title:        player.Title,
-		CurrentTier: &valorantv1.Tier{
-			Id:   int32(player.CurrentTier),
-			Name: player.CurrentTierName,
-		},
-		CurrentRr:    int32(player.CurrentRR),
-		TotalMatches: int32(len(matches)),
-		KdRatio:      s.calculateKD(totalKills, totalDeaths),
-		WinRate:      s.calculateWinRate(matches),
-	}
+	resp := s.toProtoPlayerWithStats(player, matches)
+	s.setLeaderboardPlacement(ctx, resp)
 
 	return connect.NewResponse(resp), nil
 }
@@ -80,45 +78,67 @@ func (s *TrackerServer) GetMatches(ctx context.Context, req *connect.Request[val
 
 	var respMatches []*valorantv1.Match
 	for _, m := range matches {
-		rankingInTier := int32(0)
-		mmrChange := int32(0)
-		if m.MMRData != nil {
-			rankingInTier = int32(m.MMRData.RankingInTier)
-			mmrChange = int32(m.MMRData.MMRChange)
-		}
-
-		respMatches = append(respMatches, &valorantv1.Match{
-			MatchId:   m.Match.MatchID,
-			MapName:   m.Match.MapName,
-			Mode:      m.Match.Mode,
-			StartedAt: m.Match.StartedAt.Format(time.RFC3339),
-			Tier: &valorantv1.Tier{
-				Id:   int32(m.PlayerStats.Tier),
-				Name: m.PlayerStats.TierName,
-			},
-			RankingInTier: rankingInTier,
-			MmrChange:     mmrChange,
-			Kills:         int32(m.PlayerStats.Kills),
-			Deaths:        int32(m.PlayerStats.Deaths),
-			Assists:       int32(m.PlayerStats.Assists),
-			Score:         int32(m.PlayerStats.Score),
-			Team:          m.PlayerStats.Team,
-			HasWon:        m.PlayerStats.HasWon,
-			Source:        m.Match.Source,
-			TeamRedScore:  int32(m.Match.TeamRedScore),
-			TeamBlueScore: int32(m.Match.TeamBlueScore),
-			Cluster:       m.Match.Cluster,
-			Version:       m.Match.Version,
-			MapId:         m.Match.MapID,
-			CharacterId:   m.PlayerStats.CharacterID,
-			DamageTaken:   int32(m.PlayerStats.DamageTaken),
-			DamageDealt:   int32(m.PlayerStats.DamageDealt),
-		})
+		match := s.toProtoMatch(m.Match, m.PlayerStats, m.MMRData)
+		match.LobbyRank = toProtoLobbyRank(m.Lobby)
+		respMatches = append(respMatches, match)
 	}
 
 	return connect.NewResponse(&valorantv1.MatchesResponse{Matches: respMatches}), nil
 }
 
+func (s *TrackerServer) toProtoMatch(match domain.Match, stats domain.MatchPlayer, mmr *domain.MMRHistory) *valorantv1.Match {
+	rankingInTier := int32(0)
+	mmrChange := int32(0)
+	if mmr != nil {
+		rankingInTier = int32(mmr.RankingInTier)
+		mmrChange = int32(mmr.MMRChange)
+	}
+
+	m := &valorantv1.Match{
+		MatchId:   match.MatchID,
+		MapName:   match.MapName,
+		Mode:      match.Mode,
+		StartedAt: match.StartedAt.Format(time.RFC3339),
+		Tier: &valorantv1.Tier{
+			Id:   int32(stats.Tier),
+			Name: stats.TierName,
+		},
+		RankingInTier: rankingInTier,
+		MmrChange:     mmrChange,
+		Kills:         int32(stats.Kills),
+		Deaths:        int32(stats.Deaths),
+		Assists:       int32(stats.Assists),
+		Score:         int32(stats.Score),
+		Team:          stats.Team,
+		HasWon:        stats.HasWon,
+		Source:        match.Source,
+		TeamRedScore:  int32(match.TeamRedScore),
+		TeamBlueScore: int32(match.TeamBlueScore),
+		Cluster:       match.Cluster,
+		Version:       match.Version,
+		MapId:         match.MapID,
+		CharacterId:   stats.CharacterID,
+		DamageTaken:   int32(stats.DamageTaken),
+		DamageDealt:   int32(stats.DamageDealt),
+		Acs:           float32(stats.ACS),
+		Adr:           float32(stats.ADR),
+		PlusMinus:     int32(stats.PlusMinus),
+	}
+	if stats.KAST != nil {
+		m.Kast = proto.Float32(float32(*stats.KAST))
+	}
+	if stats.FirstBloods != nil {
+		m.FirstBloods = proto.Int32(int32(*stats.FirstBloods))
+	}
+	if stats.FirstDeaths != nil {
+		m.FirstDeaths = proto.Int32(int32(*stats.FirstDeaths))
+	}
+	if stats.Rating != nil {
+		m.Rating = proto.Float32(float32(*stats.Rating))
+	}
+	return m
+}
+
 func (s *TrackerServer) calculateKD(kills, deaths int) float32 {
 	if deaths == 0 {
 		return float32(kills)
@@ -155,6 +175,11 @@ func (s *TrackerServer) GetMatch(ctx context.Context, req *connect.Request[valor
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	resp.LobbyRank = matchLobbyRank(resp)
+	// suspicion stays admin only, match pages get scores when the admin key is sent
+	if s.requireAdmin(req.Header()) == nil {
+		s.setSuspicionScores(ctx, resp.Players)
+	}
 	return connect.NewResponse(resp), nil
 }
 
@@ -163,7 +188,80 @@ func (s *TrackerServer) GetPlayerByPuuid(ctx context.Context, req *connect.Reque
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
-	return connect.NewResponse(s.toProtoPlayer(player)), nil
+	resp := s.toProtoPlayer(player)
+	s.setLeaderboardPlacement(ctx, resp)
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) toProtoPlayerWithStats(p *domain.Player, matches []repository.MatchWithPlayers) *valorantv1.PlayerResponse {
+	var totalKills, totalDeaths, totalScore, totalDamage, totalRounds int
+	var firstBloods, firstDeaths, firstKillMatches int
+	var kastRounds float64
+	var roundsWithKAST int
+	for _, m := range matches {
+		rounds := m.Match.TeamRedScore + m.Match.TeamBlueScore
+		totalKills += m.PlayerStats.Kills
+		totalDeaths += m.PlayerStats.Deaths
+		totalScore += m.PlayerStats.Score
+		totalDamage += m.PlayerStats.DamageDealt
+		totalRounds += rounds
+		if m.PlayerStats.KAST != nil {
+			kastRounds += *m.PlayerStats.KAST * float64(rounds)
+			roundsWithKAST += rounds
+		}
+		if m.PlayerStats.FirstBloods != nil && m.PlayerStats.FirstDeaths != nil {
+			firstBloods += *m.PlayerStats.FirstBloods
+			firstDeaths += *m.PlayerStats.FirstDeaths
+			firstKillMatches++
+		}
+	}
+
+	resp := s.toProtoPlayer(p)
+	resp.TotalMatches = int32(len(matches))
+	resp.KdRatio = s.calculateKD(totalKills, totalDeaths)
+	resp.WinRate = s.calculateWinRate(matches)
+	resp.Acs = float32(metrics.ACS(totalScore, totalRounds))
+	resp.Adr = float32(metrics.ADR(totalDamage, totalRounds))
+	resp.FirstBloods = int32(firstBloods)
+	resp.FirstDeaths = int32(firstDeaths)
+	resp.FirstKillMatches = int32(firstKillMatches)
+	resp.PlusMinus = int32(totalKills - totalDeaths)
+	if roundsWithKAST > 0 {
+		resp.Kast = proto.Float32(float32(kastRounds / float64(roundsWithKAST)))
+	}
+	if avg, ok := s.averageRating(matches); ok {
+		resp.AvgRating = proto.Float32(avg)
+	}
+
+	streaks, seasons := service.Streaks(matches)
+	resp.Streaks = toProtoStreaks(streaks)
+	for _, season := range seasons {
+		resp.SeasonStreaks = append(resp.SeasonStreaks, &valorantv1.SeasonStreaks{
+			SeasonId: season.SeasonID,
+			Streaks:  toProtoStreaks(season.Streaks),
+		})
+	}
+	resp.Tilt = toProtoTilt(service.DetectTilt(matches, constants.SessionDefaultGap))
+	return resp
+}
+
+// averageRating averages the rating over the latest rated matches; matches are newest first.
+func (s *TrackerServer) averageRating(matches []repository.MatchWithPlayers) (float32, bool) {
+	var sum float64
+	var n int
+	for _, m := range matches {
+		if n == constants.RatingRollingAvg {
+			break
+		}
+		if m.PlayerStats.Rating != nil {
+			sum += *m.PlayerStats.Rating
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return float32(sum / float64(n)), true
 }
 
 func (s *TrackerServer) toProtoPlayer(p *domain.Player) *valorantv1.PlayerResponse {
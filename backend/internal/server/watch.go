@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) WatchPlayer(ctx context.Context, req *connect.Request[valorantv1.WatchPlayerRequest], stream *connect.ServerStream[valorantv1.WatchPlayerResponse]) error {
+	if req.Msg.Puuid == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+
+	watcher, err := s.watchHub.Watch(ctx, req.Msg.Puuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	defer watcher.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case u, ok := <-watcher.Updates():
+			if !ok {
+				return nil
+			}
+			msg := s.toProtoWatchUpdate(u)
+			if msg == nil {
+				continue
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *TrackerServer) toProtoWatchUpdate(u service.WatchUpdate) *valorantv1.WatchPlayerResponse {
+	switch {
+	case u.Snapshot != nil:
+		return &valorantv1.WatchPlayerResponse{Update: &valorantv1.WatchPlayerResponse_Snapshot{
+			Snapshot: s.toProtoPlayerWithStats(u.Snapshot.Player, u.Snapshot.Matches),
+		}}
+	case u.Status != nil:
+		status := &valorantv1.RefreshStatus{State: string(u.Status.State), Error: u.Status.Error}
+		if !u.Status.NextRefreshAt.IsZero() {
+			status.NextRefreshAt = u.Status.NextRefreshAt.Unix()
+		}
+		return &valorantv1.WatchPlayerResponse{Update: &valorantv1.WatchPlayerResponse_RefreshStatus{RefreshStatus: status}}
+	case u.Event != nil:
+		return toProtoWatchEvent(*u.Event)
+	}
+	return nil
+}
+
+func toProtoWatchEvent(e events.Event) *valorantv1.WatchPlayerResponse {
+	switch data := e.Data.(type) {
+	case events.MatchData:
+		return &valorantv1.WatchPlayerResponse{Update: &valorantv1.WatchPlayerResponse_NewMatch{NewMatch: &valorantv1.Match{
+			MatchId:       data.MatchID,
+			MapName:       data.MapName,
+			Mode:          data.Mode,
+			StartedAt:     data.StartedAt.Format(time.RFC3339),
+			Tier:          &valorantv1.Tier{Id: int32(data.Tier), Name: data.TierName},
+			RankingInTier: int32(data.RankingInTier),
+			MmrChange:     int32(data.MMRChange),
+			Kills:         int32(data.Kills),
+			Deaths:        int32(data.Deaths),
+			Assists:       int32(data.Assists),
+			Score:         int32(data.Score),
+			Team:          data.Team,
+			HasWon:        data.HasWon,
+			TeamRedScore:  int32(data.TeamRedScore),
+			TeamBlueScore: int32(data.TeamBlueScore),
+			MapId:         data.MapID,
+			CharacterId:   data.CharacterID,
+			DamageTaken:   int32(data.DamageTaken),
+			DamageDealt:   int32(data.DamageDealt),
+		}}}
+	case events.RRData:
+		tier := &valorantv1.Tier{Id: int32(data.Tier), Name: data.TierName}
+		return &valorantv1.WatchPlayerResponse{Update: &valorantv1.WatchPlayerResponse_RrChange{RrChange: &valorantv1.RRChange{
+			PreviousTier: tier,
+			PreviousRr:   int32(data.PreviousRR),
+			Tier:         tier,
+			Rr:           int32(data.RR),
+		}}}
+	case events.RankData:
+		return &valorantv1.WatchPlayerResponse{Update: &valorantv1.WatchPlayerResponse_RrChange{RrChange: &valorantv1.RRChange{
+			PreviousTier: &valorantv1.Tier{Id: int32(data.PreviousTier), Name: data.PreviousTierName},
+			PreviousRr:   int32(data.PreviousRR),
+			Tier:         &valorantv1.Tier{Id: int32(data.Tier), Name: data.TierName},
+			Rr:           int32(data.RR),
+		}}}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *TrackerServer) GetWeaponStats(ctx context.Context, req *connect.Request[valorantv1.GetWeaponStatsRequest]) (*connect.Response[valorantv1.GetWeaponStatsResponse], error) {
+	if req.Msg.Puuid == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("puuid is required"))
+	}
+	filter, err := toDomainStatsFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	weapons, err := s.weaponSvc.GetWeaponStats(ctx, req.Msg.Puuid, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetWeaponStatsResponse{}
+	for _, w := range weapons {
+		breakdown := &valorantv1.WeaponBreakdown{
+			WeaponId:   w.WeaponID,
+			WeaponName: w.WeaponName,
+			Stats:      toProtoWeaponStats(w.WeaponStats),
+		}
+		for _, m := range w.Maps {
+			breakdown.Maps = append(breakdown.Maps, &valorantv1.WeaponMapStats{
+				MapId:   m.MapID,
+				MapName: m.MapName,
+				Stats:   toProtoWeaponStats(m.WeaponStats),
+			})
+		}
+		for _, season := range w.Seasons {
+			breakdown.Seasons = append(breakdown.Seasons, &valorantv1.WeaponSeasonStats{
+				SeasonId:      season.SeasonID,
+				FirstPlayedAt: season.FirstPlayedAt.Format(time.RFC3339),
+				Stats:         toProtoWeaponStats(season.WeaponStats),
+			})
+		}
+		resp.Weapons = append(resp.Weapons, breakdown)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) GetWeaponMeta(ctx context.Context, req *connect.Request[valorantv1.GetWeaponMetaRequest]) (*connect.Response[valorantv1.GetWeaponMetaResponse], error) {
+	metas, kills, playerRounds, err := s.weaponSvc.GetWeaponMeta(ctx, req.Msg.Region, req.Msg.Mode, req.Msg.Patch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.GetWeaponMetaResponse{Kills: int32(kills), PlayerRounds: int32(playerRounds)}
+	for _, m := range metas {
+		meta := &valorantv1.WeaponMeta{
+			WeaponId:   m.WeaponID,
+			WeaponName: m.WeaponName,
+			Kills:      int32(m.Kills),
+			Rounds:     int32(m.Rounds),
+		}
+		if kills > 0 {
+			meta.KillShare = float32(m.Kills) / float32(kills)
+		}
+		if playerRounds > 0 {
+			meta.PickRate = float32(m.Rounds) / float32(playerRounds)
+		}
+		if rate, ok := m.HeadshotRate(); ok {
+			meta.HeadshotRate = proto.Float32(float32(rate))
+		}
+		if dpr, ok := m.DamagePerRound(); ok {
+			meta.DamagePerRound = proto.Float32(float32(dpr))
+		}
+		resp.Weapons = append(resp.Weapons, meta)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toProtoWeaponStats(w domain.WeaponStats) *valorantv1.WeaponStats {
+	stats := &valorantv1.WeaponStats{
+		Kills:      int32(w.Kills),
+		Rounds:     int32(w.Rounds),
+		RoundKills: int32(w.RoundKills),
+	}
+	if kpr, ok := w.KillsPerRound(); ok {
+		stats.KillsPerRound = proto.Float32(float32(kpr))
+	}
+	if rate, ok := w.HeadshotRate(); ok {
+		stats.HeadshotRate = proto.Float32(float32(rate))
+	}
+	if dpr, ok := w.DamagePerRound(); ok {
+		stats.DamagePerRound = proto.Float32(float32(dpr))
+	}
+	return stats
+}
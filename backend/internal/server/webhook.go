@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/service"
+
+	"connectrpc.com/connect"
+)
+
+func (s *TrackerServer) CreateWebhook(ctx context.Context, req *connect.Request[valorantv1.CreateWebhookRequest]) (*connect.Response[valorantv1.CreateWebhookResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	sub := &domain.WebhookSubscription{
+		URL:        req.Msg.Url,
+		EventTypes: req.Msg.EventTypes,
+		Puuid:      req.Msg.Puuid,
+		Secret:     req.Msg.Secret,
+	}
+	if req.Msg.RrThreshold != nil {
+		threshold := int(*req.Msg.RrThreshold)
+		sub.RRThreshold = &threshold
+	}
+
+	if err := s.webhookSvc.CreateSubscription(ctx, sub); err != nil {
+		if errors.Is(err, service.ErrInvalidWebhook) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&valorantv1.CreateWebhookResponse{
+		Subscription: toProtoWebhookSubscription(*sub),
+		Secret:       sub.Secret,
+	}), nil
+}
+
+func (s *TrackerServer) DeleteWebhook(ctx context.Context, req *connect.Request[valorantv1.DeleteWebhookRequest]) (*connect.Response[valorantv1.DeleteWebhookResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookSvc.DeleteSubscription(ctx, req.Msg.Id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&valorantv1.DeleteWebhookResponse{}), nil
+}
+
+func (s *TrackerServer) ListWebhooks(ctx context.Context, req *connect.Request[valorantv1.ListWebhooksRequest]) (*connect.Response[valorantv1.ListWebhooksResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	subs, err := s.webhookSvc.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.ListWebhooksResponse{}
+	for _, sub := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, toProtoWebhookSubscription(sub))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *TrackerServer) ListWebhookDeliveries(ctx context.Context, req *connect.Request[valorantv1.ListWebhookDeliveriesRequest]) (*connect.Response[valorantv1.ListWebhookDeliveriesResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.webhookSvc.ListDeliveries(ctx, req.Msg.SubscriptionId, int(req.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &valorantv1.ListWebhookDeliveriesResponse{}
+	for _, d := range deliveries {
+		out := &valorantv1.WebhookDelivery{
+			Id:             d.ID,
+			SubscriptionId: d.SubscriptionID,
+			EventId:        d.EventID,
+			EventType:      d.EventType,
+			Status:         string(d.Status),
+			Attempts:       int32(d.Attempts),
+			NextAttemptAt:  d.NextAttemptAt.Unix(),
+			CreatedAt:      d.CreatedAt.Unix(),
+			Payload:        d.Payload,
+		}
+		if d.LastStatusCode != nil {
+			out.LastStatusCode = int32(*d.LastStatusCode)
+		}
+		if d.LastError != nil {
+			out.LastError = *d.LastError
+		}
+		if d.DeliveredAt != nil {
+			out.DeliveredAt = d.DeliveredAt.Unix()
+		}
+		resp.Deliveries = append(resp.Deliveries, out)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// toProtoWebhookSubscription leaves the secret out, it is only handed out on creation.
+func toProtoWebhookSubscription(sub domain.WebhookSubscription) *valorantv1.WebhookSubscription {
+	out := &valorantv1.WebhookSubscription{
+		Id:         sub.ID,
+		Url:        sub.URL,
+		EventTypes: sub.EventTypes,
+		Puuid:      sub.Puuid,
+		CreatedAt:  sub.CreatedAt.Unix(),
+	}
+	if sub.RRThreshold != nil {
+		threshold := int32(*sub.RRThreshold)
+		out.RrThreshold = &threshold
+	}
+	return out
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+)
+
+var ErrInvalidComparison = errors.New("invalid comparison")
+
+type CompareService struct {
+	playerSvc *PlayerService
+	matchSvc  *MatchService
+	logger    zerolog.Logger
+}
+
+func NewCompareService(playerSvc *PlayerService, matchSvc *MatchService, logger zerolog.Logger) *CompareService {
+	return &CompareService{playerSvc: playerSvc, matchSvc: matchSvc, logger: logger}
+}
+
+type ComparedPlayer struct {
+	Player       *domain.Player
+	Matches      []repository.MatchWithPlayers // within the window, newest first
+	RankTimeline []domain.RankPoint            // oldest first
+}
+
+type Comparison struct {
+	Players []ComparedPlayer
+	Agents  []domain.UsageOverlap
+	Maps    []domain.UsageOverlap
+	Records []domain.HeadToHead
+}
+
+// ComparePlayers loads every player through the regular player and match paths, so the usual
+// refresh rules apply, then compares their matches from the last `window`. A zero window keeps
+// every stored match.
+func (s *CompareService) ComparePlayers(ctx context.Context, refs []domain.PlayerRef, window time.Duration, refresh bool) (*Comparison, error) {
+	if len(refs) < constants.CompareMinPlayers || len(refs) > constants.CompareMaxPlayers {
+		return nil, fmt.Errorf("%w: between %d and %d players are required", ErrInvalidComparison, constants.CompareMinPlayers, constants.CompareMaxPlayers)
+	}
+	for _, ref := range refs {
+		if ref.Puuid == "" && (ref.Name == "" || ref.Tag == "") {
+			return nil, fmt.Errorf("%w: each player needs a puuid or a name and tag", ErrInvalidComparison)
+		}
+	}
+
+	players := make([]ComparedPlayer, len(refs))
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, ref := range refs {
+		g.Go(func() error {
+			player, err := s.resolve(gCtx, ref, refresh)
+			if err != nil {
+				return err
+			}
+			matches, err := s.matchSvc.GetMatchesFor(gCtx, player.Puuid, refresh)
+			if err != nil {
+				return err
+			}
+			players[i] = ComparedPlayer{Player: player, Matches: matches}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		s.logger.Error().Err(err).Int("players", len(refs)).Msg("failed to load players for comparison")
+		return nil, fmt.Errorf("failed to load players: %w", err)
+	}
+
+	seen := make(map[string]bool, len(players))
+	for _, p := range players {
+		if seen[p.Player.Puuid] {
+			return nil, fmt.Errorf("%w: %s#%s is listed twice", ErrInvalidComparison, p.Player.Name, p.Player.Tag)
+		}
+		seen[p.Player.Puuid] = true
+	}
+
+	if window > 0 {
+		since := time.Now().Add(-window)
+		for i := range players {
+			players[i].Matches = matchesSince(players[i].Matches, since)
+		}
+	}
+	for i := range players {
+		players[i].RankTimeline = rankTimeline(players[i].Matches)
+	}
+
+	comparison := &Comparison{
+		Players: players,
+		Agents: usageOverlap(players, func(m repository.MatchWithPlayers) (string, string) {
+			return m.PlayerStats.CharacterID, AgentName(m.PlayerStats.CharacterID)
+		}),
+		Maps: usageOverlap(players, func(m repository.MatchWithPlayers) (string, string) {
+			return m.Match.MapID, m.Match.MapName
+		}),
+		Records: headToHead(players),
+	}
+
+	s.logger.Debug().Int("players", len(players)).Dur("window", window).Msg("players compared")
+	return comparison, nil
+}
+
+func (s *CompareService) resolve(ctx context.Context, ref domain.PlayerRef, refresh bool) (*domain.Player, error) {
+	if ref.Puuid != "" {
+		return s.playerSvc.GetPlayerByPuuid(ctx, ref.Puuid, refresh)
+	}
+	return s.playerSvc.GetPlayer(ctx, ref.Name, ref.Tag, refresh)
+}
+
+// matchesSince expects matches newest first.
+func matchesSince(matches []repository.MatchWithPlayers, since time.Time) []repository.MatchWithPlayers {
+	n := 0
+	for n < len(matches) && !matches[n].Match.StartedAt.Before(since) {
+		n++
+	}
+	return matches[:n]
+}
+
+func rankTimeline(matches []repository.MatchWithPlayers) []domain.RankPoint {
+	var points []domain.RankPoint
+	for i := len(matches) - 1; i >= 0; i-- {
+		mmr := matches[i].MMRData
+		if mmr == nil {
+			continue
+		}
+		points = append(points, domain.RankPoint{
+			MatchID:       matches[i].Match.MatchID,
+			Date:          matches[i].Match.StartedAt,
+			Tier:          mmr.Tier,
+			TierName:      mmr.TierName,
+			RankingInTier: mmr.RankingInTier,
+			MMRChange:     mmr.MMRChange,
+		})
+	}
+	return points
+}
+
+// usageOverlap keeps the keys played by at least two of the players, most played first.
+func usageOverlap(players []ComparedPlayer, key func(repository.MatchWithPlayers) (id, name string)) []domain.UsageOverlap {
+	byID := make(map[string]*domain.UsageOverlap)
+	for i, p := range players {
+		for _, m := range p.Matches {
+			id, name := key(m)
+			if id == "" {
+				continue
+			}
+			o, ok := byID[id]
+			if !ok {
+				o = &domain.UsageOverlap{ID: id, Name: name, Games: make([]int, len(players)), Wins: make([]int, len(players))}
+				byID[id] = o
+			}
+			o.Games[i]++
+			if m.PlayerStats.HasWon {
+				o.Wins[i]++
+			}
+		}
+	}
+
+	var result []domain.UsageOverlap
+	for _, o := range byID {
+		playedBy := 0
+		for _, g := range o.Games {
+			if g > 0 {
+				playedBy++
+			}
+		}
+		if playedBy >= 2 {
+			result = append(result, *o)
+		}
+	}
+	slices.SortFunc(result, func(a, b domain.UsageOverlap) int {
+		return cmp.Or(cmp.Compare(b.TotalGames(), a.TotalGames()), cmp.Compare(a.ID, b.ID))
+	})
+	return result
+}
+
+// headToHead returns one record per pair that shared at least one match, in player order.
+func headToHead(players []ComparedPlayer) []domain.HeadToHead {
+	var records []domain.HeadToHead
+	for i := range players {
+		for j := i + 1; j < len(players); j++ {
+			other := make(map[string]domain.MatchPlayer, len(players[j].Matches))
+			for _, m := range players[j].Matches {
+				other[m.Match.MatchID] = m.PlayerStats
+			}
+
+			record := domain.HeadToHead{PuuidA: players[i].Player.Puuid, PuuidB: players[j].Player.Puuid}
+			for _, m := range players[i].Matches {
+				stats, ok := other[m.Match.MatchID]
+				if !ok {
+					continue
+				}
+				if stats.Team == m.PlayerStats.Team {
+					record.GamesWith++
+					if m.PlayerStats.HasWon {
+						record.WinsWith++
+					}
+				} else {
+					record.GamesAgainst++
+					if m.PlayerStats.HasWon {
+						record.WinsAgainst++
+					}
+				}
+			}
+			if record.GamesWith+record.GamesAgainst > 0 {
+				records = append(records, record)
+			}
+		}
+	}
+	return records
+}
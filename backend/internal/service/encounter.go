@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type EncounterService struct {
+	encounterRepo *repository.EncounterRepository
+	logger        zerolog.Logger
+}
+
+func NewEncounterService(encounterRepo *repository.EncounterRepository, logger zerolog.Logger) *EncounterService {
+	return &EncounterService{encounterRepo: encounterRepo, logger: logger}
+}
+
+// GetEncounters lists the players puuid has shared stored matches with. Only lobbies that were
+// fetched in full show up, so this grows as match pages get opened.
+func (s *EncounterService) GetEncounters(ctx context.Context, puuid string, limit int) ([]domain.Encounter, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = constants.EncounterDefaultLimit
+	}
+	limit = min(limit, constants.EncounterMaxLimit)
+
+	encounters, err := s.encounterRepo.List(ctx, puuid, limit)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get encounters")
+		return nil, fmt.Errorf("failed to get encounters: %w", err)
+	}
+
+	s.logger.Debug().Str("puuid", puuid).Int("encounters", len(encounters)).Msg("encounters listed")
+	return encounters, nil
+}
+
+// GetEncounter returns the history between two players, or nil if they never shared a match
+// other than excludeMatchID.
+func (s *EncounterService) GetEncounter(ctx context.Context, puuid, otherPuuid, excludeMatchID string) (*domain.Encounter, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	encounter, err := s.encounterRepo.Get(ctx, puuid, otherPuuid, excludeMatchID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Str("other_puuid", otherPuuid).Msg("failed to get encounter")
+		return nil, fmt.Errorf("failed to get encounter: %w", err)
+	}
+	return encounter, nil
+}
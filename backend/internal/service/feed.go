@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+const TrackedSourceFollow = "follow"
+
+var matchCountMilestones = []int{100, 250, 500, 1000}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type FeedService struct {
+	followRepo  *repository.FollowRepository
+	trackedRepo *repository.TrackedPlayerRepository
+	playerRepo  *repository.PlayerRepository
+	matchRepo   *repository.MatchRepository
+	logger      zerolog.Logger
+}
+
+func NewFeedService(followRepo *repository.FollowRepository, trackedRepo *repository.TrackedPlayerRepository, playerRepo *repository.PlayerRepository, matchRepo *repository.MatchRepository, logger zerolog.Logger) *FeedService {
+	return &FeedService{followRepo: followRepo, trackedRepo: trackedRepo, playerRepo: playerRepo, matchRepo: matchRepo, logger: logger}
+}
+
+// Follow adds puuid to the follower's watchlist and to the set the refresh scheduler keeps fresh.
+func (s *FeedService) Follow(ctx context.Context, followerID, puuid string) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if _, err := s.playerRepo.Get(ctx, puuid, false); err != nil {
+		return fmt.Errorf("player not found: %w", err)
+	}
+
+	if err := s.followRepo.Follow(ctx, followerID, puuid); err != nil {
+		s.logger.Error().Err(err).Str("follower_id", followerID).Str("puuid", puuid).Msg("failed to follow player")
+		return fmt.Errorf("failed to follow player: %w", err)
+	}
+
+	if err := s.trackedRepo.Track(ctx, puuid, TrackedSourceFollow, constants.RefreshDefaultInterval); err != nil {
+		s.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to track followed player")
+	}
+
+	s.logger.Info().Str("follower_id", followerID).Str("puuid", puuid).Msg("player followed")
+	return nil
+}
+
+func (s *FeedService) Unfollow(ctx context.Context, followerID, puuid string) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if err := s.followRepo.Unfollow(ctx, followerID, puuid); err != nil {
+		s.logger.Error().Err(err).Str("follower_id", followerID).Str("puuid", puuid).Msg("failed to unfollow player")
+		return fmt.Errorf("failed to unfollow player: %w", err)
+	}
+
+	followers, err := s.followRepo.CountFollowers(ctx, puuid)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to count followers")
+		return nil
+	}
+	if followers == 0 {
+		if err := s.trackedRepo.Untrack(ctx, puuid, TrackedSourceFollow); err != nil {
+			s.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to untrack unfollowed player")
+		}
+	}
+
+	s.logger.Info().Str("follower_id", followerID).Str("puuid", puuid).Msg("player unfollowed")
+	return nil
+}
+
+// GetFeed returns up to limit items older than cursor, newest first, and the cursor for the next page.
+// An empty next cursor means there is nothing more to read. Pages end on a match boundary, so a
+// single match that yields more items than limit is still returned whole.
+func (s *FeedService) GetFeed(ctx context.Context, followerID, cursor string, limit int) ([]domain.FeedItem, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.RequestTimeout)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = constants.FeedDefaultLimit
+	}
+	limit = min(limit, constants.FeedMaxLimit)
+
+	var after feedCursor
+	if cursor != "" {
+		c, err := decodeFeedCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		after = *c
+	}
+
+	var beforeMatchID *string
+	if after.MatchID != "" {
+		beforeMatchID = &after.MatchID
+	}
+
+	// every match yields at least one item, one extra row tells whether another page exists
+	matches, err := s.followRepo.GetFeedMatches(ctx, followerID, beforeMatchID, after.Puuid, limit+1)
+	if err != nil {
+		s.logger.Error().Err(err).Str("follower_id", followerID).Msg("failed to load feed matches")
+		return nil, "", fmt.Errorf("failed to load feed matches: %w", err)
+	}
+
+	more := len(matches) > limit
+	matches = matches[:min(len(matches), limit)]
+
+	players := make(map[string]*domain.Player)
+	var items []domain.FeedItem
+	var last *repository.FeedMatch
+	for i := range matches {
+		m := &matches[i]
+		puuid := m.PlayerStats.Puuid
+
+		player, ok := players[puuid]
+		if !ok {
+			player, err = s.playerRepo.Get(ctx, puuid, false)
+			if err != nil {
+				s.logger.Warn().Err(err).Str("puuid", puuid).Msg("followed player missing")
+			}
+			players[puuid] = player
+		}
+		if player == nil {
+			last = m
+			continue
+		}
+
+		matchItems := feedMatchItems(*player, m)
+		if len(items) > 0 && len(items)+len(matchItems) > limit {
+			more = true
+			break
+		}
+		items = append(items, matchItems...)
+		last = m
+	}
+
+	var next string
+	if more && last != nil {
+		next = feedCursor{MatchID: last.Match.MatchID, Puuid: last.PlayerStats.Puuid}.encode()
+	}
+
+	s.logger.Debug().Str("follower_id", followerID).Int("matches", len(matches)).Int("items", len(items)).Msg("feed built")
+	return items, next, nil
+}
+
+// feedMatchItems derives the match item and any rank change or milestone it caused, in the
+// order the feed shows them.
+func feedMatchItems(player domain.Player, m *repository.FeedMatch) []domain.FeedItem {
+	key := m.Match.MatchID + ":" + player.Puuid
+	item := func(id string, kind domain.FeedItemKind) domain.FeedItem {
+		return domain.FeedItem{
+			ID:         id,
+			Kind:       kind,
+			OccurredAt: m.Match.StartedAt,
+			Player:     player,
+			Match:      &m.Match,
+			Stats:      &m.PlayerStats,
+			MMR:        m.MMRData,
+		}
+	}
+
+	var items []domain.FeedItem
+
+	if m.MMRData != nil && m.PreviousTier != nil && m.MMRData.Tier != *m.PreviousTier {
+		rank := item("rank:"+key, domain.FeedItemRankChange)
+		rank.PreviousTier = *m.PreviousTier
+		rank.PreviousTierName = m.PreviousTierName
+		items = append(items, rank)
+
+		if m.MMRData.Tier > m.PeakTier {
+			peak := item("milestone:peak:"+key, domain.FeedItemMilestone)
+			peak.Milestone = "new peak rank: " + m.MMRData.TierName
+			items = append(items, peak)
+		}
+	}
+
+	if slices.Contains(matchCountMilestones, m.Played) {
+		milestone := item(fmt.Sprintf("milestone:matches-%d:%s", m.Played, key), domain.FeedItemMilestone)
+		milestone.Milestone = fmt.Sprintf("%d matches played", m.Played)
+		items = append(items, milestone)
+	}
+
+	return append(items, item("match:"+key, domain.FeedItemMatch))
+}
+
+// feedCursor points at the last match returned, pages continue with the matches sorting
+// after it by start time, match id and puuid.
+type feedCursor struct {
+	MatchID string
+	Puuid   string
+}
+
+func (c feedCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.MatchID + "|" + c.Puuid))
+}
+
+func decodeFeedCursor(s string) (*feedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	matchID, puuid, ok := strings.Cut(string(raw), "|")
+	if !ok || matchID == "" || puuid == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &feedCursor{MatchID: matchID, Puuid: puuid}, nil
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+var ErrInvalidLeaderboard = errors.New("invalid leaderboard request")
+
+type LeaderboardService struct {
+	leaderboardRepo *repository.LeaderboardRepository
+	playerRepo      *repository.PlayerRepository
+	logger          zerolog.Logger
+}
+
+func NewLeaderboardService(leaderboardRepo *repository.LeaderboardRepository, playerRepo *repository.PlayerRepository, logger zerolog.Logger) *LeaderboardService {
+	return &LeaderboardService{leaderboardRepo: leaderboardRepo, playerRepo: playerRepo, logger: logger}
+}
+
+// GetLeaderboard ranks stored players by metric, best first, and returns the page after cursor
+// with the cursor for the next one. An empty next cursor means there is nothing more to read.
+// Ties are broken by puuid so pages stay stable.
+func (s *LeaderboardService) GetLeaderboard(ctx context.Context, metric domain.LeaderboardMetric, filter domain.LeaderboardFilter, cursor string, limit int) ([]domain.LeaderboardEntry, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if metric == "" {
+		metric = domain.LeaderboardRR
+	}
+	if !metric.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown metric %q", ErrInvalidLeaderboard, metric)
+	}
+	if filter.MinGames <= 0 {
+		filter.MinGames = constants.LeaderboardDefaultMinGames
+	}
+	if limit <= 0 {
+		limit = constants.LeaderboardDefaultLimit
+	}
+	limit = min(limit, constants.LeaderboardMaxLimit)
+
+	var after *leaderboardCursor
+	if cursor != "" {
+		c, err := decodeLeaderboardCursor(cursor, metric)
+		if err != nil {
+			return nil, "", err
+		}
+		after = c
+	}
+
+	entries, err := s.leaderboardRepo.GetEntries(ctx, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("metric", string(metric)).Msg("failed to load leaderboard")
+		return nil, "", fmt.Errorf("failed to load leaderboard: %w", err)
+	}
+
+	if metric == domain.LeaderboardRating {
+		entries = slices.DeleteFunc(entries, func(e domain.LeaderboardEntry) bool { return e.AvgRating == nil })
+	}
+	slices.SortFunc(entries, func(a, b domain.LeaderboardEntry) int {
+		return cmp.Or(cmp.Compare(leaderboardValue(b, metric), leaderboardValue(a, metric)), cmp.Compare(a.Puuid, b.Puuid))
+	})
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+
+	start := 0
+	if after != nil {
+		start = len(entries)
+		for i, e := range entries {
+			if after.isBefore(e, metric) {
+				start = i
+				break
+			}
+		}
+	}
+	page := entries[start:]
+
+	var next string
+	if len(page) > limit {
+		page = page[:limit]
+		last := page[len(page)-1]
+		next = leaderboardCursor{Metric: metric, Value: leaderboardValue(last, metric), Puuid: last.Puuid}.encode()
+	}
+
+	s.logger.Debug().Str("metric", string(metric)).Int("ranked", len(entries)).Int("page", len(page)).Msg("leaderboard built")
+	return page, next, nil
+}
+
+func leaderboardValue(e domain.LeaderboardEntry, metric domain.LeaderboardMetric) float64 {
+	switch metric {
+	case domain.LeaderboardWinRate:
+		return e.WinRate()
+	case domain.LeaderboardKD:
+		return e.KD()
+	case domain.LeaderboardRating:
+		if e.AvgRating == nil {
+			return 0
+		}
+		return *e.AvgRating
+	case domain.LeaderboardGames:
+		return float64(e.Games)
+	default:
+		return float64(e.Tier*100 + e.RR)
+	}
+}
+
+// AddGroupMember puts a stored player into a named leaderboard group.
+func (s *LeaderboardService) AddGroupMember(ctx context.Context, group, puuid string) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if group == "" || puuid == "" {
+		return fmt.Errorf("%w: group and puuid are required", ErrInvalidLeaderboard)
+	}
+	if _, err := s.playerRepo.Get(ctx, puuid, false); err != nil {
+		return fmt.Errorf("player not found: %w", err)
+	}
+
+	if err := s.leaderboardRepo.AddGroupMember(ctx, group, puuid); err != nil {
+		s.logger.Error().Err(err).Str("group", group).Str("puuid", puuid).Msg("failed to add group member")
+		return fmt.Errorf("failed to add group member: %w", err)
+	}
+
+	s.logger.Info().Str("group", group).Str("puuid", puuid).Msg("group member added")
+	return nil
+}
+
+func (s *LeaderboardService) RemoveGroupMember(ctx context.Context, group, puuid string) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if group == "" || puuid == "" {
+		return fmt.Errorf("%w: group and puuid are required", ErrInvalidLeaderboard)
+	}
+
+	if err := s.leaderboardRepo.RemoveGroupMember(ctx, group, puuid); err != nil {
+		s.logger.Error().Err(err).Str("group", group).Str("puuid", puuid).Msg("failed to remove group member")
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+
+	s.logger.Info().Str("group", group).Str("puuid", puuid).Msg("group member removed")
+	return nil
+}
+
+func (s *LeaderboardService) GetGroupMembers(ctx context.Context, group string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	members, err := s.leaderboardRepo.GetGroupMembers(ctx, group)
+	if err != nil {
+		s.logger.Error().Err(err).Str("group", group).Msg("failed to load group members")
+		return nil, fmt.Errorf("failed to load group members: %w", err)
+	}
+	return members, nil
+}
+
+// leaderboardCursor is the last entry of a page. It carries the metric so a cursor can't be
+// replayed against a differently sorted board.
+type leaderboardCursor struct {
+	Metric domain.LeaderboardMetric
+	Value  float64
+	Puuid  string
+}
+
+// isBefore reports whether e sorts after the cursor in best-first order.
+func (c leaderboardCursor) isBefore(e domain.LeaderboardEntry, metric domain.LeaderboardMetric) bool {
+	value := leaderboardValue(e, metric)
+	if value != c.Value {
+		return value < c.Value
+	}
+	return e.Puuid > c.Puuid
+}
+
+func (c leaderboardCursor) encode() string {
+	raw := string(c.Metric) + "|" + strconv.FormatFloat(c.Value, 'g', -1, 64) + "|" + c.Puuid
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeLeaderboardCursor(s string, metric domain.LeaderboardMetric) (*leaderboardCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.SplitN(string(raw), "|", 3)
+	if len(parts) != 3 || domain.LeaderboardMetric(parts[0]) != metric {
+		return nil, ErrInvalidCursor
+	}
+	value, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &leaderboardCursor{Metric: metric, Value: value, Puuid: parts[2]}, nil
+}
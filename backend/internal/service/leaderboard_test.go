@@ -0,0 +1,76 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"valorant-tracker/internal/domain"
+)
+
+func TestLeaderboardCursorRoundTrip(t *testing.T) {
+	cursors := []leaderboardCursor{
+		{Metric: domain.LeaderboardWinRate, Value: 1.0 / 3, Puuid: "abc-123"},
+		{Metric: domain.LeaderboardRR, Value: 2475, Puuid: "p"},
+		{Metric: domain.LeaderboardKD, Value: 0, Puuid: ""},
+		// only the first two separators split, the puuid keeps its own
+		{Metric: domain.LeaderboardRating, Value: 512.25, Puuid: "odd|puuid"},
+	}
+
+	for _, c := range cursors {
+		got, err := decodeLeaderboardCursor(c.encode(), c.Metric)
+		if err != nil {
+			t.Errorf("decode(%+v) failed: %v", c, err)
+			continue
+		}
+		if *got != c {
+			t.Errorf("decode(encode(%+v)) = %+v", c, *got)
+		}
+	}
+}
+
+func TestDecodeLeaderboardCursorRejects(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"other metric", leaderboardCursor{Metric: domain.LeaderboardKD, Value: 1.5, Puuid: "p"}.encode()},
+		{"not base64", "not base64!"},
+		{"missing puuid", encode("rr|2475")},
+		{"bad value", encode("rr|high|p")},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeLeaderboardCursor(tt.cursor, domain.LeaderboardRR); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("err = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestLeaderboardCursorIsBefore(t *testing.T) {
+	cursor := leaderboardCursor{Metric: domain.LeaderboardGames, Value: 10, Puuid: "m"}
+
+	tests := []struct {
+		games int
+		puuid string
+		want  bool
+	}{
+		{9, "a", true},
+		{11, "z", false},
+		// ties continue in puuid order
+		{10, "n", true},
+		{10, "m", false},
+		{10, "a", false},
+	}
+
+	for _, tt := range tests {
+		e := domain.LeaderboardEntry{Games: tt.games, Puuid: tt.puuid}
+		if got := cursor.isBefore(e, domain.LeaderboardGames); got != tt.want {
+			t.Errorf("isBefore(%d games, %q) = %v, want %v", tt.games, tt.puuid, got, tt.want)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"valorant-tracker/internal/api"
 	"valorant-tracker/internal/constants"
 	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/metrics"
 	"valorant-tracker/internal/repository"
 
 	"github.com/rs/zerolog"
@@ -18,11 +20,19 @@ type MatchService struct {
 	matchRepo      *repository.MatchRepository
 	playerRepo     *repository.PlayerRepository
 	mmrHistoryRepo *repository.MMRHistoryRepository
+	publisher      *matchPublisher
 	logger         zerolog.Logger
 }
 
-func NewMatchService(hdev *api.HDevClient, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, mmrHistoryRepo *repository.MMRHistoryRepository, logger zerolog.Logger) *MatchService {
-	return &MatchService{hdev: hdev, matchRepo: matchRepo, playerRepo: playerRepo, mmrHistoryRepo: mmrHistoryRepo, logger: logger}
+func NewMatchService(hdev *api.HDevClient, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, mmrHistoryRepo *repository.MMRHistoryRepository, bus *events.Bus, logger zerolog.Logger) *MatchService {
+	return &MatchService{
+		hdev:           hdev,
+		matchRepo:      matchRepo,
+		playerRepo:     playerRepo,
+		mmrHistoryRepo: mmrHistoryRepo,
+		publisher:      &matchPublisher{matchRepo: matchRepo, bus: bus, logger: logger},
+		logger:         logger,
+	}
 }
 
 func (s *MatchService) GetMatchesFor(ctx context.Context, puuid string, refresh bool) ([]repository.MatchWithPlayers, error) {
@@ -80,6 +90,14 @@ func (s *MatchService) GetMatchesFor(ctx context.Context, puuid string, refresh
 	return s.matchRepo.GetByPUUID(ctx, puuid)
 }
 
+// GetCachedMatches returns the matches stored for puuid without going upstream.
+func (s *MatchService) GetCachedMatches(ctx context.Context, puuid string) ([]repository.MatchWithPlayers, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	return s.matchRepo.GetByPUUID(ctx, puuid)
+}
+
 func (s *MatchService) fetchStoredData(ctx context.Context, player *domain.Player) (*api.StoredMatchesResponse, *api.StoredMMRHistoryResponse, error) {
 	apiCtx, cancel := context.WithTimeout(ctx, constants.ExternalAPITimeout)
 	defer cancel()
@@ -182,7 +200,7 @@ func (s *MatchService) upsertStoredMatches(ctx context.Context, puuid, region st
 			UpdatedAt:     time.Now(),
 		})
 
-		dbMatchPlayers = append(dbMatchPlayers, domain.MatchPlayer{
+		matchPlayer := domain.MatchPlayer{
 			MatchID:     match.Meta.ID,
 			Puuid:       puuid,
 			Name:        name,
@@ -200,7 +218,10 @@ func (s *MatchService) upsertStoredMatches(ctx context.Context, puuid, region st
 			DamageDealt: match.Stats.Damage.Made,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
-		})
+		}
+		// stored matches carry no kill feed
+		metrics.Apply(&matchPlayer, match.Teams.Red+match.Teams.Blue, nil)
+		dbMatchPlayers = append(dbMatchPlayers, matchPlayer)
 
 		dbMMRHistory = append(dbMMRHistory, domain.MMRHistory{
 			MatchID:       match.Meta.ID,
@@ -217,6 +238,7 @@ func (s *MatchService) upsertStoredMatches(ctx context.Context, puuid, region st
 		})
 	}
 
+	// stored matches are a one-off backfill of old games, nothing here is news to subscribers
 	if len(dbMatches) > 0 {
 		s.matchRepo.UpsertBatch(ctx, dbMatches, dbMatchPlayers)
 		s.mmrHistoryRepo.UpsertBatch(ctx, dbMMRHistory)
@@ -239,6 +261,7 @@ func (s *MatchService) upsertLiveMatches(ctx context.Context, puuid string, matc
 	var dbMatches []domain.Match
 	var dbMatchPlayers []domain.MatchPlayer
 	var dbMMRHistory []domain.MMRHistory
+	var timelines []matchTimeline
 
 	for _, match := range matches {
 		mmr, ok := mmrMap[match.Metadata.MatchID]
@@ -276,7 +299,7 @@ func (s *MatchService) upsertLiveMatches(ctx context.Context, puuid string, matc
 			UpdatedAt:     time.Now(),
 		})
 
-		dbMatchPlayers = append(dbMatchPlayers, domain.MatchPlayer{
+		matchPlayer := domain.MatchPlayer{
 			MatchID:     match.Metadata.MatchID,
 			Puuid:       puuid,
 			Name:        name,
@@ -290,11 +313,24 @@ func (s *MatchService) upsertLiveMatches(ctx context.Context, puuid string, matc
 			Team:        playerTeam,
 			HasWon:      teamWonMap[playerTeam],
 			CharacterID: s.getPlayerStatsString(match.Players, puuid, func(p api.V4Player) string { return p.Agent.ID }),
+			PartyID:     s.getPlayerStatsString(match.Players, puuid, func(p api.V4Player) string { return p.PartyID }),
 			DamageTaken: s.getPlayerStats(match.Players, puuid, func(p api.V4Player) int { return p.Stats.Damage.Received }),
 			DamageDealt: s.getPlayerStats(match.Players, puuid, func(p api.V4Player) int { return p.Stats.Damage.Made }),
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
-		})
+		}
+		rounds, kills := toDomainV4Rounds(match, "competitive")
+		metrics.Apply(&matchPlayer, teamScoreMap["Red"]+teamScoreMap["Blue"], kills)
+		matchPlayer.Rating = lobbyRating(match, puuid, teamScoreMap["Red"]+teamScoreMap["Blue"], kills)
+		dbMatchPlayers = append(dbMatchPlayers, matchPlayer)
+		if rounds != nil {
+			timelines = append(timelines, matchTimeline{
+				matchID:      match.Metadata.MatchID,
+				rounds:       rounds,
+				kills:        kills,
+				playerRounds: toDomainV4PlayerRounds(match),
+			})
+		}
 
 		dbMMRHistory = append(dbMMRHistory, domain.MMRHistory{
 			MatchID:       match.Metadata.MatchID,
@@ -312,9 +348,128 @@ func (s *MatchService) upsertLiveMatches(ctx context.Context, puuid string, matc
 	}
 
 	if len(dbMatches) > 0 {
-		s.matchRepo.UpsertBatch(ctx, dbMatches, dbMatchPlayers)
+		known := s.publisher.known(ctx, puuid, dbMatches)
+		err := s.matchRepo.UpsertBatch(ctx, dbMatches, dbMatchPlayers)
 		s.mmrHistoryRepo.UpsertBatch(ctx, dbMMRHistory)
+		if err == nil {
+			s.storeTimelines(ctx, timelines)
+		}
+		if err == nil && known != nil {
+			s.publisher.publish(ctx, puuid, dbMatches, dbMatchPlayers, dbMMRHistory, known)
+		}
+	}
+}
+
+type matchTimeline struct {
+	matchID      string
+	rounds       []domain.MatchRound
+	kills        []domain.MatchKill
+	playerRounds []domain.MatchPlayerRound
+}
+
+func (s *MatchService) storeTimelines(ctx context.Context, timelines []matchTimeline) {
+	for _, t := range timelines {
+		if err := s.matchRepo.ReplaceRounds(ctx, t.matchID, t.rounds, t.kills, t.playerRounds); err != nil {
+			s.logger.Warn().Err(err).Str("match_id", t.matchID).Msg("failed to store match rounds")
+		}
+	}
+}
+
+// lobbyRating rates everyone in the v4 payload and returns puuid's rating. Only puuid's row
+// gets stored, but the rating needs the whole lobby.
+func lobbyRating(match api.V4MatchData, puuid string, rounds int, kills []domain.MatchKill) *float64 {
+	lobby := make([]domain.MatchPlayer, len(match.Players))
+	for i, p := range match.Players {
+		lobby[i] = domain.MatchPlayer{
+			Puuid:       p.Puuid,
+			Team:        p.TeamID,
+			Tier:        p.Tier.ID,
+			Kills:       p.Stats.Kills,
+			Deaths:      p.Stats.Deaths,
+			Assists:     p.Stats.Assists,
+			Score:       p.Stats.Score,
+			DamageDealt: p.Stats.Damage.Made,
+		}
+		metrics.Apply(&lobby[i], rounds, kills)
+	}
+	metrics.ApplyRatings(lobby, rounds, kills)
+
+	for _, p := range lobby {
+		if p.Puuid == puuid {
+			return p.Rating
+		}
+	}
+	return nil
+}
+
+// toDomainV4Rounds converts the round list and kill feed of a v4 match. Both are nil when
+// the payload has no rounds.
+func toDomainV4Rounds(match api.V4MatchData, mode string) ([]domain.MatchRound, []domain.MatchKill) {
+	if len(match.Rounds) == 0 {
+		return nil, nil
+	}
+
+	matchID := match.Metadata.MatchID
+	rounds := make([]domain.MatchRound, len(match.Rounds))
+	for i, r := range match.Rounds {
+		rounds[i] = domain.MatchRound{
+			MatchID:       matchID,
+			RoundNumber:   r.ID,
+			WinningTeam:   r.WinningTeam,
+			AttackingTeam: metrics.AttackingTeam(mode, r.ID),
+			EndType:       r.Result,
+			BombPlanted:   r.Plant != nil,
+			BombDefused:   r.Defuse != nil,
+		}
+		if r.Plant != nil {
+			rounds[i].PlantSite = r.Plant.Site
+		}
+	}
+
+	kills := make([]domain.MatchKill, len(match.Kills))
+	for i, k := range match.Kills {
+		assistants := make([]string, len(k.Assistants))
+		for j, a := range k.Assistants {
+			assistants[j] = a.Puuid
+		}
+		kills[i] = domain.MatchKill{
+			MatchID:       matchID,
+			RoundNumber:   k.Round,
+			TimeInRoundMs: k.TimeInRoundInMs,
+			KillerPuuid:   k.Killer.Puuid,
+			KillerTeam:    k.Killer.Team,
+			VictimPuuid:   k.Victim.Puuid,
+			VictimTeam:    k.Victim.Team,
+			Assistants:    assistants,
+			WeaponID:      k.Weapon.ID,
+			WeaponName:    k.Weapon.Name,
+		}
+	}
+	return rounds, kills
+}
+
+// toDomainV4PlayerRounds converts every player's round stats and loadout of a v4 match.
+func toDomainV4PlayerRounds(match api.V4MatchData) []domain.MatchPlayerRound {
+	var playerRounds []domain.MatchPlayerRound
+	for _, r := range match.Rounds {
+		for _, p := range r.Stats {
+			pr := domain.MatchPlayerRound{
+				MatchID:     match.Metadata.MatchID,
+				RoundNumber: r.ID,
+				Puuid:       p.Player.Puuid,
+				Headshots:   p.Stats.Headshots,
+				Bodyshots:   p.Stats.Bodyshots,
+				Legshots:    p.Stats.Legshots,
+				Damage:      p.Stats.Damage,
+			}
+			if p.Economy.Weapon != nil {
+				pr.WeaponID = p.Economy.Weapon.ID
+				pr.WeaponName = p.Economy.Weapon.Name
+			}
+			playerRounds = append(playerRounds, pr)
+		}
 	}
+	return playerRounds
 }
 
 func (s *MatchService) getPlayerStatsString(players []api.V4Player, targetPUUID string, getStat func(api.V4Player) string) string {
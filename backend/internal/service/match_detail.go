@@ -1,25 +1,38 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
 	"time"
 	valorantv1 "valorant-tracker/gen/proto/valorant/v1"
 	"valorant-tracker/internal/api"
 	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/metrics"
 	"valorant-tracker/internal/repository"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
 )
 
 type MatchDetailService struct {
 	hdev       *api.HDevClient
 	matchRepo  *repository.MatchRepository
 	playerRepo *repository.PlayerRepository
+	publisher  *matchPublisher
 	logger     zerolog.Logger
 }
 
-func NewMatchDetailService(hdev *api.HDevClient, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, logger zerolog.Logger) *MatchDetailService {
-	return &MatchDetailService{hdev: hdev, matchRepo: matchRepo, playerRepo: playerRepo, logger: logger}
+func NewMatchDetailService(hdev *api.HDevClient, matchRepo *repository.MatchRepository, playerRepo *repository.PlayerRepository, bus *events.Bus, logger zerolog.Logger) *MatchDetailService {
+	return &MatchDetailService{
+		hdev:       hdev,
+		matchRepo:  matchRepo,
+		playerRepo: playerRepo,
+		publisher:  &matchPublisher{matchRepo: matchRepo, bus: bus, logger: logger},
+		logger:     logger,
+	}
 }
 
 func (s *MatchDetailService) GetMatch(ctx context.Context, matchID string) (*valorantv1.GetMatchResponse, error) {
@@ -34,19 +47,31 @@ func (s *MatchDetailService) GetMatch(ctx context.Context, matchID string) (*val
 		return s.fetchAndStoreMatch(ctx, matchID)
 	}
 
-	if len(matches) != 10 {
-		s.logger.Warn().Str("match_id", matchID).Int("player_count", len(matches)).Msg("incomplete match data, refetching")
-		_, err := s.fetchAndStoreMatch(ctx, matchID)
+	metadata, err := s.matchRepo.GetMatchMetadata(ctx, matchID)
+	if err != nil {
+		s.logger.Debug().Str("match_id", matchID).Msg("match metadata missing, fetching from API")
+		return s.fetchAndStoreMatch(ctx, matchID)
+	}
+
+	if !domain.IsCompleteLobby(metadata.Mode, len(matches)) {
+		s.logger.Warn().Str("match_id", matchID).Str("mode", metadata.Mode).Int("player_count", len(matches)).Msg("incomplete match data, refetching")
+		resp, err := s.fetchAndStoreMatch(ctx, matchID)
 		if err != nil {
 			s.logger.Error().Err(err).Str("match_id", matchID).Msg("failed to fetch and store match")
 			return nil, err
 		}
-		return s.GetMatch(ctx, matchID)
+		return resp, nil
+	}
+
+	awards, err := s.matchRepo.GetAwards(ctx, matchID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("match_id", matchID).Msg("failed to get match awards")
+	} else if len(awards) == 0 {
+		awards = s.storeMissingAwards(ctx, matchID, matches)
 	}
 
-	metadata, _ := s.matchRepo.GetMatchMetadata(ctx, matchID)
 	s.logger.Info().Str("match_id", matchID).Msg("match found in cache")
-	return s.buildResponse(metadata, matches), nil
+	return s.buildResponse(metadata, matches, awards), nil
 }
 
 var mapNameToID = map[string]string{
@@ -101,6 +126,25 @@ var characterNameToID = map[string]string{
 	"Jett":      "add6443a-41bd-e414-f6ad-e58d267f4e95",
 }
 
+var characterIDToName = func() map[string]string {
+	m := make(map[string]string, len(characterNameToID))
+	for name, id := range characterNameToID {
+		m[id] = name
+	}
+	return m
+}()
+
+// AgentName returns the display name for a character id, or "" if it is unknown.
+func AgentName(characterID string) string {
+	return characterIDToName[characterID]
+}
+
+// Refetch pulls the full lobby for a match from HDev and overwrites what is stored.
+func (s *MatchDetailService) Refetch(ctx context.Context, matchID string) error {
+	_, err := s.fetchAndStoreMatch(ctx, matchID)
+	return err
+}
+
 func (s *MatchDetailService) fetchAndStoreMatch(ctx context.Context, matchID string) (*valorantv1.GetMatchResponse, error) {
 	resp, err := s.hdev.GetMatchV2(ctx, matchID)
 	if err != nil {
@@ -115,7 +159,7 @@ func (s *MatchDetailService) fetchAndStoreMatch(ctx context.Context, matchID str
 		MapName:       resp.Data.Metadata.Map,
 		MapID:         mapNameToID[resp.Data.Metadata.Map],
 		Mode:          resp.Data.Metadata.Mode,
-		StartedAt:     time.Unix(int64(resp.Data.Metadata.GameStart), 0),
+		StartedAt:     time.Unix(int64(resp.Data.Metadata.GameStart), 0).UTC(),
 		SeasonID:      resp.Data.Metadata.SeasonID,
 		TeamRedScore:  resp.Data.Teams.Red.RoundsWon,
 		TeamBlueScore: resp.Data.Teams.Blue.RoundsWon,
@@ -127,6 +171,8 @@ func (s *MatchDetailService) fetchAndStoreMatch(ctx context.Context, matchID str
 		UpdatedAt:     time.Now(),
 	}
 
+	rounds, kills := toDomainV2Rounds(resp)
+
 	for _, p := range resp.Data.Players.AllPlayers {
 		players = append(players, domain.Player{
 			Puuid:           p.Puuid,
@@ -141,7 +187,7 @@ func (s *MatchDetailService) fetchAndStoreMatch(ctx context.Context, matchID str
 			LastFetchAt:     time.Now(),
 		})
 
-		matchPlayers = append(matchPlayers, domain.MatchPlayer{
+		matchPlayer := domain.MatchPlayer{
 			MatchID:     resp.Data.Metadata.Matchid,
 			Puuid:       p.Puuid,
 			Name:        p.Name,
@@ -155,33 +201,155 @@ func (s *MatchDetailService) fetchAndStoreMatch(ctx context.Context, matchID str
 			Team:        p.Team,
 			HasWon:      (p.Team == "Red" && resp.Data.Teams.Red.RoundsWon > resp.Data.Teams.Blue.RoundsWon) || (p.Team == "Blue" && resp.Data.Teams.Blue.RoundsWon > resp.Data.Teams.Red.RoundsWon),
 			CharacterID: characterNameToID[p.Character],
+			PartyID:     p.PartyID,
 			DamageTaken: p.DamageReceived,
 			DamageDealt: p.DamageMade,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
-		})
+		}
+		metrics.Apply(&matchPlayer, match.TeamRedScore+match.TeamBlueScore, kills)
+		matchPlayers = append(matchPlayers, matchPlayer)
+	}
+	metrics.ApplyRatings(matchPlayers, match.TeamRedScore+match.TeamBlueScore, kills)
+	awards := metrics.Awards(matchID, matchPlayers, rounds, kills)
+
+	// which lobby players already had this match has to be known before it is written
+	known := make([]map[string]bool, len(matchPlayers))
+	for i, mp := range matchPlayers {
+		known[i] = s.publisher.known(ctx, mp.Puuid, []domain.Match{match})
 	}
 
 	for _, p := range players {
-		s.playerRepo.Upsert(ctx, &p)
+		if err := s.playerRepo.Upsert(ctx, &p); err != nil {
+			s.logger.Warn().Err(err).Str("puuid", p.Puuid).Msg("failed to upsert match lobby player")
+		}
 	}
 
-	s.matchRepo.UpsertMatch(ctx, &match)
+	if err := s.matchRepo.UpsertMatch(ctx, &match); err != nil {
+		return nil, fmt.Errorf("failed to upsert match: %w", err)
+	}
 	for _, mp := range matchPlayers {
-		s.matchRepo.UpsertMatchPlayer(ctx, &mp)
+		if err := s.matchRepo.UpsertMatchPlayer(ctx, &mp); err != nil {
+			return nil, fmt.Errorf("failed to upsert match player: %w", err)
+		}
+	}
+	if rounds != nil {
+		if err := s.matchRepo.ReplaceRounds(ctx, matchID, rounds, kills, toDomainV2PlayerRounds(resp)); err != nil {
+			s.logger.Warn().Err(err).Str("match_id", matchID).Msg("failed to store match rounds")
+		}
+	}
+	if err := s.matchRepo.ReplaceAwards(ctx, matchID, awards); err != nil {
+		s.logger.Warn().Err(err).Str("match_id", matchID).Msg("failed to store match awards")
+	}
+
+	// v2 carries no rr changes, match.new goes out without them
+	for i, mp := range matchPlayers {
+		if known[i] != nil {
+			s.publisher.publish(ctx, mp.Puuid, []domain.Match{match}, []domain.MatchPlayer{mp}, nil, known[i])
+		}
 	}
 
 	metadata, _ := s.matchRepo.GetMatchMetadata(ctx, matchID)
 	storedPlayers, _ := s.matchRepo.GetByMatchID(ctx, matchID)
 
-	return s.buildResponse(metadata, storedPlayers), nil
+	return s.buildResponse(metadata, storedPlayers, awards), nil
 }
 
-func (s *MatchDetailService) buildResponse(metadata *domain.Match, players []domain.MatchPlayer) *valorantv1.GetMatchResponse {
+// storeMissingAwards computes the awards of a complete cached lobby that has none, matches
+// stored from a player's match list never went through the v2 fetch that computes them.
+func (s *MatchDetailService) storeMissingAwards(ctx context.Context, matchID string, players []domain.MatchPlayer) []domain.MatchAward {
+	rounds, kills, err := s.matchRepo.GetRounds(ctx, matchID)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("match_id", matchID).Msg("failed to get match rounds")
+	}
+
+	awards := metrics.Awards(matchID, players, rounds, kills)
+	if err := s.matchRepo.ReplaceAwards(ctx, matchID, awards); err != nil {
+		s.logger.Warn().Err(err).Str("match_id", matchID).Msg("failed to store match awards")
+	}
+	return awards
+}
+
+// toDomainV2Rounds converts the round list and kill feed of a v2 match. Both are nil when
+// the payload has no rounds.
+func toDomainV2Rounds(resp *api.MatchV2Response) ([]domain.MatchRound, []domain.MatchKill) {
+	if len(resp.Data.Rounds) == 0 {
+		return nil, nil
+	}
+
+	matchID := resp.Data.Metadata.Matchid
+	mode := resp.Data.Metadata.Mode
+	rounds := make([]domain.MatchRound, len(resp.Data.Rounds))
+	for i, r := range resp.Data.Rounds {
+		rounds[i] = domain.MatchRound{
+			MatchID:       matchID,
+			RoundNumber:   i,
+			WinningTeam:   r.WinningTeam,
+			AttackingTeam: metrics.AttackingTeam(mode, i),
+			EndType:       r.EndType,
+			BombPlanted:   r.BombPlanted,
+			BombDefused:   r.BombDefused,
+			PlantSite:     r.PlantEvents.PlantSite,
+		}
+	}
+
+	kills := make([]domain.MatchKill, len(resp.Data.Kills))
+	for i, k := range resp.Data.Kills {
+		assistants := make([]string, len(k.Assistants))
+		for j, a := range k.Assistants {
+			assistants[j] = a.AssistantPuuid
+		}
+		kills[i] = domain.MatchKill{
+			MatchID:       matchID,
+			RoundNumber:   k.Round,
+			TimeInRoundMs: k.KillTimeInRound,
+			KillerPuuid:   k.KillerPuuid,
+			KillerTeam:    k.KillerTeam,
+			VictimPuuid:   k.VictimPuuid,
+			VictimTeam:    k.VictimTeam,
+			Assistants:    assistants,
+			WeaponID:      k.DamageWeaponID,
+			WeaponName:    k.DamageWeaponName,
+		}
+	}
+	return rounds, kills
+}
+
+// toDomainV2PlayerRounds converts every player's round stats and loadout of a v2 match.
+func toDomainV2PlayerRounds(resp *api.MatchV2Response) []domain.MatchPlayerRound {
+	var playerRounds []domain.MatchPlayerRound
+	for i, r := range resp.Data.Rounds {
+		for _, p := range r.PlayerStats {
+			playerRounds = append(playerRounds, domain.MatchPlayerRound{
+				MatchID:     resp.Data.Metadata.Matchid,
+				RoundNumber: i,
+				Puuid:       p.PlayerPuuid,
+				WeaponID:    p.Economy.Weapon.ID,
+				WeaponName:  p.Economy.Weapon.Name,
+				Headshots:   p.Headshots,
+				Bodyshots:   p.Bodyshots,
+				Legshots:    p.Legshots,
+				Damage:      p.Damage,
+			})
+		}
+	}
+	return playerRounds
+}
+
+func (s *MatchDetailService) buildResponse(metadata *domain.Match, players []domain.MatchPlayer, awards []domain.MatchAward) *valorantv1.GetMatchResponse {
 	if metadata == nil {
 		return &valorantv1.GetMatchResponse{}
 	}
 
+	slices.SortStableFunc(players, func(a, b domain.MatchPlayer) int {
+		return cmp.Or(cmp.Compare(a.Team, b.Team), cmp.Compare(b.Score, a.Score))
+	})
+
+	var protoAwards []*valorantv1.MatchAward
+	for _, a := range awards {
+		protoAwards = append(protoAwards, &valorantv1.MatchAward{Type: a.Award, Puuid: a.Puuid, Value: float32(a.Value)})
+	}
+
 	return &valorantv1.GetMatchResponse{
 		Metadata: &valorantv1.MatchMetadata{
 			MatchId:       metadata.MatchID,
@@ -198,13 +366,14 @@ func (s *MatchDetailService) buildResponse(metadata *domain.Match, players []dom
 			RoundsPlayed:  int32(metadata.TeamRedScore + metadata.TeamBlueScore),
 		},
 		Players: s.toProtoPlayers(players),
+		Awards:  protoAwards,
 	}
 }
 
 func (s *MatchDetailService) toProtoPlayers(players []domain.MatchPlayer) []*valorantv1.PlayerMatch {
 	var protoPlayers []*valorantv1.PlayerMatch
 	for _, p := range players {
-		protoPlayers = append(protoPlayers, &valorantv1.PlayerMatch{
+		player := &valorantv1.PlayerMatch{
 			Puuid:       p.Puuid,
 			Name:        p.Name,
 			Tag:         p.Tag,
@@ -222,7 +391,23 @@ func (s *MatchDetailService) toProtoPlayers(players []domain.MatchPlayer) []*val
 				Id:   int32(p.Tier),
 				Name: p.TierName,
 			},
-		})
+			Acs:       float32(p.ACS),
+			Adr:       float32(p.ADR),
+			PlusMinus: int32(p.PlusMinus),
+		}
+		if p.KAST != nil {
+			player.Kast = proto.Float32(float32(*p.KAST))
+		}
+		if p.FirstBloods != nil {
+			player.FirstBloods = proto.Int32(int32(*p.FirstBloods))
+		}
+		if p.FirstDeaths != nil {
+			player.FirstDeaths = proto.Int32(int32(*p.FirstDeaths))
+		}
+		if p.Rating != nil {
+			player.Rating = proto.Float32(float32(*p.Rating))
+		}
+		protoPlayers = append(protoPlayers, player)
 	}
 	return protoPlayers
 }
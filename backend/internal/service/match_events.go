@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+// matchPublisher announces matches that are new to a player. Every ingestion path goes
+// through it, so subscribers hear about a match no matter which endpoint stored it first.
+type matchPublisher struct {
+	matchRepo *repository.MatchRepository
+	bus       *events.Bus
+	logger    zerolog.Logger
+}
+
+// known returns which of matches were already stored for puuid, or nil when no events
+// should be published: on the player's first ingestion every match would look new.
+// It has to run before the matches are written.
+func (p *matchPublisher) known(ctx context.Context, puuid string, matches []domain.Match) map[string]bool {
+	latest, err := p.matchRepo.GetLatestMatchDate(ctx, puuid)
+	if err != nil {
+		p.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to get latest match date")
+		return nil
+	}
+	if latest == nil {
+		return nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.MatchID
+	}
+	known, err := p.matchRepo.GetStoredMatchIDs(ctx, puuid, ids)
+	if err != nil {
+		p.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to get stored match ids")
+		return nil
+	}
+	return known
+}
+
+// publish sends match.new for every match missing from known and streak.win when those
+// matches carried the player's win streak to the threshold. matches and players are built
+// side by side, one player row per match.
+func (p *matchPublisher) publish(ctx context.Context, puuid string, matches []domain.Match, players []domain.MatchPlayer, mmrHistory []domain.MMRHistory, known map[string]bool) {
+	mmrByMatch := make(map[string]domain.MMRHistory, len(mmrHistory))
+	for _, mmr := range mmrHistory {
+		mmrByMatch[mmr.MatchID] = mmr
+	}
+
+	var newest *domain.Match
+	var newestWon bool
+	added := 0
+	for i, m := range matches {
+		if known[m.MatchID] {
+			continue
+		}
+		added++
+		mp := players[i]
+		mmr := mmrByMatch[m.MatchID]
+		p.bus.Publish(events.MatchNew, puuid, m.StartedAt, events.MatchData{
+			MatchID:       m.MatchID,
+			MapName:       m.MapName,
+			MapID:         m.MapID,
+			Mode:          m.Mode,
+			StartedAt:     m.StartedAt,
+			CharacterID:   mp.CharacterID,
+			Team:          mp.Team,
+			HasWon:        mp.HasWon,
+			Kills:         mp.Kills,
+			Deaths:        mp.Deaths,
+			Assists:       mp.Assists,
+			Score:         mp.Score,
+			DamageDealt:   mp.DamageDealt,
+			DamageTaken:   mp.DamageTaken,
+			TeamRedScore:  m.TeamRedScore,
+			TeamBlueScore: m.TeamBlueScore,
+			Tier:          mp.Tier,
+			TierName:      mp.TierName,
+			RankingInTier: mmr.RankingInTier,
+			MMRChange:     mmr.MMRChange,
+		})
+		if newest == nil || m.StartedAt.After(newest.StartedAt) {
+			newest = &matches[i]
+			newestWon = mp.HasWon
+		}
+	}
+
+	if newest == nil || !newestWon {
+		return
+	}
+
+	outcomes, err := p.matchRepo.GetRecentOutcomes(ctx, puuid, constants.WinStreakSample)
+	if err != nil {
+		p.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to get recent outcomes")
+		return
+	}
+	streak := 0
+	for _, won := range outcomes {
+		if !won {
+			break
+		}
+		streak++
+	}
+
+	// the added matches are the newest ones, whatever of the streak they don't cover was
+	// already there, and a streak that had reached the threshold was announced back then
+	before := streak - min(streak, added)
+	if before < constants.WinStreakMinLength && streak >= constants.WinStreakMinLength {
+		p.bus.Publish(events.WinStreak, puuid, newest.StartedAt, events.StreakData{Length: streak, MatchID: newest.MatchID})
+	}
+}
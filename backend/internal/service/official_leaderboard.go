@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"valorant-tracker/internal/api"
+	"valorant-tracker/internal/config"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// OfficialLeaderboardService snapshots the official ranked leaderboard of every configured
+// region on an interval and serves the latest snapshot. Older snapshots are kept for
+// constants.OfficialLeaderboardRetention so rank movement can be shown.
+type OfficialLeaderboardService struct {
+	cfg    *config.Config
+	hdev   *api.HDevClient
+	repo   *repository.OfficialLeaderboardRepository
+	logger zerolog.Logger
+
+	runMu  sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewOfficialLeaderboardService(lc fx.Lifecycle, cfg *config.Config, hdev *api.HDevClient, repo *repository.OfficialLeaderboardRepository, logger zerolog.Logger) *OfficialLeaderboardService {
+	s := &OfficialLeaderboardService{
+		cfg:    cfg,
+		hdev:   hdev,
+		repo:   repo,
+		logger: logger,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			s.cancel = cancel
+			s.done = make(chan struct{})
+			go s.loop(ctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			s.cancel()
+			select {
+			case <-s.done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return s
+}
+
+func (s *OfficialLeaderboardService) loop(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(constants.OfficialLeaderboardInterval)
+	defer ticker.Stop()
+
+	for {
+		s.Ingest(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ingest snapshots every configured region. A region that can't be fetched completely is
+// skipped rather than stored partially, the previous snapshot stays current.
+func (s *OfficialLeaderboardService) Ingest(ctx context.Context) {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	for _, region := range s.cfg.LeaderboardRegions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		entries, err := s.fetch(ctx, region)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("region", region).Msg("skipping leaderboard snapshot")
+			continue
+		}
+
+		dbCtx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+		_, err = s.repo.SaveSnapshot(dbCtx, region, time.Now(), entries)
+		cancel()
+		if err != nil {
+			s.logger.Error().Err(err).Str("region", region).Msg("failed to save leaderboard snapshot")
+			continue
+		}
+		s.logger.Info().Str("region", region).Int("entries", len(entries)).Msg("leaderboard snapshot saved")
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+	if err := s.repo.DeleteSnapshotsBefore(dbCtx, time.Now().Add(-constants.OfficialLeaderboardRetention)); err != nil {
+		s.logger.Warn().Err(err).Msg("failed to prune leaderboard snapshots")
+	}
+}
+
+func (s *OfficialLeaderboardService) fetch(ctx context.Context, region string) ([]domain.OfficialLeaderboardEntry, error) {
+	var entries []domain.OfficialLeaderboardEntry
+	for len(entries) < constants.OfficialLeaderboardDepth {
+		if !s.hdev.HasBudget(constants.OfficialLeaderboardHDevReserve) {
+			return nil, errors.New("out of HDev budget")
+		}
+
+		apiCtx, cancel := context.WithTimeout(ctx, constants.ExternalAPITimeout)
+		resp, err := s.hdev.GetLeaderboard(apiCtx, region, len(entries), constants.OfficialLeaderboardPageSize)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch leaderboard page at %d: %w", len(entries), err)
+		}
+
+		for _, p := range resp.Data.Players {
+			entry := domain.OfficialLeaderboardEntry{
+				Rank: p.LeaderboardRank,
+				Tier: p.Tier,
+				RR:   p.RR,
+				Wins: p.Wins,
+			}
+			if !p.IsAnonymized {
+				entry.Puuid, entry.Name, entry.Tag = p.Puuid, p.Name, p.Tag
+			}
+			entries = append(entries, entry)
+		}
+		if len(resp.Data.Players) < constants.OfficialLeaderboardPageSize {
+			break
+		}
+	}
+	return entries[:min(len(entries), constants.OfficialLeaderboardDepth)], nil
+}
+
+// GetLeaderboard pages through the latest snapshot of region by rank. The snapshot is nil when
+// the region hasn't been ingested yet.
+func (s *OfficialLeaderboardService) GetLeaderboard(ctx context.Context, region, search string, afterRank, limit int) (*domain.LeaderboardSnapshot, []domain.OfficialLeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = constants.OfficialLeaderboardDefaultLimit
+	}
+	limit = min(limit, constants.OfficialLeaderboardMaxLimit)
+
+	region = strings.ToLower(region)
+	snapshots, err := s.repo.GetRecentSnapshots(ctx, region, 2)
+	if err != nil {
+		s.logger.Error().Err(err).Str("region", region).Msg("failed to load leaderboard snapshots")
+		return nil, nil, fmt.Errorf("failed to load leaderboard snapshots: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return nil, nil, nil
+	}
+
+	var previousID string
+	if len(snapshots) > 1 {
+		previousID = snapshots[1].ID
+	}
+
+	entries, err := s.repo.ListEntries(ctx, snapshots[0].ID, previousID, search, afterRank, limit)
+	if err != nil {
+		s.logger.Error().Err(err).Str("region", region).Msg("failed to load leaderboard entries")
+		return nil, nil, fmt.Errorf("failed to load leaderboard entries: %w", err)
+	}
+	return &snapshots[0], entries, nil
+}
+
+// GetPlacement returns the player's current official leaderboard rank, or nil when unranked.
+func (s *OfficialLeaderboardService) GetPlacement(ctx context.Context, puuid string) (*domain.LeaderboardPlacement, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	placement, err := s.repo.GetPlacement(ctx, puuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load leaderboard placement: %w", err)
+	}
+	return placement, nil
+}
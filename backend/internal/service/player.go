@@ -9,6 +9,7 @@ import (
 	"valorant-tracker/internal/api"
 	"valorant-tracker/internal/constants"
 	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
 	"valorant-tracker/internal/repository"
 
 	"github.com/rs/zerolog"
@@ -18,17 +19,15 @@ import (
 type PlayerService struct {
 	hdev   *api.HDevClient
 	repo   *repository.PlayerRepository
+	bus    *events.Bus
 	logger zerolog.Logger
 }
 
-func NewPlayerService(hdev *api.HDevClient, repo *repository.PlayerRepository, logger zerolog.Logger) *PlayerService {
-	return &PlayerService{hdev: hdev, repo: repo, logger: logger}
+func NewPlayerService(hdev *api.HDevClient, repo *repository.PlayerRepository, bus *events.Bus, logger zerolog.Logger) *PlayerService {
+	return &PlayerService{hdev: hdev, repo: repo, bus: bus, logger: logger}
 }
 
 func (s *PlayerService) GetPlayer(ctx context.Context, name, tag string, refresh bool) (*domain.Player, error) {
-	ctx, cancel := context.WithTimeout(ctx, constants.RequestTimeout)
-	defer cancel()
-
 	name, err := url.QueryUnescape(name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unescape name: %w", err)
@@ -37,6 +36,14 @@ func (s *PlayerService) GetPlayer(ctx context.Context, name, tag string, refresh
 	if err != nil {
 		return nil, fmt.Errorf("failed to unescape tag: %w", err)
 	}
+	return s.GetPlayerByRiotID(ctx, name, tag, refresh)
+}
+
+// GetPlayerByRiotID is GetPlayer for a name and tag that are already decoded, such as the
+// ones stored in the database. Running those through GetPlayer would mangle '+' and '%'.
+func (s *PlayerService) GetPlayerByRiotID(ctx context.Context, name, tag string, refresh bool) (*domain.Player, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.RequestTimeout)
+	defer cancel()
 
 	s.logger.Info().Str("name", name).Str("tag", tag).Bool("refresh", refresh).Msg("getting player")
 
@@ -115,6 +122,8 @@ func (s *PlayerService) GetPlayer(ctx context.Context, name, tag string, refresh
 			return nil, err
 		}
 
+		previous := *player
+
 		player.Name = accResponse.Data.Name
 		player.Tag = accResponse.Data.Tag
 		player.Region = accResponse.Data.Region
@@ -132,6 +141,8 @@ func (s *PlayerService) GetPlayer(ctx context.Context, name, tag string, refresh
 			return nil, fmt.Errorf("failed to upsert player: %w", err)
 		}
 
+		s.publishRankChange(&previous, player)
+
 		g2 := new(errgroup.Group)
 		g2.Go(func() error {
 			time.Sleep(constants.LastFetchDelay)
@@ -203,6 +214,52 @@ func (s *PlayerService) GetPlayer(ctx context.Context, name, tag string, refresh
 	return player, nil
 }
 
+// publishRankChange compares a refreshed player against what was stored before.
+// Partial players only carry the tier from their last match, so they have nothing reliable to compare.
+func (s *PlayerService) publishRankChange(previous, current *domain.Player) {
+	if previous.IsPartialFetch || previous.CurrentTier == 0 {
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case current.CurrentTier != previous.CurrentTier:
+		t := events.RankUp
+		if current.CurrentTier < previous.CurrentTier {
+			t = events.RankDown
+		}
+		s.bus.Publish(t, current.Puuid, now, events.RankData{
+			PreviousTier:     previous.CurrentTier,
+			PreviousTierName: previous.CurrentTierName,
+			PreviousRR:       previous.CurrentRR,
+			Tier:             current.CurrentTier,
+			TierName:         current.CurrentTierName,
+			RR:               current.CurrentRR,
+		})
+	case current.CurrentRR != previous.CurrentRR:
+		s.bus.Publish(events.RRChanged, current.Puuid, now, events.RRData{
+			PreviousRR: previous.CurrentRR,
+			RR:         current.CurrentRR,
+			Tier:       current.CurrentTier,
+			TierName:   current.CurrentTierName,
+		})
+	}
+}
+
+// GetCachedPlayer returns name#tag if it can be served from the database without any HDev
+// call and nil otherwise, for callers that have to answer within a deadline.
+func (s *PlayerService) GetCachedPlayer(ctx context.Context, name, tag string) *domain.Player {
+	player, err := s.repo.GetByName(ctx, name, tag)
+	if err != nil || player == nil || player.IsPartialFetch {
+		return nil
+	}
+	stale, err := s.repo.ShouldRefresh(ctx, player.Puuid, min(constants.PlayerRefreshTTL, constants.MatchRefreshTTL))
+	if err != nil || stale {
+		return nil
+	}
+	return player
+}
+
 func (s *PlayerService) SearchSuggestions(ctx context.Context, query string) ([]*valorantv1.PlayerResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
 	defer cancel()
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/metrics"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type ProjectionService struct {
+	mmrHistoryRepo *repository.MMRHistoryRepository
+	logger         zerolog.Logger
+}
+
+func NewProjectionService(mmrHistoryRepo *repository.MMRHistoryRepository, logger zerolog.Logger) *ProjectionService {
+	return &ProjectionService{mmrHistoryRepo: mmrHistoryRepo, logger: logger}
+}
+
+// GetRankProjection fits average RR gain, loss and win rate on the latest rated games and
+// simulates the games to the next tier. games is the horizon of the promotion chance; zero uses
+// the default.
+func (s *ProjectionService) GetRankProjection(ctx context.Context, puuid string, games int) (*domain.RankProjection, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if games <= 0 {
+		games = constants.ProjectionDefaultGames
+	}
+	games = min(games, constants.ProjectionMaxGames)
+
+	history, err := s.mmrHistoryRepo.GetRatedGames(ctx, puuid, constants.ProjectionSample)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to load mmr history for projection")
+		return nil, fmt.Errorf("failed to load mmr history: %w", err)
+	}
+
+	projection := projectRank(history, games)
+	s.logger.Debug().Str("puuid", puuid).Int("sample", projection.SampleGames).Str("unavailable", projection.Unavailable).Msg("rank projected")
+	return &projection, nil
+}
+
+// projectRank expects history newest first. Games are counted by their match outcome, a loss
+// that cost no RR still is a loss; only games whose match isn't stored fall back to the RR change.
+func projectRank(history []repository.RatedGame, games int) domain.RankProjection {
+	projection := domain.RankProjection{Games: games}
+	if len(history) == 0 {
+		projection.Unavailable = "no rated games stored"
+		return projection
+	}
+
+	latest := history[0].MMRHistory
+	projection.Tier = latest.Tier
+	projection.TierName = latest.TierName
+	projection.RR = latest.RankingInTier
+	projection.Elo = latest.Elo
+
+	var gained, lost int
+	for _, game := range history {
+		switch ratedOutcome(game) {
+		case domain.OutcomeWin:
+			projection.Wins++
+			gained += game.MMRHistory.MMRChange
+		case domain.OutcomeLoss:
+			projection.Losses++
+			lost -= game.MMRHistory.MMRChange
+		default:
+			projection.Draws++
+		}
+	}
+	projection.SampleGames = len(history)
+	projection.WinRate = float64(projection.Wins) / float64(projection.SampleGames)
+	projection.WinRateLow, projection.WinRateHigh = metrics.Wilson(projection.Wins, projection.SampleGames, constants.ProjectionConfidenceZ)
+	if projection.Wins > 0 {
+		projection.AvgGain = float64(gained) / float64(projection.Wins)
+	}
+	if projection.Losses > 0 {
+		projection.AvgLoss = float64(lost) / float64(projection.Losses)
+	}
+
+	switch {
+	case !domain.IsRankedTier(latest.Tier):
+		projection.Unavailable = "not ranked"
+		return projection
+	case latest.Tier > constants.ProjectionHighestTier:
+		projection.Unavailable = "promotions above Ascendant 3 depend on leaderboard thresholds"
+		return projection
+	case projection.SampleGames < constants.ProjectionMinSample:
+		projection.Unavailable = fmt.Sprintf("%d rated games stored, %d needed", projection.SampleGames, constants.ProjectionMinSample)
+		return projection
+	case projection.Wins == 0:
+		projection.Unavailable = "no wins in the sample to estimate RR gains from"
+		return projection
+	}
+
+	gain := max(int(math.Round(projection.AvgGain)), 1)
+	loss := max(int(math.Round(projection.AvgLoss)), 0)
+	drawRate := float64(projection.Draws) / float64(projection.SampleGames)
+
+	// the draw rate stays fixed, the win rate moves within its band and losses take the rest
+	run := func(win float64) ([]float64, *float64) {
+		lose := max(1-win-drawRate, 0)
+		curve := metrics.PromotionCurve(projection.RR, gain, loss, win, lose, constants.ProjectionHorizon)
+		if expected, ok := metrics.ExpectedGames(curve); ok {
+			return curve, &expected
+		}
+		return curve, nil
+	}
+
+	high := min(projection.WinRateHigh, 1-drawRate)
+	curve, expected := run(projection.WinRate)
+	curveLow, expectedHigh := run(projection.WinRateLow)
+	curveHigh, expectedLow := run(high)
+
+	projection.PromotionChance = curve[games-1]
+	projection.PromotionChanceLow = curveLow[games-1]
+	projection.PromotionChanceHigh = curveHigh[games-1]
+	projection.ExpectedGames = expected
+	projection.ExpectedGamesLow = expectedLow
+	projection.ExpectedGamesHigh = expectedHigh
+	return projection
+}
+
+func ratedOutcome(game repository.RatedGame) domain.Outcome {
+	switch {
+	case game.Outcome != nil:
+		return *game.Outcome
+	case game.MMRHistory.MMRChange > 0:
+		return domain.OutcomeWin
+	case game.MMRHistory.MMRChange < 0:
+		return domain.OutcomeLoss
+	default:
+		return domain.OutcomeDraw
+	}
+}
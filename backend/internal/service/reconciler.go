@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"valorant-tracker/internal/api"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+// Reconciler periodically scans the database for data that ingestion left inconsistent,
+// repairs what it can within the HDev budget and keeps the last report for the admin RPC.
+type Reconciler struct {
+	hdev           *api.HDevClient
+	integrityRepo  *repository.IntegrityRepository
+	playerSvc      *PlayerService
+	matchDetailSvc *MatchDetailService
+	logger         zerolog.Logger
+
+	runMu    sync.Mutex
+	reportMu sync.RWMutex
+	report   *domain.IntegrityReport
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewReconciler(lc fx.Lifecycle, hdev *api.HDevClient, integrityRepo *repository.IntegrityRepository, playerSvc *PlayerService, matchDetailSvc *MatchDetailService, logger zerolog.Logger) *Reconciler {
+	r := &Reconciler{
+		hdev:           hdev,
+		integrityRepo:  integrityRepo,
+		playerSvc:      playerSvc,
+		matchDetailSvc: matchDetailSvc,
+		logger:         logger,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			r.cancel = cancel
+			r.done = make(chan struct{})
+			go r.loop(ctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			r.cancel()
+			select {
+			case <-r.done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return r
+}
+
+func (r *Reconciler) loop(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(constants.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Run(ctx); err != nil {
+				r.logger.Error().Err(err).Msg("reconciliation failed")
+			}
+		}
+	}
+}
+
+// LastReport returns the most recent report, or nil if no run has finished yet.
+func (r *Reconciler) LastReport() *domain.IntegrityReport {
+	r.reportMu.RLock()
+	defer r.reportMu.RUnlock()
+	return r.report
+}
+
+// Run performs a full scan and repair pass. Concurrent callers wait for the running pass.
+func (r *Reconciler) Run(ctx context.Context) (*domain.IntegrityReport, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	report := &domain.IntegrityReport{StartedAt: time.Now()}
+	r.logger.Info().Msg("reconciliation started")
+
+	budget := &refetchBudget{
+		left:    constants.ReconcileMaxRefetches,
+		fetched: make(map[string]bool),
+	}
+
+	if err := r.checkLobbies(ctx, report, budget); err != nil {
+		return nil, fmt.Errorf("failed to check lobbies: %w", err)
+	}
+	if err := r.checkOrphanedMMR(ctx, report, budget); err != nil {
+		return nil, fmt.Errorf("failed to check mmr histories: %w", err)
+	}
+	if err := r.checkOutcomes(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to check match outcomes: %w", err)
+	}
+	if err := r.checkPartialPlayers(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to check partial players: %w", err)
+	}
+
+	report.FinishedAt = time.Now()
+
+	r.reportMu.Lock()
+	r.report = report
+	r.reportMu.Unlock()
+
+	r.logger.Info().
+		Int("matches_scanned", report.MatchesScanned).
+		Int("issues", len(report.Issues)).
+		Int("repaired", report.Repaired()).
+		Int("deferred", report.Deferred()).
+		Dur("duration", report.FinishedAt.Sub(report.StartedAt)).
+		Msg("reconciliation finished")
+
+	return report, nil
+}
+
+func (r *Reconciler) checkLobbies(ctx context.Context, report *domain.IntegrityReport, budget *refetchBudget) error {
+	lobbies, err := r.integrityRepo.GetLobbySizes(ctx)
+	if err != nil {
+		return err
+	}
+	report.MatchesScanned = len(lobbies)
+
+	for _, lobby := range lobbies {
+		expected := domain.ExpectedLobbySize(lobby.Mode)
+		oversized := expected > 0 && lobby.PlayerCount > expected
+		// the stored and v4 paths only keep the fetched player's row, GetMatch fills those lobbies on demand
+		if domain.IsCompleteLobby(lobby.Mode, lobby.PlayerCount) || (!oversized && domain.IsSinglePlayerSource(lobby.Source)) {
+			continue
+		}
+
+		issue := domain.IntegrityIssue{
+			Kind:    domain.IssueIncompleteLobby,
+			MatchID: lobby.MatchID,
+			Detail:  fmt.Sprintf("%d players stored for %q (expected %d)", lobby.PlayerCount, lobby.Mode, expected),
+		}
+		if oversized {
+			issue.Kind = domain.IssueOversizedLobby
+		}
+
+		// more rows than seats can't be fixed by refetching, only by a human
+		if issue.Kind == domain.IssueIncompleteLobby {
+			r.refetch(ctx, &issue, budget, func(ctx context.Context) (bool, error) {
+				lobby, err := r.integrityRepo.GetLobbySize(ctx, issue.MatchID)
+				if err != nil {
+					return false, err
+				}
+				return domain.IsCompleteLobby(lobby.Mode, lobby.PlayerCount), nil
+			})
+		}
+		report.Issues = append(report.Issues, issue)
+	}
+	return nil
+}
+
+func (r *Reconciler) checkOrphanedMMR(ctx context.Context, report *domain.IntegrityReport, budget *refetchBudget) error {
+	orphans, err := r.integrityRepo.GetOrphanedMMRHistories(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, orphan := range orphans {
+		issue := domain.IntegrityIssue{
+			Kind:    domain.IssueOrphanedMMRHistory,
+			MatchID: orphan.MatchID,
+			Puuid:   orphan.Puuid,
+			Detail:  fmt.Sprintf("mmr history %s has no match player row", orphan.ID),
+		}
+		r.refetch(ctx, &issue, budget, func(ctx context.Context) (bool, error) {
+			return r.integrityRepo.HasMatchPlayer(ctx, issue.MatchID, issue.Puuid)
+		})
+		report.Issues = append(report.Issues, issue)
+	}
+	return nil
+}
+
+func (r *Reconciler) checkOutcomes(ctx context.Context, report *domain.IntegrityReport) error {
+	mismatches, err := r.integrityRepo.GetOutcomeMismatches(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range mismatches {
+		issue := domain.IntegrityIssue{
+			Kind:    domain.IssueOutcomeMismatch,
+			MatchID: m.MatchID,
+			Puuid:   m.Puuid,
+			Detail:  fmt.Sprintf("has_won=%t on %s but score is %d-%d", m.HasWon, m.Team, m.TeamRedScore, m.TeamBlueScore),
+		}
+
+		// a 0-0 score means the score itself is missing, so trust neither side
+		if m.TeamRedScore+m.TeamBlueScore > 0 {
+			hasWon := (m.Team == "Red" && m.TeamRedScore > m.TeamBlueScore) || (m.Team == "Blue" && m.TeamBlueScore > m.TeamRedScore)
+			if err := r.integrityRepo.SetOutcome(ctx, m.MatchID, m.Puuid, hasWon); err != nil {
+				r.logger.Warn().Err(err).Str("match_id", m.MatchID).Str("puuid", m.Puuid).Msg("failed to repair match outcome")
+			} else {
+				issue.Repaired = true
+			}
+		}
+		report.Issues = append(report.Issues, issue)
+	}
+	return nil
+}
+
+func (r *Reconciler) checkPartialPlayers(ctx context.Context, report *domain.IntegrityReport) error {
+	players, err := r.integrityRepo.GetStalePartialPlayers(ctx, time.Now().Add(-constants.PartialPlayerStaleAfter), constants.PartialPlayerBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range players {
+		issue := domain.IntegrityIssue{
+			Kind:   domain.IssueStalePartialPlayer,
+			Puuid:  p.Puuid,
+			Detail: fmt.Sprintf("%s#%s partial since %s", p.Name, p.Tag, p.UpdatedAt.Format(time.RFC3339)),
+		}
+
+		if !r.hdev.HasBudget(constants.ReconcileHDevReserve) {
+			issue.Deferred = true
+		} else if _, err := r.playerSvc.GetPlayerByRiotID(ctx, p.Name, p.Tag, true); err != nil {
+			r.logger.Warn().Err(err).Str("puuid", p.Puuid).Msg("failed to refresh partial player")
+		} else {
+			issue.Repaired = true
+		}
+		report.Issues = append(report.Issues, issue)
+	}
+	return nil
+}
+
+// refetchBudget caps the match refetches of a single run and remembers which matches were
+// already pulled, several issues often point at the same lobby.
+type refetchBudget struct {
+	left    int
+	fetched map[string]bool
+}
+
+// refetch pulls the match again and only marks the issue repaired once verify confirms the
+// stored data is fixed, a refetch that succeeds can still come back short.
+func (r *Reconciler) refetch(ctx context.Context, issue *domain.IntegrityIssue, budget *refetchBudget, verify func(context.Context) (bool, error)) {
+	fetched, tried := budget.fetched[issue.MatchID]
+	if !tried {
+		if budget.left <= 0 || !r.hdev.HasBudget(constants.ReconcileHDevReserve) {
+			issue.Deferred = true
+			return
+		}
+		budget.left--
+
+		err := r.matchDetailSvc.Refetch(ctx, issue.MatchID)
+		if err != nil {
+			r.logger.Warn().Err(err).Str("match_id", issue.MatchID).Msg("failed to refetch match")
+		}
+		fetched = err == nil
+		budget.fetched[issue.MatchID] = fetched
+	}
+	if !fetched {
+		return
+	}
+
+	repaired, err := verify(ctx)
+	if err != nil {
+		r.logger.Warn().Err(err).Str("match_id", issue.MatchID).Msg("failed to verify refetched match")
+		return
+	}
+	if !repaired {
+		r.logger.Warn().Str("match_id", issue.MatchID).Str("kind", string(issue.Kind)).Msg("refetch did not repair match")
+	}
+	issue.Repaired = repaired
+}
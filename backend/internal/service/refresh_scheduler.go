@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"valorant-tracker/internal/api"
+	"valorant-tracker/internal/config"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+const TrackedSourceConfig = "config"
+
+// RefreshScheduler keeps tracked players fresh in the background so the first viewer
+// doesn't pay for the HDev round trips. Each player gets its own interval based on how
+// often they play.
+type RefreshScheduler struct {
+	cfg         *config.Config
+	hdev        *api.HDevClient
+	trackedRepo *repository.TrackedPlayerRepository
+	playerRepo  *repository.PlayerRepository
+	matchRepo   *repository.MatchRepository
+	playerSvc   *PlayerService
+	matchSvc    *MatchService
+	logger      zerolog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRefreshScheduler(lc fx.Lifecycle, cfg *config.Config, hdev *api.HDevClient, trackedRepo *repository.TrackedPlayerRepository, playerRepo *repository.PlayerRepository, matchRepo *repository.MatchRepository, playerSvc *PlayerService, matchSvc *MatchService, logger zerolog.Logger) *RefreshScheduler {
+	s := &RefreshScheduler{
+		cfg:         cfg,
+		hdev:        hdev,
+		trackedRepo: trackedRepo,
+		playerRepo:  playerRepo,
+		matchRepo:   matchRepo,
+		playerSvc:   playerSvc,
+		matchSvc:    matchSvc,
+		logger:      logger,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			for _, puuid := range cfg.TrackedPuuids {
+				if err := trackedRepo.Track(ctx, puuid, TrackedSourceConfig, constants.RefreshDefaultInterval); err != nil {
+					return fmt.Errorf("failed to track configured player %s: %w", puuid, err)
+				}
+			}
+
+			runCtx, cancel := context.WithCancel(context.Background())
+			s.cancel = cancel
+			s.done = make(chan struct{})
+			go s.loop(runCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			s.cancel()
+			select {
+			case <-s.done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return s
+}
+
+func (s *RefreshScheduler) loop(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(constants.RefreshSchedulerTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *RefreshScheduler) tick(ctx context.Context) {
+	due, err := s.trackedRepo.GetDue(ctx, time.Now(), constants.RefreshBatchSize)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to load due tracked players")
+		return
+	}
+
+	for _, tp := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		if !s.hdev.HasBudget(constants.RefreshHDevReserve) {
+			s.logger.Debug().Int("pending", len(due)).Msg("hdev budget low, postponing scheduled refreshes")
+			return
+		}
+
+		interval, refreshedAt := s.refresh(ctx, tp)
+		if err := s.trackedRepo.Reschedule(ctx, tp.Puuid, interval, refreshedAt); err != nil {
+			s.logger.Error().Err(err).Str("puuid", tp.Puuid).Msg("failed to reschedule tracked player")
+		}
+	}
+}
+
+// refresh returns the interval until the next refresh and when the data was last refreshed.
+func (s *RefreshScheduler) refresh(ctx context.Context, tp domain.TrackedPlayer) (time.Duration, *time.Time) {
+	log := s.logger.With().Str("puuid", tp.Puuid).Logger()
+
+	player, err := s.resolvePlayer(ctx, tp.Puuid)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to resolve tracked player")
+		return constants.RefreshFailureBackoff, tp.LastRefreshAt
+	}
+
+	// someone looked at this profile recently, the data is already fresh
+	stale, err := s.playerRepo.ShouldRefresh(ctx, player.Puuid, constants.PlayerRefreshTTL)
+	if err == nil && !stale && tp.LastRefreshAt != nil {
+		return s.nextInterval(ctx, player.Puuid), tp.LastRefreshAt
+	}
+
+	if _, err := s.playerSvc.GetPlayerByRiotID(ctx, player.Name, player.Tag, true); err != nil {
+		log.Warn().Err(err).Msg("scheduled player refresh failed")
+		return constants.RefreshFailureBackoff, tp.LastRefreshAt
+	}
+	if _, err := s.matchSvc.GetMatchesFor(ctx, player.Puuid, true); err != nil {
+		log.Warn().Err(err).Msg("scheduled match refresh failed")
+		return constants.RefreshFailureBackoff, tp.LastRefreshAt
+	}
+
+	now := time.Now()
+	interval := s.nextInterval(ctx, player.Puuid)
+	log.Info().Dur("next_in", interval).Msg("tracked player refreshed")
+	return interval, &now
+}
+
+func (s *RefreshScheduler) resolvePlayer(ctx context.Context, puuid string) (*domain.Player, error) {
+	player, err := s.playerRepo.Get(ctx, puuid, false)
+	if err == nil {
+		return player, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// never seen this puuid, ask HDev who it belongs to
+	apiCtx, cancel := context.WithTimeout(ctx, constants.ExternalAPITimeout)
+	defer cancel()
+
+	acc, err := s.hdev.GetAccountByPuuid(apiCtx, puuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+	return &domain.Player{Puuid: acc.Data.Puuid, Name: acc.Data.Name, Tag: acc.Data.Tag, Region: acc.Data.Region}, nil
+}
+
+func (s *RefreshScheduler) nextInterval(ctx context.Context, puuid string) time.Duration {
+	startTimes, err := s.matchRepo.GetRecentStartTimes(ctx, puuid, constants.RefreshFrequencySample)
+	if err != nil {
+		s.logger.Warn().Err(err).Str("puuid", puuid).Msg("failed to load recent match times")
+		return constants.RefreshDefaultInterval
+	}
+	return refreshInterval(startTimes, time.Now())
+}
+
+// refreshInterval picks half the player's average gap between matches, so an active
+// player is polled about twice per game they'd play. startTimes are newest first.
+func refreshInterval(startTimes []time.Time, now time.Time) time.Duration {
+	if len(startTimes) == 0 {
+		return constants.RefreshMaxInterval
+	}
+	if now.Sub(startTimes[0]) < constants.RefreshActiveWindow {
+		return constants.RefreshMinInterval
+	}
+	if len(startTimes) < 2 {
+		return constants.RefreshDefaultInterval
+	}
+
+	span := startTimes[0].Sub(startTimes[len(startTimes)-1])
+	interval := span / time.Duration(len(startTimes)-1) / 2
+
+	// the longer they've been away, the less often they need checking
+	if idle := now.Sub(startTimes[0]); idle/2 > interval {
+		interval = idle / 2
+	}
+
+	return min(max(interval, constants.RefreshMinInterval), constants.RefreshMaxInterval)
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type SessionService struct {
+	matchRepo *repository.MatchRepository
+	logger    zerolog.Logger
+}
+
+func NewSessionService(matchRepo *repository.MatchRepository, logger zerolog.Logger) *SessionService {
+	return &SessionService{matchRepo: matchRepo, logger: logger}
+}
+
+// GetSessions groups the player's stored matches into sessions, newest first. A zero gap uses
+// the default. today keeps only sessions that ended within constants.SessionTodayWindow. The
+// tilt is detected over every stored session, not just the returned ones.
+func (s *SessionService) GetSessions(ctx context.Context, puuid string, gap time.Duration, today bool, limit int) ([]domain.Session, domain.Tilt, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if gap <= 0 {
+		gap = constants.SessionDefaultGap
+	}
+	gap = min(max(gap, constants.SessionMinGap), constants.SessionMaxGap)
+	if limit <= 0 {
+		limit = constants.SessionDefaultLimit
+	}
+	limit = min(limit, constants.SessionMaxLimit)
+
+	matches, err := s.matchRepo.GetByPUUID(ctx, puuid)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to load matches for sessions")
+		return nil, domain.Tilt{}, fmt.Errorf("failed to load matches: %w", err)
+	}
+
+	sessions := groupSessions(matches, gap)
+	tilt := DetectTilt(matches, gap)
+
+	if today {
+		since := time.Now().Add(-constants.SessionTodayWindow)
+		n := 0
+		for n < len(sessions) && sessions[n].EndedAt.After(since) {
+			n++
+		}
+		sessions = sessions[:n]
+	}
+	if len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+
+	s.logger.Debug().Str("puuid", puuid).Dur("gap", gap).Int("sessions", len(sessions)).Bool("tilted", tilt.Tilted).Msg("sessions built")
+	return sessions, tilt, nil
+}
+
+// groupSessions expects matches newest first, like MatchRepository.GetByPUUID returns them,
+// and returns sessions in the same order.
+func groupSessions(matches []repository.MatchWithPlayers, gap time.Duration) []domain.Session {
+	var sessions []domain.Session
+	for _, session := range splitSessions(matches, gap) {
+		sessions = append(sessions, summarizeSession(session))
+	}
+	return sessions
+}
+
+// splitSessions cuts newest-first matches wherever two starts are more than gap apart.
+func splitSessions(matches []repository.MatchWithPlayers, gap time.Duration) [][]repository.MatchWithPlayers {
+	var sessions [][]repository.MatchWithPlayers
+	var current []repository.MatchWithPlayers
+
+	for i, m := range matches {
+		if i > 0 && matches[i-1].Match.StartedAt.Sub(m.Match.StartedAt) > gap {
+			sessions = append(sessions, current)
+			current = nil
+		}
+		current = append(current, m)
+	}
+	if len(current) > 0 {
+		sessions = append(sessions, current)
+	}
+	return sessions
+}
+
+func summarizeSession(matches []repository.MatchWithPlayers) domain.Session {
+	newest, oldest := matches[0], matches[len(matches)-1]
+
+	session := domain.Session{
+		StartedAt:     oldest.Match.StartedAt,
+		EndedAt:       newest.Match.StartedAt.Add(constants.SessionMatchLength),
+		StartTier:     oldest.PlayerStats.Tier,
+		StartTierName: oldest.PlayerStats.TierName,
+		EndTier:       newest.PlayerStats.Tier,
+		EndTierName:   newest.PlayerStats.TierName,
+	}
+
+	agents := make(map[string]int)
+	for _, m := range matches {
+		session.MatchIDs = append(session.MatchIDs, m.Match.MatchID)
+		session.Kills += m.PlayerStats.Kills
+		session.Deaths += m.PlayerStats.Deaths
+		session.Assists += m.PlayerStats.Assists
+		agents[m.PlayerStats.CharacterID]++
+
+		switch domain.MatchOutcome(m.Match, m.PlayerStats) {
+		case domain.OutcomeWin:
+			session.Wins++
+		case domain.OutcomeDraw:
+			session.Draws++
+		default:
+			session.Losses++
+		}
+
+		if m.MMRData != nil {
+			session.NetRR += m.MMRData.MMRChange
+		}
+	}
+
+	losses := 0
+	for i := len(matches) - 1; i >= 0; i-- {
+		outcome := domain.MatchOutcome(matches[i].Match, matches[i].PlayerStats)
+		if losses >= constants.TiltLossStreak {
+			session.TiltGames++
+		}
+		if outcome == domain.OutcomeLoss {
+			losses++
+		} else {
+			losses = 0
+		}
+		session.Streaks.Add(outcome)
+	}
+
+	// the mmr history holds the tier after each game, which is what "ended at" should show
+	if newest.MMRData != nil {
+		session.EndTier = newest.MMRData.Tier
+		session.EndTierName = newest.MMRData.TierName
+	}
+
+	for id, n := range agents {
+		session.Agents = append(session.Agents, domain.SessionAgent{CharacterID: id, Matches: n})
+	}
+	sort.Slice(session.Agents, func(i, j int) bool {
+		if session.Agents[i].Matches != session.Agents[j].Matches {
+			return session.Agents[i].Matches > session.Agents[j].Matches
+		}
+		return session.Agents[i].CharacterID < session.Agents[j].CharacterID
+	})
+
+	return session
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+var ErrInvalidTimezone = errors.New("invalid timezone")
+
+type StatsService struct {
+	statsRepo *repository.StatsRepository
+	logger    zerolog.Logger
+}
+
+func NewStatsService(statsRepo *repository.StatsRepository, logger zerolog.Logger) *StatsService {
+	return &StatsService{statsRepo: statsRepo, logger: logger}
+}
+
+// GetAgentStats returns the player's agents most played first. groupByPatch splits them per
+// patch, newest patch first.
+func (s *StatsService) GetAgentStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.AgentStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	stats, err := s.statsRepo.GetAgentStats(ctx, puuid, filter, groupByPatch)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get agent stats")
+		return nil, fmt.Errorf("failed to get agent stats: %w", err)
+	}
+	sortByPatch(stats, func(a domain.AgentStats) string { return a.Patch })
+
+	s.logger.Debug().Str("puuid", puuid).Int("agents", len(stats)).Msg("agent stats computed")
+	return stats, nil
+}
+
+// GetMapStats returns the player's maps most played first. groupByPatch splits them per patch,
+// newest patch first.
+func (s *StatsService) GetMapStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.MapStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	stats, err := s.statsRepo.GetMapStats(ctx, puuid, filter, groupByPatch)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get map stats")
+		return nil, fmt.Errorf("failed to get map stats: %w", err)
+	}
+	sortByPatch(stats, func(m domain.MapStats) string { return m.Patch })
+
+	s.logger.Debug().Str("puuid", puuid).Int("maps", len(stats)).Msg("map stats computed")
+	return stats, nil
+}
+
+// GetOverallStats returns a single aggregate, or one per patch newest first with groupByPatch.
+// It's empty when no match is left after filtering.
+func (s *StatsService) GetOverallStats(ctx context.Context, puuid string, filter domain.StatsFilter, groupByPatch bool) ([]domain.OverallStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	stats, err := s.statsRepo.GetOverallStats(ctx, puuid, filter, groupByPatch)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get overall stats")
+		return nil, fmt.Errorf("failed to get overall stats: %w", err)
+	}
+	sortByPatch(stats, func(o domain.OverallStats) string { return o.Patch })
+
+	s.logger.Debug().Str("puuid", puuid).Int("rows", len(stats)).Msg("overall stats computed")
+	return stats, nil
+}
+
+func (s *StatsService) ListPatches(ctx context.Context) ([]domain.Patch, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	patches, err := s.statsRepo.ListPatches(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to list patches")
+		return nil, fmt.Errorf("failed to list patches: %w", err)
+	}
+	return patches, nil
+}
+
+// GetPatchMeta aggregates every stored player's agents on the patch, along with how many
+// stored matches it has. An empty mode counts every mode.
+func (s *StatsService) GetPatchMeta(ctx context.Context, patch, mode string) ([]domain.PatchMeta, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	meta, total, err := s.statsRepo.GetPatchMeta(ctx, patch, mode)
+	if err != nil {
+		s.logger.Error().Err(err).Str("patch", patch).Msg("failed to get patch meta")
+		return nil, 0, fmt.Errorf("failed to get patch meta: %w", err)
+	}
+
+	s.logger.Debug().Str("patch", patch).Int("agents", len(meta)).Int("matches", total).Msg("patch meta computed")
+	return meta, total, nil
+}
+
+// sortByPatch moves newer patches first and keeps the order within a patch. Without grouping
+// every row has the same empty patch and nothing moves.
+func sortByPatch[T any](rows []T, patch func(T) string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return domain.PatchBefore(patch(rows[j]), patch(rows[i]))
+	})
+}
+
+func (s *StatsService) GetClusterStats(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.ClusterStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	stats, err := s.statsRepo.GetClusterStats(ctx, puuid, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get cluster stats")
+		return nil, fmt.Errorf("failed to get cluster stats: %w", err)
+	}
+
+	s.logger.Debug().Str("puuid", puuid).Int("clusters", len(stats)).Msg("cluster stats computed")
+	return stats, nil
+}
+
+// GetClusterDistribution spreads every stored match over the clusters it was played on.
+func (s *StatsService) GetClusterDistribution(ctx context.Context, region, mode, patch string) ([]domain.ClusterShare, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	shares, err := s.statsRepo.GetClusterDistribution(ctx, region, mode, patch)
+	if err != nil {
+		s.logger.Error().Err(err).Str("region", region).Msg("failed to get cluster distribution")
+		return nil, fmt.Errorf("failed to get cluster distribution: %w", err)
+	}
+
+	s.logger.Debug().Str("region", region).Int("clusters", len(shares)).Msg("cluster distribution computed")
+	return shares, nil
+}
+
+// GetHeatmap buckets the player's matches by local hour and weekday in the IANA timezone; an
+// empty timezone uses UTC.
+func (s *StatsService) GetHeatmap(ctx context.Context, puuid string, filter domain.StatsFilter, timezone string) (*domain.Heatmap, error) {
+	// "Local" would be the server's zone, not the client's
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, timezone)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	matches, err := s.statsRepo.GetMatchTimes(ctx, puuid, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get match times")
+		return nil, fmt.Errorf("failed to get match times: %w", err)
+	}
+
+	heatmap := domain.NewHeatmap(loc, matches)
+	s.logger.Debug().Str("puuid", puuid).Str("timezone", loc.String()).Int("matches", len(matches)).Msg("heatmap computed")
+	return &heatmap, nil
+}
+
+// GetOpponentStrength buckets the player's matches into periods of bucket length, aligned to
+// UTC (weeks start on Monday), and fits how the average opponent rank moves over time.
+// A zero bucket uses the default.
+func (s *StatsService) GetOpponentStrength(ctx context.Context, puuid string, filter domain.StatsFilter, bucket time.Duration) (*domain.OpponentStrengthTrend, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if bucket <= 0 {
+		bucket = constants.OpponentStrengthDefaultBucket
+	}
+	bucket = min(max(bucket, constants.OpponentStrengthMinBucket), constants.OpponentStrengthMaxBucket)
+
+	matches, err := s.statsRepo.GetOpponentStrength(ctx, puuid, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get opponent strength")
+		return nil, fmt.Errorf("failed to get opponent strength: %w", err)
+	}
+
+	trend := opponentStrengthTrend(matches, bucket)
+	s.logger.Debug().Str("puuid", puuid).Int("matches", trend.Matches).Int("periods", len(trend.Periods)).Msg("opponent strength computed")
+	return &trend, nil
+}
+
+// opponentStrengthTrend expects matches oldest first.
+func opponentStrengthTrend(matches []domain.OpponentStrength, bucket time.Duration) domain.OpponentStrengthTrend {
+	trend := domain.OpponentStrengthTrend{Matches: len(matches)}
+
+	var xs, ys []float64
+	for _, m := range matches {
+		start := m.StartedAt.UTC().Truncate(bucket)
+		if len(trend.Periods) == 0 || !trend.Periods[len(trend.Periods)-1].Start.Equal(start) {
+			trend.Periods = append(trend.Periods, domain.OpponentStrengthPeriod{Start: start})
+		}
+		addOpponentStrength(&trend.Periods[len(trend.Periods)-1], m)
+		addOpponentStrength(&trend.Overall, m)
+
+		if avg, ok := m.Opponents.AvgTier(); ok {
+			trend.RatedMatches++
+			xs = append(xs, m.StartedAt.Sub(matches[0].StartedAt).Hours()/(24*7))
+			ys = append(ys, avg)
+		}
+	}
+	if len(trend.Periods) > 0 {
+		trend.Overall.Start = trend.Periods[0].Start
+	}
+
+	trend.TierChangePerWeek = leastSquaresSlope(xs, ys)
+	return trend
+}
+
+func addOpponentStrength(p *domain.OpponentStrengthPeriod, m domain.OpponentStrength) {
+	p.Matches++
+	p.Team.Add(m.Team)
+	p.Opponents.Add(m.Opponents)
+	p.ExpectedOpponents += m.ExpectedOpponents
+}
+
+// leastSquaresSlope returns nil when the points don't determine a line.
+func leastSquaresSlope(xs, ys []float64) *float64 {
+	if len(xs) < 2 {
+		return nil
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var cov, variance float64
+	for i := range xs {
+		cov += (xs[i] - meanX) * (ys[i] - meanY)
+		variance += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if variance == 0 {
+		return nil
+	}
+	slope := cov / variance
+	return &slope
+}
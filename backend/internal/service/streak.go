@@ -0,0 +1,84 @@
+package service
+
+import (
+	"fmt"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+)
+
+// Streaks walks newest-first matches oldest first, over all of them and per season. Seasons
+// come newest first.
+func Streaks(matches []repository.MatchWithPlayers) (domain.Streaks, []domain.SeasonStreaks) {
+	var overall domain.Streaks
+	var seasons []domain.SeasonStreaks
+	index := make(map[string]int)
+
+	for _, m := range matches {
+		if _, ok := index[m.Match.SeasonID]; !ok && m.Match.SeasonID != "" {
+			index[m.Match.SeasonID] = len(seasons)
+			seasons = append(seasons, domain.SeasonStreaks{SeasonID: m.Match.SeasonID})
+		}
+	}
+
+	for i := len(matches) - 1; i >= 0; i-- {
+		m := matches[i]
+		outcome := domain.MatchOutcome(m.Match, m.PlayerStats)
+		overall.Add(outcome)
+		if j, ok := index[m.Match.SeasonID]; ok {
+			seasons[j].Add(outcome)
+		}
+	}
+	return overall, seasons
+}
+
+// DetectTilt compares the games played after constants.TiltLossStreak straight losses in the
+// same session with the player's other games. Tilted needs both the win rate and the rating to
+// drop, or KD when the games aren't rated. Matches are newest first.
+func DetectTilt(matches []repository.MatchWithPlayers, gap time.Duration) domain.Tilt {
+	tilt := domain.Tilt{AfterLosses: constants.TiltLossStreak}
+
+	for _, session := range splitSessions(matches, gap) {
+		losses := 0
+		for i := len(session) - 1; i >= 0; i-- {
+			m := session[i]
+			if losses >= tilt.AfterLosses {
+				tilt.AfterStreak.Add(m.Match, m.PlayerStats)
+			} else {
+				tilt.Baseline.Add(m.Match, m.PlayerStats)
+			}
+			if domain.MatchOutcome(m.Match, m.PlayerStats) == domain.OutcomeLoss {
+				losses++
+			} else {
+				losses = 0
+			}
+		}
+	}
+
+	if tilt.AfterStreak.Games < constants.TiltMinGames || tilt.Baseline.Games < constants.TiltMinGames {
+		return tilt
+	}
+
+	rates := fmt.Sprintf("%.0f%% win rate over %d games vs %.0f%% otherwise",
+		tilt.AfterStreak.WinRate()*100, tilt.AfterStreak.Games, tilt.Baseline.WinRate()*100)
+	var form string
+	var worse bool
+	if drop, ok := tilt.RatingDrop(); ok {
+		after, _ := tilt.AfterStreak.AvgRating()
+		baseline, _ := tilt.Baseline.AvgRating()
+		form = fmt.Sprintf("%.0f rating vs %.0f", after, baseline)
+		worse = drop >= constants.TiltMinRatingDrop
+	} else {
+		form = fmt.Sprintf("%.2f KD vs %.2f", tilt.AfterStreak.KD(), tilt.Baseline.KD())
+		worse = tilt.KDDrop() >= constants.TiltMinKDDrop
+	}
+
+	tilt.Tilted = tilt.WinRateDrop() >= constants.TiltMinWinRateDrop && worse
+	if tilt.Tilted {
+		tilt.Insight = fmt.Sprintf("You play worse after %d losses in a row: %s, %s", tilt.AfterLosses, rates, form)
+	} else {
+		tilt.Insight = fmt.Sprintf("You hold up after %d losses in a row: %s, %s", tilt.AfterLosses, rates, form)
+	}
+	return tilt
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/metrics"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+// SuspicionService scores how much an account looks like a smurf or a boosted account from
+// stored data only. Every signal is reported with its inputs so admins can judge the flag.
+type SuspicionService struct {
+	playerRepo *repository.PlayerRepository
+	matchRepo  *repository.MatchRepository
+	logger     zerolog.Logger
+}
+
+func NewSuspicionService(playerRepo *repository.PlayerRepository, matchRepo *repository.MatchRepository, logger zerolog.Logger) *SuspicionService {
+	return &SuspicionService{playerRepo: playerRepo, matchRepo: matchRepo, logger: logger}
+}
+
+// GetReport works for players that were never looked up too; the account level signal is
+// then unavailable.
+func (s *SuspicionService) GetReport(ctx context.Context, puuid string) (*domain.SuspicionReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	player, err := s.playerRepo.Get(ctx, puuid, false)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to load player for suspicion")
+		return nil, fmt.Errorf("failed to load player: %w", err)
+	}
+
+	matches, err := s.matchRepo.GetByPUUID(ctx, puuid)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to load matches for suspicion")
+		return nil, fmt.Errorf("failed to load matches: %w", err)
+	}
+
+	report := buildSuspicionReport(puuid, player, matches)
+	s.logger.Debug().Str("puuid", puuid).Float64("score", report.Score()).Float64("coverage", report.Coverage()).Msg("suspicion scored")
+	return &report, nil
+}
+
+// GetReports scores several players, leaving out any that fail to load.
+func (s *SuspicionService) GetReports(ctx context.Context, puuids []string) map[string]domain.SuspicionReport {
+	reports := make(map[string]domain.SuspicionReport, len(puuids))
+	for _, puuid := range puuids {
+		report, err := s.GetReport(ctx, puuid)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("puuid", puuid).Msg("skipping suspicion report")
+			continue
+		}
+		reports[puuid] = *report
+	}
+	return reports
+}
+
+// buildSuspicionReport expects matches newest first. player may be nil.
+func buildSuspicionReport(puuid string, player *domain.Player, matches []repository.MatchWithPlayers) domain.SuspicionReport {
+	return domain.SuspicionReport{
+		Puuid: puuid,
+		Signals: []domain.SuspicionSignal{
+			levelSignal(player),
+			ratingSignal(matches),
+			rrPerWinSignal(matches),
+			sessionShiftSignal(matches),
+		},
+	}
+}
+
+func levelSignal(player *domain.Player) domain.SuspicionSignal {
+	signal := domain.SuspicionSignal{
+		Kind:       domain.SignalLowLevelHighRank,
+		Threshold:  constants.SuspicionLevelThreshold,
+		Saturation: constants.SuspicionLevelSaturation,
+		Weight:     constants.SuspicionWeightLevel,
+	}
+	if player == nil || !domain.IsRankedTier(player.CurrentTier) {
+		signal.Detail = "no stored account or current rank"
+		return signal
+	}
+
+	signal.Available = true
+	signal.Value = float64(player.AccountLevel)
+	// both a low level and a high rank are needed, either alone is common
+	signal.Strength = ramp(signal.Value, signal.Threshold, signal.Saturation) *
+		ramp(float64(player.CurrentTier), constants.SuspicionTierThreshold, constants.SuspicionTierSaturation)
+	signal.Detail = fmt.Sprintf("account level %d at %s", player.AccountLevel, player.CurrentTierName)
+	return signal
+}
+
+// ratingSignal takes the lobby rank adjustment back out of the rating, leaving only how far
+// the player stood above that lobby.
+func ratingSignal(matches []repository.MatchWithPlayers) domain.SuspicionSignal {
+	signal := domain.SuspicionSignal{
+		Kind:       domain.SignalRatingAboveLobby,
+		Threshold:  constants.SuspicionRatingThreshold,
+		Saturation: constants.SuspicionRatingSaturation,
+		Weight:     constants.SuspicionWeightRating,
+	}
+
+	var sum float64
+	var n int
+	for _, m := range matches {
+		if n == constants.SuspicionSample {
+			break
+		}
+		avgTier, ok := m.Lobby.Lobby().AvgTier()
+		if m.PlayerStats.Rating == nil || !ok {
+			continue
+		}
+		sum += *m.PlayerStats.Rating - (avgTier-constants.RatingTierPivot)*constants.RatingPerTier
+		n++
+	}
+	if n < constants.SuspicionMinSample {
+		signal.Detail = fmt.Sprintf("%d rated matches with a known lobby rank, %d needed", n, constants.SuspicionMinSample)
+		return signal
+	}
+
+	signal.Available = true
+	signal.Value = sum / float64(n)
+	signal.Strength = ramp(signal.Value, signal.Threshold, signal.Saturation)
+	signal.Detail = fmt.Sprintf("average lobby-relative rating %.0f over %d matches", signal.Value, n)
+	return signal
+}
+
+func rrPerWinSignal(matches []repository.MatchWithPlayers) domain.SuspicionSignal {
+	signal := domain.SuspicionSignal{
+		Kind:       domain.SignalRRPerWin,
+		Threshold:  constants.SuspicionRRThreshold,
+		Saturation: constants.SuspicionRRSaturation,
+		Weight:     constants.SuspicionWeightRR,
+	}
+
+	var sum, wins int
+	for i, m := range matches {
+		if i == constants.SuspicionSample {
+			break
+		}
+		if m.MMRData != nil && m.PlayerStats.HasWon && m.MMRData.MMRChange > 0 {
+			sum += m.MMRData.MMRChange
+			wins++
+		}
+	}
+	if wins < constants.SuspicionMinSample {
+		signal.Detail = fmt.Sprintf("%d rated wins in the latest %d matches, %d needed", wins, constants.SuspicionSample, constants.SuspicionMinSample)
+		return signal
+	}
+
+	signal.Available = true
+	signal.Value = float64(sum) / float64(wins)
+	signal.Strength = ramp(signal.Value, signal.Threshold, signal.Saturation)
+	signal.Detail = fmt.Sprintf("%.1f RR per win over %d wins", signal.Value, wins)
+	return signal
+}
+
+// sessionShiftSignal compares the latest session's combat score with everything before it.
+// A jump can mean someone else is playing the account.
+func sessionShiftSignal(matches []repository.MatchWithPlayers) domain.SuspicionSignal {
+	signal := domain.SuspicionSignal{
+		Kind:       domain.SignalSessionStatShift,
+		Threshold:  constants.SuspicionShiftThreshold,
+		Saturation: constants.SuspicionShiftSaturation,
+		Weight:     constants.SuspicionWeightSession,
+	}
+
+	sessions := splitSessions(matches, constants.SessionDefaultGap)
+	if len(sessions) < 2 || len(sessions[0]) < constants.SuspicionMinSession || len(matches)-len(sessions[0]) < constants.SuspicionMinSample {
+		signal.Detail = fmt.Sprintf("needs a latest session of %d matches and %d earlier matches", constants.SuspicionMinSession, constants.SuspicionMinSample)
+		return signal
+	}
+
+	latest := sessionACS(sessions[0])
+	earlier := sessionACS(matches[len(sessions[0]):])
+	if earlier == 0 {
+		signal.Detail = "no combat score in earlier matches"
+		return signal
+	}
+
+	signal.Available = true
+	signal.Value = latest / earlier
+	signal.Strength = ramp(signal.Value, signal.Threshold, signal.Saturation)
+	signal.Detail = fmt.Sprintf("latest session ACS %.0f against %.0f before", latest, earlier)
+	return signal
+}
+
+func sessionACS(matches []repository.MatchWithPlayers) float64 {
+	var score, rounds int
+	for _, m := range matches {
+		score += m.PlayerStats.Score
+		rounds += m.Match.TeamRedScore + m.Match.TeamBlueScore
+	}
+	return metrics.ACS(score, rounds)
+}
+
+// ramp maps value linearly onto 0-1 between from and to; to may be below from.
+func ramp(value, from, to float64) float64 {
+	if from == to {
+		return 0
+	}
+	return max(0, min(1, (value-from)/(to-from)))
+}
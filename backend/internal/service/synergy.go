@@ -0,0 +1,108 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type SynergyService struct {
+	statsRepo *repository.StatsRepository
+	logger    zerolog.Logger
+}
+
+func NewSynergyService(statsRepo *repository.StatsRepository, logger zerolog.Logger) *SynergyService {
+	return &SynergyService{statsRepo: statsRepo, logger: logger}
+}
+
+// GetSynergy splits the player's results by teammate and by solo versus party queue. Teammates
+// below minGames shared matches are left out; zero values use the defaults.
+func (s *SynergyService) GetSynergy(ctx context.Context, puuid string, minGames, limit int) (*domain.Synergy, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if minGames <= 0 {
+		minGames = constants.SynergyDefaultMinGames
+	}
+	if limit <= 0 {
+		limit = constants.SynergyDefaultLimit
+	}
+	limit = min(limit, constants.SynergyMaxLimit)
+
+	matches, err := s.statsRepo.GetTeamMatches(ctx, puuid)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to load team matches")
+		return nil, fmt.Errorf("failed to load team matches: %w", err)
+	}
+
+	synergy := buildSynergy(matches, minGames)
+	if len(synergy.Teammates) > limit {
+		synergy.Teammates = synergy.Teammates[:limit]
+	}
+
+	s.logger.Debug().Str("puuid", puuid).Int("teammates", len(synergy.Teammates)).Int("unclassified", synergy.Unclassified).Msg("synergy computed")
+	return &synergy, nil
+}
+
+// buildSynergy expects matches newest first, so names come from the latest shared match.
+func buildSynergy(matches []domain.TeamMatch, minGames int) domain.Synergy {
+	together := make(map[string]int)
+	for _, m := range matches {
+		for _, t := range m.Teammates {
+			together[t.Puuid]++
+		}
+	}
+
+	// party ids decide when both sides have one, otherwise queuing together often enough counts
+	isPremade := func(m domain.TeamMatch, t domain.Teammate) bool {
+		if m.PartyID != "" && t.PartyID != "" {
+			return m.PartyID == t.PartyID
+		}
+		return together[t.Puuid] >= constants.SynergyCoOccurrence
+	}
+
+	var synergy domain.Synergy
+	byTeammate := make(map[string]*domain.TeammateSynergy)
+	for _, m := range matches {
+		if len(m.Teammates) == 0 {
+			synergy.Unclassified++
+			continue
+		}
+
+		party := false
+		for _, t := range m.Teammates {
+			ts, ok := byTeammate[t.Puuid]
+			if !ok {
+				ts = &domain.TeammateSynergy{Puuid: t.Puuid, Name: t.Name, Tag: t.Tag}
+				byTeammate[t.Puuid] = ts
+			}
+			ts.Add(m)
+			if isPremade(m, t) {
+				ts.PremadeGames++
+				party = true
+			}
+		}
+
+		if party {
+			synergy.Party.Add(m)
+		} else {
+			synergy.Solo.Add(m)
+		}
+	}
+
+	for _, ts := range byTeammate {
+		if ts.Games >= minGames {
+			synergy.Teammates = append(synergy.Teammates, *ts)
+		}
+	}
+	slices.SortFunc(synergy.Teammates, func(a, b domain.TeammateSynergy) int {
+		return cmp.Or(cmp.Compare(b.Games, a.Games), cmp.Compare(b.WinRate(), a.WinRate()), cmp.Compare(a.Puuid, b.Puuid))
+	})
+	return synergy
+}
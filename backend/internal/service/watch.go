@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"valorant-tracker/internal/api"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx"
+)
+
+type RefreshState string
+
+const (
+	RefreshStarted   RefreshState = "refreshing"
+	RefreshFinished  RefreshState = "refreshed"
+	RefreshFailed    RefreshState = "failed"
+	RefreshThrottled RefreshState = "throttled" // skipped to leave HDev budget for user requests
+)
+
+type RefreshStatus struct {
+	State         RefreshState
+	NextRefreshAt time.Time
+	Error         string
+}
+
+type PlayerSnapshot struct {
+	Player  *domain.Player
+	Matches []repository.MatchWithPlayers
+}
+
+// WatchUpdate carries exactly one of its fields.
+type WatchUpdate struct {
+	Snapshot *PlayerSnapshot
+	Event    *events.Event
+	Status   *RefreshStatus
+}
+
+type Watcher struct {
+	updates chan WatchUpdate
+	hub     *WatchHub
+	puuid   string
+
+	gotSnapshot bool
+}
+
+// Updates is closed when the hub shuts down.
+func (w *Watcher) Updates() <-chan WatchUpdate {
+	return w.updates
+}
+
+func (w *Watcher) Close() {
+	w.hub.unwatch(w)
+}
+
+type playerPoller struct {
+	watchers map[*Watcher]struct{}
+	snapshot *PlayerSnapshot
+	cancel   context.CancelFunc
+}
+
+// WatchHub runs one poller per watched player no matter how many clients watch it, and forwards
+// bus events about that player, which also covers refreshes triggered by anyone else.
+type WatchHub struct {
+	hdev       *api.HDevClient
+	playerRepo *repository.PlayerRepository
+	matchRepo  *repository.MatchRepository
+	playerSvc  *PlayerService
+	matchSvc   *MatchService
+	logger     zerolog.Logger
+
+	mu          sync.Mutex
+	pollers     map[string]*playerPoller
+	closed      bool
+	wg          sync.WaitGroup
+	unsubscribe func()
+}
+
+func NewWatchHub(lc fx.Lifecycle, bus *events.Bus, hdev *api.HDevClient, playerRepo *repository.PlayerRepository, matchRepo *repository.MatchRepository, playerSvc *PlayerService, matchSvc *MatchService, logger zerolog.Logger) *WatchHub {
+	h := &WatchHub{
+		hdev:       hdev,
+		playerRepo: playerRepo,
+		matchRepo:  matchRepo,
+		playerSvc:  playerSvc,
+		matchSvc:   matchSvc,
+		logger:     logger,
+		pollers:    make(map[string]*playerPoller),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			h.unsubscribe = bus.Subscribe(h.handle)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			h.unsubscribe()
+			h.Close()
+
+			done := make(chan struct{})
+			go func() {
+				h.wg.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return h
+}
+
+// Watch registers a watcher for puuid. The first update is always a snapshot of the profile.
+func (h *WatchHub) Watch(ctx context.Context, puuid string) (*Watcher, error) {
+	if _, err := h.playerRepo.Get(ctx, puuid, false); err != nil {
+		return nil, fmt.Errorf("player not found: %w", err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, fmt.Errorf("watch hub is shutting down")
+	}
+
+	w := &Watcher{updates: make(chan WatchUpdate, constants.WatchBufferSize), hub: h, puuid: puuid}
+
+	p, ok := h.pollers[puuid]
+	if !ok {
+		pollCtx, cancel := context.WithCancel(context.Background())
+		p = &playerPoller{watchers: make(map[*Watcher]struct{}), cancel: cancel}
+		h.pollers[puuid] = p
+
+		h.wg.Add(1)
+		go h.poll(pollCtx, puuid)
+		h.logger.Debug().Str("puuid", puuid).Msg("player poller started")
+	}
+	p.watchers[w] = struct{}{}
+
+	if p.snapshot != nil {
+		w.gotSnapshot = true
+		w.updates <- WatchUpdate{Snapshot: p.snapshot}
+	}
+
+	h.logger.Info().Str("puuid", puuid).Int("watchers", len(p.watchers)).Msg("player watch started")
+	return w, nil
+}
+
+func (h *WatchHub) unwatch(w *Watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.pollers[w.puuid]
+	if !ok {
+		return
+	}
+	delete(p.watchers, w)
+
+	if len(p.watchers) == 0 {
+		p.cancel()
+		delete(h.pollers, w.puuid)
+		h.logger.Debug().Str("puuid", w.puuid).Msg("player poller stopped")
+	}
+}
+
+// Close stops every poller and closes all watcher channels so streaming handlers return.
+func (h *WatchHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+
+	for puuid, p := range h.pollers {
+		p.cancel()
+		for w := range p.watchers {
+			close(w.updates)
+		}
+		delete(h.pollers, puuid)
+	}
+}
+
+func (h *WatchHub) handle(e events.Event) {
+	switch e.Type {
+	case events.MatchNew, events.RRChanged, events.RankUp, events.RankDown:
+	default:
+		return
+	}
+
+	h.broadcast(e.Puuid, WatchUpdate{Event: &e})
+}
+
+// broadcast must not block: it runs on bus publishers and pollers alike.
+func (h *WatchHub) broadcast(puuid string, u WatchUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.pollers[puuid]
+	if !ok {
+		return
+	}
+	for w := range p.watchers {
+		if u.Snapshot != nil {
+			if w.gotSnapshot {
+				continue
+			}
+			w.gotSnapshot = true
+		}
+		select {
+		case w.updates <- u:
+		default:
+			h.logger.Warn().Str("puuid", puuid).Msg("watcher too slow, dropping update")
+		}
+	}
+}
+
+func (h *WatchHub) poll(ctx context.Context, puuid string) {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(constants.WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		h.pollOnce(ctx, puuid)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *WatchHub) pollOnce(ctx context.Context, puuid string) {
+	log := h.logger.With().Str("puuid", puuid).Logger()
+	next := time.Now().Add(constants.WatchPollInterval)
+
+	player, err := h.playerRepo.Get(ctx, puuid, false)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to load watched player")
+		return
+	}
+
+	// the snapshot goes out first, so don't make watchers wait for HDev
+	if !h.hasSnapshot(puuid) {
+		h.sendCachedSnapshot(ctx, player)
+	}
+
+	// the services only go upstream once their TTLs run out, tell watchers when that happens
+	stale, err := h.playerRepo.ShouldRefresh(ctx, puuid, min(constants.PlayerRefreshTTL, constants.MatchRefreshTTL))
+	upstream := err != nil || stale || player.IsPartialFetch
+	if upstream {
+		if !h.hdev.HasBudget(constants.WatchHDevReserve) {
+			h.broadcast(puuid, WatchUpdate{Status: &RefreshStatus{State: RefreshThrottled, NextRefreshAt: next}})
+			return
+		}
+		h.broadcast(puuid, WatchUpdate{Status: &RefreshStatus{State: RefreshStarted}})
+	}
+
+	refreshed, err := h.playerSvc.GetPlayerByRiotID(ctx, player.Name, player.Tag, false)
+	var matches []repository.MatchWithPlayers
+	if err == nil {
+		matches, err = h.matchSvc.GetMatchesFor(ctx, puuid, false)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Err(err).Msg("watched player refresh failed")
+		h.broadcast(puuid, WatchUpdate{Status: &RefreshStatus{State: RefreshFailed, NextRefreshAt: next, Error: err.Error()}})
+		return
+	}
+
+	h.storeSnapshot(puuid, &PlayerSnapshot{Player: refreshed, Matches: matches})
+	if upstream {
+		h.broadcast(puuid, WatchUpdate{Status: &RefreshStatus{State: RefreshFinished, NextRefreshAt: next}})
+	}
+}
+
+func (h *WatchHub) hasSnapshot(puuid string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.pollers[puuid]
+	return ok && p.snapshot != nil
+}
+
+func (h *WatchHub) sendCachedSnapshot(ctx context.Context, player *domain.Player) {
+	matches, err := h.matchRepo.GetByPUUID(ctx, player.Puuid)
+	if err != nil {
+		h.logger.Warn().Err(err).Str("puuid", player.Puuid).Msg("failed to load cached matches")
+		return
+	}
+	h.storeSnapshot(player.Puuid, &PlayerSnapshot{Player: player, Matches: matches})
+}
+
+func (h *WatchHub) storeSnapshot(puuid string, snapshot *PlayerSnapshot) {
+	h.mu.Lock()
+	if p, ok := h.pollers[puuid]; ok {
+		p.snapshot = snapshot
+	}
+	h.mu.Unlock()
+
+	h.broadcast(puuid, WatchUpdate{Snapshot: snapshot})
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+)
+
+type WeaponService struct {
+	weaponRepo *repository.WeaponRepository
+	logger     zerolog.Logger
+}
+
+func NewWeaponService(weaponRepo *repository.WeaponRepository, logger zerolog.Logger) *WeaponService {
+	return &WeaponService{weaponRepo: weaponRepo, logger: logger}
+}
+
+// GetWeaponStats returns the player's weapons with the most kills first, each split by map and
+// by season. Only matches with a stored kill feed count.
+func (s *WeaponService) GetWeaponStats(ctx context.Context, puuid string, filter domain.StatsFilter) ([]domain.WeaponBreakdown, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	weaponSlices, err := s.weaponRepo.GetWeaponSlices(ctx, puuid, filter)
+	if err != nil {
+		s.logger.Error().Err(err).Str("puuid", puuid).Msg("failed to get weapon stats")
+		return nil, fmt.Errorf("failed to get weapon stats: %w", err)
+	}
+
+	weapons := buildWeaponBreakdowns(weaponSlices)
+	s.logger.Debug().Str("puuid", puuid).Int("weapons", len(weapons)).Msg("weapon stats computed")
+	return weapons, nil
+}
+
+// GetWeaponMeta returns every weapon across all stored matches with the most kills first, along
+// with the total kills and player rounds to weigh them against. Empty arguments don't filter.
+func (s *WeaponService) GetWeaponMeta(ctx context.Context, region, mode, patch string) ([]domain.WeaponMeta, int, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	metas, playerRounds, err := s.weaponRepo.GetWeaponMeta(ctx, region, mode, patch)
+	if err != nil {
+		s.logger.Error().Err(err).Str("region", region).Msg("failed to get weapon meta")
+		return nil, 0, 0, fmt.Errorf("failed to get weapon meta: %w", err)
+	}
+
+	var kills int
+	for _, m := range metas {
+		kills += m.Kills
+	}
+	slices.SortFunc(metas, func(a, b domain.WeaponMeta) int {
+		return cmp.Or(cmp.Compare(b.Kills, a.Kills), cmp.Compare(b.Rounds, a.Rounds), cmp.Compare(a.WeaponName, b.WeaponName))
+	})
+
+	s.logger.Debug().Str("region", region).Int("weapons", len(metas)).Int("kills", kills).Msg("weapon meta computed")
+	return metas, kills, playerRounds, nil
+}
+
+func buildWeaponBreakdowns(weaponSlices []domain.WeaponSlice) []domain.WeaponBreakdown {
+	var weapons []domain.WeaponBreakdown
+	index := make(map[string]int)
+
+	for _, ws := range weaponSlices {
+		i, ok := index[ws.WeaponID]
+		if !ok {
+			i = len(weapons)
+			index[ws.WeaponID] = i
+			weapons = append(weapons, domain.WeaponBreakdown{WeaponID: ws.WeaponID, WeaponName: ws.WeaponName})
+		}
+		w := &weapons[i]
+		w.Add(ws.WeaponStats)
+
+		if j := slices.IndexFunc(w.Maps, func(m domain.WeaponMapStats) bool { return m.MapID == ws.MapID }); j >= 0 {
+			w.Maps[j].Add(ws.WeaponStats)
+		} else {
+			w.Maps = append(w.Maps, domain.WeaponMapStats{MapID: ws.MapID, MapName: ws.MapName, WeaponStats: ws.WeaponStats})
+		}
+
+		if j := slices.IndexFunc(w.Seasons, func(p domain.WeaponSeasonStats) bool { return p.SeasonID == ws.SeasonID }); j >= 0 {
+			w.Seasons[j].Add(ws.WeaponStats)
+			if ws.FirstPlayedAt.Before(w.Seasons[j].FirstPlayedAt) {
+				w.Seasons[j].FirstPlayedAt = ws.FirstPlayedAt
+			}
+		} else {
+			w.Seasons = append(w.Seasons, domain.WeaponSeasonStats{SeasonID: ws.SeasonID, FirstPlayedAt: ws.FirstPlayedAt, WeaponStats: ws.WeaponStats})
+		}
+	}
+
+	for i := range weapons {
+		slices.SortFunc(weapons[i].Maps, func(a, b domain.WeaponMapStats) int {
+			return cmp.Or(cmp.Compare(b.Kills, a.Kills), cmp.Compare(b.Rounds, a.Rounds), cmp.Compare(a.MapName, b.MapName))
+		})
+		slices.SortFunc(weapons[i].Seasons, func(a, b domain.WeaponSeasonStats) int {
+			return a.FirstPlayedAt.Compare(b.FirstPlayedAt)
+		})
+	}
+	slices.SortFunc(weapons, func(a, b domain.WeaponBreakdown) int {
+		return cmp.Or(cmp.Compare(b.Kills, a.Kills), cmp.Compare(b.Rounds, a.Rounds), cmp.Compare(a.WeaponName, b.WeaponName))
+	})
+	return weapons
+}
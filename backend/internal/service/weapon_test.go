@@ -0,0 +1,66 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+	"valorant-tracker/internal/domain"
+)
+
+func TestBuildWeaponBreakdowns(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	slice := func(weapon, mapName, season string, first time.Time, kills, rounds, headshots int) domain.WeaponSlice {
+		return domain.WeaponSlice{
+			WeaponID:      weapon,
+			WeaponName:    weapon,
+			MapID:         mapName,
+			MapName:       mapName,
+			SeasonID:      season,
+			FirstPlayedAt: first,
+			WeaponStats:   domain.WeaponStats{Kills: kills, Rounds: rounds, RoundKills: kills, Headshots: headshots},
+		}
+	}
+
+	weapons := buildWeaponBreakdowns([]domain.WeaponSlice{
+		slice("vandal", "Ascent", "s2", day(20), 10, 20, 5),
+		slice("phantom", "Bind", "s1", day(2), 30, 40, 12),
+		slice("vandal", "Bind", "s1", day(5), 15, 25, 6),
+		slice("vandal", "Ascent", "s1", day(3), 10, 10, 4),
+		// same kills and rounds as the sheriff, the name breaks the tie
+		slice("classic", "Bind", "s1", day(1), 2, 4, 1),
+		slice("sheriff", "Bind", "s1", day(1), 2, 4, 1),
+	})
+
+	var order []string
+	for _, w := range weapons {
+		order = append(order, w.WeaponID)
+	}
+	if want := []string{"vandal", "phantom", "classic", "sheriff"}; !slices.Equal(order, want) {
+		t.Fatalf("weapons = %v, want %v", order, want)
+	}
+
+	vandal := weapons[0]
+	if vandal.Kills != 35 || vandal.Rounds != 55 || vandal.Headshots != 15 {
+		t.Errorf("vandal totals = %+v", vandal.WeaponStats)
+	}
+
+	// maps are summed across seasons, most kills first
+	if len(vandal.Maps) != 2 || vandal.Maps[0].MapName != "Ascent" || vandal.Maps[0].Kills != 20 || vandal.Maps[0].Rounds != 30 {
+		t.Errorf("vandal maps = %+v", vandal.Maps)
+	}
+
+	if len(vandal.Seasons) != 2 {
+		t.Fatalf("vandal seasons = %+v", vandal.Seasons)
+	}
+	s1, s2 := vandal.Seasons[0], vandal.Seasons[1]
+	if s1.SeasonID != "s1" || !s1.FirstPlayedAt.Equal(day(3)) || s1.Kills != 25 {
+		t.Errorf("first season = %+v, want s1 first played on day 3 with 25 kills", s1)
+	}
+	if s2.SeasonID != "s2" || s2.Kills != 10 {
+		t.Errorf("second season = %+v", s2)
+	}
+
+	if got := buildWeaponBreakdowns(nil); len(got) != 0 {
+		t.Errorf("no slices gave %v", got)
+	}
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+	"valorant-tracker/internal/constants"
+	"valorant-tracker/internal/domain"
+	"valorant-tracker/internal/events"
+	"valorant-tracker/internal/repository"
+
+	"github.com/rs/zerolog"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/fx"
+)
+
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+// WebhookService turns bus events into persisted deliveries and posts them to subscribers,
+// retrying failures with exponential backoff. Deliveries live in the database, so pending
+// retries survive a restart.
+type WebhookService struct {
+	bus    *events.Bus
+	repo   *repository.WebhookRepository
+	client *fasthttp.Client
+	logger zerolog.Logger
+
+	queue       chan events.Event
+	unsubscribe func()
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewWebhookService(lc fx.Lifecycle, bus *events.Bus, repo *repository.WebhookRepository, logger zerolog.Logger) *WebhookService {
+	s := &WebhookService{
+		bus:  bus,
+		repo: repo,
+		client: &fasthttp.Client{
+			ReadTimeout:         constants.WebhookRequestTimeout,
+			WriteTimeout:        constants.WebhookRequestTimeout,
+			MaxIdleConnDuration: 1 * time.Minute,
+		},
+		logger: logger,
+		queue:  make(chan events.Event, constants.WebhookQueueSize),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			s.unsubscribe = bus.Subscribe(s.handle)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			s.cancel = cancel
+			s.done = make(chan struct{})
+			go s.loop(ctx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			s.unsubscribe()
+			s.cancel()
+			select {
+			case <-s.done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+
+	return s
+}
+
+// handle runs on the publisher's goroutine, so it only hands the event over.
+func (s *WebhookService) handle(e events.Event) {
+	select {
+	case s.queue <- e:
+	default:
+		s.logger.Warn().Str("event_id", e.ID).Str("type", string(e.Type)).Msg("webhook queue full, dropping event")
+	}
+}
+
+func (s *WebhookService) loop(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(constants.WebhookDispatchTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-s.queue:
+			s.enqueue(ctx, e)
+			s.dispatch(ctx)
+		case <-ticker.C:
+			s.dispatch(ctx)
+		}
+	}
+}
+
+func (s *WebhookService) enqueue(ctx context.Context, e events.Event) {
+	subs, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to load webhook subscriptions")
+		return
+	}
+
+	var payload []byte
+	for _, sub := range subs {
+		if !subscriptionMatches(sub, e) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(e); err != nil {
+				s.logger.Error().Err(err).Str("event_id", e.ID).Msg("failed to encode webhook payload")
+				return
+			}
+		}
+		if err := s.repo.CreateDelivery(ctx, sub.ID, e.ID, string(e.Type), string(payload)); err != nil {
+			s.logger.Error().Err(err).Str("subscription_id", sub.ID).Str("event_id", e.ID).Msg("failed to create webhook delivery")
+		}
+	}
+}
+
+func subscriptionMatches(sub domain.WebhookSubscription, e events.Event) bool {
+	if !slices.Contains(sub.EventTypes, string(e.Type)) {
+		return false
+	}
+	if sub.Puuid != "" && sub.Puuid != e.Puuid {
+		return false
+	}
+	if e.Type == events.RRChanged && sub.RRThreshold != nil {
+		data, ok := e.Data.(events.RRData)
+		if !ok {
+			return false
+		}
+		t := *sub.RRThreshold
+		return (data.PreviousRR < t && data.RR >= t) || (data.PreviousRR >= t && data.RR < t)
+	}
+	return true
+}
+
+func (s *WebhookService) dispatch(ctx context.Context) {
+	due, err := s.repo.GetDueDeliveries(ctx, time.Now(), constants.WebhookDispatchBatch)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to load due webhook deliveries")
+		return
+	}
+	if len(due) == 0 {
+		return
+	}
+
+	subs, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to load webhook subscriptions")
+		return
+	}
+	byID := make(map[string]domain.WebhookSubscription, len(subs))
+	for _, sub := range subs {
+		byID[sub.ID] = sub
+	}
+
+	for i := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		sub, ok := byID[due[i].SubscriptionID]
+		if !ok {
+			s.abandon(ctx, &due[i])
+			continue
+		}
+		s.deliver(ctx, sub, &due[i])
+	}
+}
+
+// abandon fails a delivery whose subscription is gone, left pending it would keep its place at
+// the front of every batch.
+func (s *WebhookService) abandon(ctx context.Context, d *domain.WebhookDelivery) {
+	msg := "subscription deleted"
+	d.Status = domain.WebhookDeliveryFailed
+	d.LastError = &msg
+	if err := s.repo.UpdateDelivery(ctx, d); err != nil {
+		s.logger.Error().Err(err).Str("delivery_id", d.ID).Msg("failed to update webhook delivery")
+		return
+	}
+	s.logger.Warn().Str("delivery_id", d.ID).Str("subscription_id", d.SubscriptionID).Msg("webhook subscription gone, delivery failed")
+}
+
+func (s *WebhookService) deliver(ctx context.Context, sub domain.WebhookSubscription, d *domain.WebhookDelivery) {
+	log := s.logger.With().Str("delivery_id", d.ID).Str("subscription_id", sub.ID).Str("event_type", d.EventType).Logger()
+
+	statusCode, err := s.post(sub, d)
+	d.Attempts++
+	d.LastStatusCode = nil
+	d.LastError = nil
+	if statusCode > 0 {
+		d.LastStatusCode = &statusCode
+	}
+
+	now := time.Now()
+	switch {
+	case err == nil && statusCode >= 200 && statusCode < 300:
+		d.Status = domain.WebhookDeliveryDelivered
+		d.DeliveredAt = &now
+		log.Debug().Int("status", statusCode).Msg("webhook delivered")
+	default:
+		if err == nil {
+			err = fmt.Errorf("unexpected status %d", statusCode)
+		}
+		msg := err.Error()
+		d.LastError = &msg
+
+		if d.Attempts >= constants.WebhookMaxAttempts {
+			d.Status = domain.WebhookDeliveryFailed
+			log.Warn().Err(err).Int("attempts", d.Attempts).Msg("webhook delivery failed, giving up")
+		} else {
+			d.NextAttemptAt = now.Add(retryBackoff(d.Attempts))
+			log.Debug().Err(err).Int("attempts", d.Attempts).Time("next_attempt_at", d.NextAttemptAt).Msg("webhook delivery failed, retrying")
+		}
+	}
+
+	if err := s.repo.UpdateDelivery(ctx, d); err != nil {
+		log.Error().Err(err).Msg("failed to update webhook delivery")
+	}
+}
+
+func (s *WebhookService) post(sub domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.SetRequestURI(sub.URL)
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.Header.SetContentType("application/json")
+	req.Header.Set("X-Webhook-Id", d.ID)
+	req.Header.Set("X-Webhook-Event", d.EventType)
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", "sha256="+signPayload(sub.Secret, timestamp, d.Payload))
+	req.SetBodyString(d.Payload)
+
+	if err := s.client.DoTimeout(req, resp, constants.WebhookRequestTimeout); err != nil {
+		return 0, err
+	}
+	return resp.StatusCode(), nil
+}
+
+// signPayload signs "<timestamp>.<body>" so receivers can reject replayed deliveries.
+func signPayload(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func retryBackoff(attempts int) time.Duration {
+	backoff := constants.WebhookRetryBase << (attempts - 1)
+	if backoff <= 0 || backoff > constants.WebhookRetryMax {
+		return constants.WebhookRetryMax
+	}
+	return backoff
+}
+
+// CreateSubscription validates and stores sub. A secret is generated when none is given.
+func (s *WebhookService) CreateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) url", ErrInvalidWebhook)
+	}
+	if len(sub.EventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", ErrInvalidWebhook)
+	}
+	for _, t := range sub.EventTypes {
+		if !events.Type(t).Valid() {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, t)
+		}
+	}
+
+	if sub.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("failed to generate secret: %w", err)
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		s.logger.Error().Err(err).Str("url", sub.URL).Msg("failed to create webhook subscription")
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	s.logger.Info().Str("subscription_id", sub.ID).Strs("event_types", sub.EventTypes).Msg("webhook subscription created")
+	return nil
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if err := s.repo.DeleteSubscription(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	s.logger.Info().Str("subscription_id", id).Msg("webhook subscription deleted")
+	return nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	return s.repo.ListSubscriptions(ctx)
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DatabaseTimeout)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = constants.WebhookDeliveryLimit
+	}
+	return s.repo.ListDeliveries(ctx, subscriptionID, min(limit, constants.WebhookDeliveryMaxList))
+}